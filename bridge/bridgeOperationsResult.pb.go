@@ -0,0 +1,410 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: bridgeOperationsResult.proto
+
+package bridge
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ErrorStage defines the stage at which sending a bridge tx failed
+type ErrorStage int32
+
+const (
+	ErrorStage_None       ErrorStage = 0
+	ErrorStage_Formatting ErrorStage = 1
+	ErrorStage_Journal    ErrorStage = 2
+	ErrorStage_Nonce      ErrorStage = 3
+	ErrorStage_Signing    ErrorStage = 4
+	ErrorStage_Broadcast  ErrorStage = 5
+	ErrorStage_Dependency ErrorStage = 6
+	ErrorStage_Epoch      ErrorStage = 7
+)
+
+// Enum value maps for ErrorStage.
+var (
+	ErrorStage_name = map[int32]string{
+		0: "None",
+		1: "Formatting",
+		2: "Journal",
+		3: "Nonce",
+		4: "Signing",
+		5: "Broadcast",
+		6: "Dependency",
+		7: "Epoch",
+	}
+	ErrorStage_value = map[string]int32{
+		"None":       0,
+		"Formatting": 1,
+		"Journal":    2,
+		"Nonce":      3,
+		"Signing":    4,
+		"Broadcast":  5,
+		"Dependency": 6,
+		"Epoch":      7,
+	}
+)
+
+func (x ErrorStage) Enum() *ErrorStage {
+	p := new(ErrorStage)
+	*p = x
+	return p
+}
+
+func (x ErrorStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_bridgeOperationsResult_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorStage) Type() protoreflect.EnumType {
+	return &file_bridgeOperationsResult_proto_enumTypes[0]
+}
+
+func (x ErrorStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorStage.Descriptor instead.
+func (ErrorStage) EnumDescriptor() ([]byte, []int) {
+	return file_bridgeOperationsResult_proto_rawDescGZIP(), []int{0}
+}
+
+// OperationsResult holds the outcome of sending the txs of all received bridge outgoing data. In dry-run mode, txs are
+// built and signed, but not sent.
+type OperationsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*OutGoingDataResult  `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationsResult) Reset() {
+	*x = OperationsResult{}
+	mi := &file_bridgeOperationsResult_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationsResult) ProtoMessage() {}
+
+func (x *OperationsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bridgeOperationsResult_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationsResult.ProtoReflect.Descriptor instead.
+func (*OperationsResult) Descriptor() ([]byte, []int) {
+	return file_bridgeOperationsResult_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OperationsResult) GetResults() []*OutGoingDataResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *OperationsResult) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// OutGoingDataResult holds the outcome of sending the txs of a bridge outgoing data. A bridge outgoing data held until
+// the validator set of its epoch is rotated on-chain has no txs, since it is journaled and sent in background once the
+// validator set change of its epoch is confirmed.
+type OutGoingDataResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Txs           []*TxResult            `protobuf:"bytes,2,rep,name=Txs,proto3" json:"Txs,omitempty"`
+	Stage         ErrorStage             `protobuf:"varint,3,opt,name=Stage,proto3,enum=bridge.ErrorStage" json:"Stage,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	Held          bool                   `protobuf:"varint,5,opt,name=Held,proto3" json:"Held,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutGoingDataResult) Reset() {
+	*x = OutGoingDataResult{}
+	mi := &file_bridgeOperationsResult_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutGoingDataResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutGoingDataResult) ProtoMessage() {}
+
+func (x *OutGoingDataResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bridgeOperationsResult_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutGoingDataResult.ProtoReflect.Descriptor instead.
+func (*OutGoingDataResult) Descriptor() ([]byte, []int) {
+	return file_bridgeOperationsResult_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OutGoingDataResult) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *OutGoingDataResult) GetTxs() []*TxResult {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *OutGoingDataResult) GetStage() ErrorStage {
+	if x != nil {
+		return x.Stage
+	}
+	return ErrorStage_None
+}
+
+func (x *OutGoingDataResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OutGoingDataResult) GetHeld() bool {
+	if x != nil {
+		return x.Held
+	}
+	return false
+}
+
+// TxResult holds the hash of a sent bridge tx or the error which prevented sending it. A tx held until the tx it depends
+// on is confirmed has neither, since it is sent in background once its dependency is confirmed. In dry-run mode, it
+// holds the json encoded signed tx instead of its hash.
+type TxResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	Hash          string                 `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Stage         ErrorStage             `protobuf:"varint,3,opt,name=Stage,proto3,enum=bridge.ErrorStage" json:"Stage,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	Tx            string                 `protobuf:"bytes,5,opt,name=Tx,proto3" json:"Tx,omitempty"`
+	Held          bool                   `protobuf:"varint,6,opt,name=Held,proto3" json:"Held,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxResult) Reset() {
+	*x = TxResult{}
+	mi := &file_bridgeOperationsResult_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxResult) ProtoMessage() {}
+
+func (x *TxResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bridgeOperationsResult_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
+func (*TxResult) Descriptor() ([]byte, []int) {
+	return file_bridgeOperationsResult_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TxResult) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TxResult) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TxResult) GetStage() ErrorStage {
+	if x != nil {
+		return x.Stage
+	}
+	return ErrorStage_None
+}
+
+func (x *TxResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TxResult) GetTx() string {
+	if x != nil {
+		return x.Tx
+	}
+	return ""
+}
+
+func (x *TxResult) GetHeld() bool {
+	if x != nil {
+		return x.Held
+	}
+	return false
+}
+
+var File_bridgeOperationsResult_proto protoreflect.FileDescriptor
+
+var file_bridgeOperationsResult_proto_rawDesc = string([]byte{
+	0x0a, 0x1c, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x47, 0x6f, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x12, 0x4f, 0x75, 0x74,
+	0x47, 0x6f, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x03, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x65, 0x6c, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x48, 0x65, 0x6c, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x08,
+	0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x28, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x54, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x54, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x48, 0x65, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x48, 0x65, 0x6c, 0x64, 0x2a, 0x75, 0x0a, 0x0a, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x10,
+	0x04, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x10, 0x05,
+	0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x10, 0x06,
+	0x12, 0x09, 0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x10, 0x07, 0x42, 0x42, 0x5a, 0x40, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x78, 0x2f, 0x6d, 0x78, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2d, 0x73, 0x6f,
+	0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2d, 0x67,
+	0x6f, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x3b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_bridgeOperationsResult_proto_rawDescOnce sync.Once
+	file_bridgeOperationsResult_proto_rawDescData []byte
+)
+
+func file_bridgeOperationsResult_proto_rawDescGZIP() []byte {
+	file_bridgeOperationsResult_proto_rawDescOnce.Do(func() {
+		file_bridgeOperationsResult_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bridgeOperationsResult_proto_rawDesc), len(file_bridgeOperationsResult_proto_rawDesc)))
+	})
+	return file_bridgeOperationsResult_proto_rawDescData
+}
+
+var file_bridgeOperationsResult_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_bridgeOperationsResult_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_bridgeOperationsResult_proto_goTypes = []any{
+	(ErrorStage)(0),            // 0: bridge.ErrorStage
+	(*OperationsResult)(nil),   // 1: bridge.OperationsResult
+	(*OutGoingDataResult)(nil), // 2: bridge.OutGoingDataResult
+	(*TxResult)(nil),           // 3: bridge.TxResult
+}
+var file_bridgeOperationsResult_proto_depIdxs = []int32{
+	2, // 0: bridge.OperationsResult.Results:type_name -> bridge.OutGoingDataResult
+	3, // 1: bridge.OutGoingDataResult.Txs:type_name -> bridge.TxResult
+	0, // 2: bridge.OutGoingDataResult.Stage:type_name -> bridge.ErrorStage
+	0, // 3: bridge.TxResult.Stage:type_name -> bridge.ErrorStage
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_bridgeOperationsResult_proto_init() }
+func file_bridgeOperationsResult_proto_init() {
+	if File_bridgeOperationsResult_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bridgeOperationsResult_proto_rawDesc), len(file_bridgeOperationsResult_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_bridgeOperationsResult_proto_goTypes,
+		DependencyIndexes: file_bridgeOperationsResult_proto_depIdxs,
+		EnumInfos:         file_bridgeOperationsResult_proto_enumTypes,
+		MessageInfos:      file_bridgeOperationsResult_proto_msgTypes,
+	}.Build()
+	File_bridgeOperationsResult_proto = out.File
+	file_bridgeOperationsResult_proto_goTypes = nil
+	file_bridgeOperationsResult_proto_depIdxs = nil
+}
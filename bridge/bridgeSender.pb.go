@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: bridgeSender.proto
+
+package bridge
+
+import (
+	sovereign "github.com/multiversx/mx-chain-core-go/data/sovereign"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SendResponse holds the outcome of sending the txs of all received bridge outgoing data. In asynchronous mode, it
+// also holds the tickets of the accepted bridge outgoing data, whose txs are sent later.
+type SendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *OperationsResult      `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	Tickets       *Tickets               `protobuf:"bytes,2,opt,name=Tickets,proto3" json:"Tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendResponse) Reset() {
+	*x = SendResponse{}
+	mi := &file_bridgeSender_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendResponse) ProtoMessage() {}
+
+func (x *SendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bridgeSender_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendResponse.ProtoReflect.Descriptor instead.
+func (*SendResponse) Descriptor() ([]byte, []int) {
+	return file_bridgeSender_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SendResponse) GetResult() *OperationsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SendResponse) GetTickets() *Tickets {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+var File_bridgeSender_proto protoreflect.FileDescriptor
+
+var file_bridgeSender_proto_rawDesc = string([]byte{
+	0x0a, 0x12, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x1a, 0x0f, 0x73, 0x6f,
+	0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x6b, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x52, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x32, 0x53, 0x0a,
+	0x0c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a,
+	0x0e, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1b, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x14, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x78, 0x2f, 0x6d, 0x78, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2d, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2d, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2d, 0x67, 0x6f, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x3b,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_bridgeSender_proto_rawDescOnce sync.Once
+	file_bridgeSender_proto_rawDescData []byte
+)
+
+func file_bridgeSender_proto_rawDescGZIP() []byte {
+	file_bridgeSender_proto_rawDescOnce.Do(func() {
+		file_bridgeSender_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bridgeSender_proto_rawDesc), len(file_bridgeSender_proto_rawDesc)))
+	})
+	return file_bridgeSender_proto_rawDescData
+}
+
+var file_bridgeSender_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_bridgeSender_proto_goTypes = []any{
+	(*SendResponse)(nil),               // 0: bridge.SendResponse
+	(*OperationsResult)(nil),           // 1: bridge.OperationsResult
+	(*Tickets)(nil),                    // 2: bridge.Tickets
+	(*sovereign.BridgeOperations)(nil), // 3: sovereign.BridgeOperations
+}
+var file_bridgeSender_proto_depIdxs = []int32{
+	1, // 0: bridge.SendResponse.Result:type_name -> bridge.OperationsResult
+	2, // 1: bridge.SendResponse.Tickets:type_name -> bridge.Tickets
+	3, // 2: bridge.BridgeSender.SendOperations:input_type -> sovereign.BridgeOperations
+	0, // 3: bridge.BridgeSender.SendOperations:output_type -> bridge.SendResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_bridgeSender_proto_init() }
+func file_bridgeSender_proto_init() {
+	if File_bridgeSender_proto != nil {
+		return
+	}
+	file_bridgeOperationsResult_proto_init()
+	file_bridgeTickets_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bridgeSender_proto_rawDesc), len(file_bridgeSender_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bridgeSender_proto_goTypes,
+		DependencyIndexes: file_bridgeSender_proto_depIdxs,
+		MessageInfos:      file_bridgeSender_proto_msgTypes,
+	}.Build()
+	File_bridgeSender_proto = out.File
+	file_bridgeSender_proto_goTypes = nil
+	file_bridgeSender_proto_depIdxs = nil
+}
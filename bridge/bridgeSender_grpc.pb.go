@@ -0,0 +1,130 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: bridgeSender.proto
+
+package bridge
+
+import (
+	context "context"
+	sovereign "github.com/multiversx/mx-chain-core-go/data/sovereign"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BridgeSender_SendOperations_FullMethodName = "/bridge.BridgeSender/SendOperations"
+)
+
+// BridgeSenderClient is the client API for BridgeSender service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BridgeSender sends bridge operations to main chain, returning their outcome in the response body
+type BridgeSenderClient interface {
+	// SendOperations sends the txs of the received bridge operations. Bridge outgoing data which could not be sent is
+	// reported in the response, without failing the call.
+	SendOperations(ctx context.Context, in *sovereign.BridgeOperations, opts ...grpc.CallOption) (*SendResponse, error)
+}
+
+type bridgeSenderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBridgeSenderClient(cc grpc.ClientConnInterface) BridgeSenderClient {
+	return &bridgeSenderClient{cc}
+}
+
+func (c *bridgeSenderClient) SendOperations(ctx context.Context, in *sovereign.BridgeOperations, opts ...grpc.CallOption) (*SendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendResponse)
+	err := c.cc.Invoke(ctx, BridgeSender_SendOperations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BridgeSenderServer is the server API for BridgeSender service.
+// All implementations must embed UnimplementedBridgeSenderServer
+// for forward compatibility.
+//
+// BridgeSender sends bridge operations to main chain, returning their outcome in the response body
+type BridgeSenderServer interface {
+	// SendOperations sends the txs of the received bridge operations. Bridge outgoing data which could not be sent is
+	// reported in the response, without failing the call.
+	SendOperations(context.Context, *sovereign.BridgeOperations) (*SendResponse, error)
+	mustEmbedUnimplementedBridgeSenderServer()
+}
+
+// UnimplementedBridgeSenderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBridgeSenderServer struct{}
+
+func (UnimplementedBridgeSenderServer) SendOperations(context.Context, *sovereign.BridgeOperations) (*SendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendOperations not implemented")
+}
+func (UnimplementedBridgeSenderServer) mustEmbedUnimplementedBridgeSenderServer() {}
+func (UnimplementedBridgeSenderServer) testEmbeddedByValue()                      {}
+
+// UnsafeBridgeSenderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BridgeSenderServer will
+// result in compilation errors.
+type UnsafeBridgeSenderServer interface {
+	mustEmbedUnimplementedBridgeSenderServer()
+}
+
+func RegisterBridgeSenderServer(s grpc.ServiceRegistrar, srv BridgeSenderServer) {
+	// If the following call pancis, it indicates UnimplementedBridgeSenderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BridgeSender_ServiceDesc, srv)
+}
+
+func _BridgeSender_SendOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sovereign.BridgeOperations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeSenderServer).SendOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeSender_SendOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeSenderServer).SendOperations(ctx, req.(*sovereign.BridgeOperations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BridgeSender_ServiceDesc is the grpc.ServiceDesc for BridgeSender service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BridgeSender_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bridge.BridgeSender",
+	HandlerType: (*BridgeSenderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendOperations",
+			Handler:    _BridgeSender_SendOperations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bridgeSender.proto",
+}
@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: bridgeTickets.proto
+
+package bridge
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TicketStatus defines the submission status of a bridge outgoing data accepted in asynchronous mode
+type TicketStatus int32
+
+const (
+	TicketStatus_Unknown    TicketStatus = 0
+	TicketStatus_Queued     TicketStatus = 1
+	TicketStatus_Submitting TicketStatus = 2
+	TicketStatus_Submitted  TicketStatus = 3
+	TicketStatus_Failed     TicketStatus = 4
+)
+
+// Enum value maps for TicketStatus.
+var (
+	TicketStatus_name = map[int32]string{
+		0: "Unknown",
+		1: "Queued",
+		2: "Submitting",
+		3: "Submitted",
+		4: "Failed",
+	}
+	TicketStatus_value = map[string]int32{
+		"Unknown":    0,
+		"Queued":     1,
+		"Submitting": 2,
+		"Submitted":  3,
+		"Failed":     4,
+	}
+)
+
+func (x TicketStatus) Enum() *TicketStatus {
+	p := new(TicketStatus)
+	*p = x
+	return p
+}
+
+func (x TicketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bridgeTickets_proto_enumTypes[0].Descriptor()
+}
+
+func (TicketStatus) Type() protoreflect.EnumType {
+	return &file_bridgeTickets_proto_enumTypes[0]
+}
+
+func (x TicketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketStatus.Descriptor instead.
+func (TicketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bridgeTickets_proto_rawDescGZIP(), []int{0}
+}
+
+// Ticket holds the submission status of a bridge outgoing data accepted in asynchronous mode, identified by its
+// hash. Once submitted or failed, it holds the outcome of sending its txs. While its txs are held or sent again after a
+// failed attempt, it remains submitting and holds the outcome of the last attempt.
+type Ticket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Status        TicketStatus           `protobuf:"varint,2,opt,name=Status,proto3,enum=bridge.TicketStatus" json:"Status,omitempty"`
+	Result        *OutGoingDataResult    `protobuf:"bytes,3,opt,name=Result,proto3" json:"Result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	mi := &file_bridgeTickets_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_bridgeTickets_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_bridgeTickets_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ticket) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Ticket) GetStatus() TicketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TicketStatus_Unknown
+}
+
+func (x *Ticket) GetResult() *OutGoingDataResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Tickets holds the tickets of all bridge outgoing data accepted by a request
+type Tickets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*Ticket              `protobuf:"bytes,1,rep,name=Tickets,proto3" json:"Tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tickets) Reset() {
+	*x = Tickets{}
+	mi := &file_bridgeTickets_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tickets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tickets) ProtoMessage() {}
+
+func (x *Tickets) ProtoReflect() protoreflect.Message {
+	mi := &file_bridgeTickets_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tickets.ProtoReflect.Descriptor instead.
+func (*Tickets) Descriptor() ([]byte, []int) {
+	return file_bridgeTickets_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Tickets) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// TicketRequest is the request for the ticket of the bridge outgoing data with the provided hash
+type TicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketRequest) Reset() {
+	*x = TicketRequest{}
+	mi := &file_bridgeTickets_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketRequest) ProtoMessage() {}
+
+func (x *TicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bridgeTickets_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketRequest.ProtoReflect.Descriptor instead.
+func (*TicketRequest) Descriptor() ([]byte, []int) {
+	return file_bridgeTickets_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TicketRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+var File_bridgeTickets_proto protoreflect.FileDescriptor
+
+var file_bridgeTickets_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x1a, 0x1c, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7e, 0x0a, 0x06, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2c, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x4f, 0x75, 0x74, 0x47, 0x6f, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x33, 0x0a, 0x07, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x22, 0x23, 0x0a, 0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x48, 0x61, 0x73, 0x68, 0x2a, 0x52, 0x0a, 0x0c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0e,
+	0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0a, 0x0a,
+	0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x32, 0x43, 0x0a, 0x0d, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x42,
+	0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x78, 0x2f, 0x6d, 0x78, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2d, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2d, 0x67, 0x6f, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x3b, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_bridgeTickets_proto_rawDescOnce sync.Once
+	file_bridgeTickets_proto_rawDescData []byte
+)
+
+func file_bridgeTickets_proto_rawDescGZIP() []byte {
+	file_bridgeTickets_proto_rawDescOnce.Do(func() {
+		file_bridgeTickets_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bridgeTickets_proto_rawDesc), len(file_bridgeTickets_proto_rawDesc)))
+	})
+	return file_bridgeTickets_proto_rawDescData
+}
+
+var file_bridgeTickets_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_bridgeTickets_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_bridgeTickets_proto_goTypes = []any{
+	(TicketStatus)(0),          // 0: bridge.TicketStatus
+	(*Ticket)(nil),             // 1: bridge.Ticket
+	(*Tickets)(nil),            // 2: bridge.Tickets
+	(*TicketRequest)(nil),      // 3: bridge.TicketRequest
+	(*OutGoingDataResult)(nil), // 4: bridge.OutGoingDataResult
+}
+var file_bridgeTickets_proto_depIdxs = []int32{
+	0, // 0: bridge.Ticket.Status:type_name -> bridge.TicketStatus
+	4, // 1: bridge.Ticket.Result:type_name -> bridge.OutGoingDataResult
+	1, // 2: bridge.Tickets.Tickets:type_name -> bridge.Ticket
+	3, // 3: bridge.BridgeTickets.GetTicket:input_type -> bridge.TicketRequest
+	1, // 4: bridge.BridgeTickets.GetTicket:output_type -> bridge.Ticket
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_bridgeTickets_proto_init() }
+func file_bridgeTickets_proto_init() {
+	if File_bridgeTickets_proto != nil {
+		return
+	}
+	file_bridgeOperationsResult_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bridgeTickets_proto_rawDesc), len(file_bridgeTickets_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bridgeTickets_proto_goTypes,
+		DependencyIndexes: file_bridgeTickets_proto_depIdxs,
+		EnumInfos:         file_bridgeTickets_proto_enumTypes,
+		MessageInfos:      file_bridgeTickets_proto_msgTypes,
+	}.Build()
+	File_bridgeTickets_proto = out.File
+	file_bridgeTickets_proto_goTypes = nil
+	file_bridgeTickets_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: bridgeTickets.proto
+
+package bridge
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BridgeTickets_GetTicket_FullMethodName = "/bridge.BridgeTickets/GetTicket"
+)
+
+// BridgeTicketsClient is the client API for BridgeTickets service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BridgeTickets provides the submission status of bridge operations accepted in asynchronous mode
+type BridgeTicketsClient interface {
+	// GetTicket returns the ticket of the bridge outgoing data with the requested hash
+	GetTicket(ctx context.Context, in *TicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+}
+
+type bridgeTicketsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBridgeTicketsClient(cc grpc.ClientConnInterface) BridgeTicketsClient {
+	return &bridgeTicketsClient{cc}
+}
+
+func (c *bridgeTicketsClient) GetTicket(ctx context.Context, in *TicketRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, BridgeTickets_GetTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BridgeTicketsServer is the server API for BridgeTickets service.
+// All implementations must embed UnimplementedBridgeTicketsServer
+// for forward compatibility.
+//
+// BridgeTickets provides the submission status of bridge operations accepted in asynchronous mode
+type BridgeTicketsServer interface {
+	// GetTicket returns the ticket of the bridge outgoing data with the requested hash
+	GetTicket(context.Context, *TicketRequest) (*Ticket, error)
+	mustEmbedUnimplementedBridgeTicketsServer()
+}
+
+// UnimplementedBridgeTicketsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBridgeTicketsServer struct{}
+
+func (UnimplementedBridgeTicketsServer) GetTicket(context.Context, *TicketRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
+}
+func (UnimplementedBridgeTicketsServer) mustEmbedUnimplementedBridgeTicketsServer() {}
+func (UnimplementedBridgeTicketsServer) testEmbeddedByValue()                       {}
+
+// UnsafeBridgeTicketsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BridgeTicketsServer will
+// result in compilation errors.
+type UnsafeBridgeTicketsServer interface {
+	mustEmbedUnimplementedBridgeTicketsServer()
+}
+
+func RegisterBridgeTicketsServer(s grpc.ServiceRegistrar, srv BridgeTicketsServer) {
+	// If the following call pancis, it indicates UnimplementedBridgeTicketsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BridgeTickets_ServiceDesc, srv)
+}
+
+func _BridgeTickets_GetTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeTicketsServer).GetTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeTickets_GetTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeTicketsServer).GetTicket(ctx, req.(*TicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BridgeTickets_ServiceDesc is the grpc.ServiceDesc for BridgeTickets service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BridgeTickets_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bridge.BridgeTickets",
+	HandlerType: (*BridgeTicketsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTicket",
+			Handler:    _BridgeTickets_GetTicket_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bridgeTickets.proto",
+}
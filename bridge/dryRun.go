@@ -0,0 +1,33 @@
+package bridge
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// DryRunMetadataKey is the grpc request metadata key which, set to "true", makes the server build and sign the txs of
+// the request without sending them
+const DryRunMetadataKey = "bridge-dry-run"
+
+// WithDryRun returns an outgoing grpc context which requests the server to only build and sign the txs
+func WithDryRun(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, DryRunMetadataKey, strconv.FormatBool(true))
+}
+
+// IsDryRunRequested returns true if the incoming grpc request metadata requests a dry-run
+func IsDryRunRequested(ctx context.Context) bool {
+	md, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return false
+	}
+
+	values := md.Get(DryRunMetadataKey)
+	if len(values) == 0 {
+		return false
+	}
+
+	isDryRun, err := strconv.ParseBool(values[0])
+	return err == nil && isDryRun
+}
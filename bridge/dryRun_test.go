@@ -0,0 +1,21 @@
+package bridge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestIsDryRunRequested(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, IsDryRunRequested(context.Background()))
+	require.False(t, IsDryRunRequested(metadata.NewIncomingContext(context.Background(), metadata.Pairs("other", "true"))))
+	require.False(t, IsDryRunRequested(metadata.NewIncomingContext(context.Background(), metadata.Pairs(DryRunMetadataKey, "false"))))
+	require.False(t, IsDryRunRequested(metadata.NewIncomingContext(context.Background(), metadata.Pairs(DryRunMetadataKey, "invalid"))))
+
+	outgoingMd, _ := metadata.FromOutgoingContext(WithDryRun(context.Background()))
+	require.True(t, IsDryRunRequested(metadata.NewIncomingContext(context.Background(), outgoingMd)))
+}
@@ -0,0 +1,11 @@
+package bridge
+
+import "errors"
+
+var errNoResultInMetadata = errors.New("no bridge operations result found in metadata")
+
+var errBridgeDataFailed = errors.New("could not send bridge outgoing data txs")
+
+var errBridgeTxFailed = errors.New("could not send bridge tx")
+
+var errNoTicketsInMetadata = errors.New("no bridge tickets found in metadata")
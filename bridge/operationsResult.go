@@ -0,0 +1,105 @@
+package bridge
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// ResultMetadataKey is the grpc header metadata key under which the proto encoded OperationsResult is sent
+const ResultMetadataKey = "bridge-operations-result-bin"
+
+// TxHashes returns the hashes of all sent txs, for all bridge outgoing data
+func (x *OperationsResult) TxHashes() []string {
+	txHashes := make([]string, 0)
+	for _, result := range x.GetResults() {
+		txHashes = append(txHashes, result.TxHashes()...)
+	}
+
+	return txHashes
+}
+
+// Err returns an error describing all failed bridge outgoing data and txs, or nil if everything was sent
+func (x *OperationsResult) Err() error {
+	errs := make([]error, 0)
+	for _, result := range x.GetResults() {
+		errs = append(errs, result.errs()...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ToMetadata returns the grpc metadata holding the proto encoded result
+func (x *OperationsResult) ToMetadata() (metadata.MD, error) {
+	buff, err := proto.Marshal(x)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.Pairs(ResultMetadataKey, string(buff)), nil
+}
+
+// OperationsResultFromMetadata decodes the result from the grpc metadata received from the server
+func OperationsResultFromMetadata(md metadata.MD) (*OperationsResult, error) {
+	values := md.Get(ResultMetadataKey)
+	if len(values) == 0 {
+		return nil, errNoResultInMetadata
+	}
+
+	result := &OperationsResult{}
+	err := proto.Unmarshal([]byte(values[0]), result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// TxHashes returns the hashes of all sent txs of the bridge outgoing data
+func (x *OutGoingDataResult) TxHashes() []string {
+	txHashes := make([]string, 0, len(x.GetTxs()))
+	for _, tx := range x.GetTxs() {
+		if len(tx.GetHash()) != 0 {
+			txHashes = append(txHashes, tx.GetHash())
+		}
+	}
+
+	return txHashes
+}
+
+// Failed returns true if the bridge outgoing data or any of its txs failed
+func (x *OutGoingDataResult) Failed() bool {
+	return len(x.errs()) != 0
+}
+
+func (x *OutGoingDataResult) errs() []error {
+	hash := hex.EncodeToString(x.GetHash())
+	errs := make([]error, 0)
+	if x.GetStage() != ErrorStage_None {
+		errs = append(errs, fmt.Errorf("%w, hash = %s, stage = %s, error = %s",
+			errBridgeDataFailed, hash, x.GetStage(), x.GetError()))
+	}
+
+	for _, tx := range x.GetTxs() {
+		if tx.Failed() {
+			errs = append(errs, fmt.Errorf("%w, hash = %s, endpoint = %s, stage = %s, error = %s",
+				errBridgeTxFailed, hash, tx.Endpoint(), tx.GetStage(), tx.GetError()))
+		}
+	}
+
+	return errs
+}
+
+// Endpoint returns the contract endpoint called by the tx
+func (x *TxResult) Endpoint() string {
+	return strings.SplitN(string(x.GetData()), "@", 2)[0]
+}
+
+// Failed returns true if the tx could not be sent or its state could not be journaled
+func (x *TxResult) Failed() bool {
+	return x.GetStage() != ErrorStage_None
+}
@@ -0,0 +1,71 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+func createOperationsResult() *OperationsResult {
+	return &OperationsResult{
+		Results: []*OutGoingDataResult{
+			{
+				Hash: []byte("hash1"),
+				Txs: []*TxResult{
+					{Data: []byte("registerBridgeOps@01"), Hash: "txHash1"},
+					{Data: []byte("executeBridgeOps@02"), Stage: ErrorStage_Nonce, Error: "nonce error"},
+				},
+			},
+			{
+				Hash:  []byte("hash2"),
+				Stage: ErrorStage_Formatting,
+				Error: "format error",
+			},
+			{
+				Hash: []byte("hash3"),
+				Txs:  []*TxResult{{Data: []byte("executeBridgeOps@03"), Hash: "txHash3"}},
+			},
+		},
+	}
+}
+
+func TestOperationsResult_TxHashes(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{"txHash1", "txHash3"}, createOperationsResult().TxHashes())
+	require.Empty(t, (&OperationsResult{}).TxHashes())
+}
+
+func TestOperationsResult_Err(t *testing.T) {
+	t.Parallel()
+
+	result := createOperationsResult()
+	require.True(t, result.Results[0].Failed())
+	require.True(t, result.Results[1].Failed())
+	require.False(t, result.Results[2].Failed())
+
+	err := result.Err()
+	require.ErrorIs(t, err, errBridgeTxFailed)
+	require.ErrorIs(t, err, errBridgeDataFailed)
+	require.Contains(t, err.Error(), "endpoint = executeBridgeOps, stage = Nonce, error = nonce error")
+	require.Contains(t, err.Error(), "stage = Formatting, error = format error")
+
+	require.Nil(t, (&OperationsResult{Results: result.Results[2:]}).Err())
+}
+
+func TestOperationsResult_Metadata(t *testing.T) {
+	t.Parallel()
+
+	_, err := OperationsResultFromMetadata(metadata.MD{})
+	require.Equal(t, errNoResultInMetadata, err)
+
+	result := createOperationsResult()
+	md, err := result.ToMetadata()
+	require.Nil(t, err)
+
+	decodedResult, err := OperationsResultFromMetadata(md)
+	require.Nil(t, err)
+	require.True(t, proto.Equal(result, decodedResult))
+}
@@ -0,0 +1,55 @@
+package bridge
+
+import (
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// TicketsMetadataKey is the grpc header metadata key under which the proto encoded Tickets of a request accepted in
+// asynchronous mode are sent
+const TicketsMetadataKey = "bridge-tickets-bin"
+
+// NewFinishedTicket returns the ticket of a bridge outgoing data whose txs were sent, holding the outcome of sending them
+func NewFinishedTicket(result *OutGoingDataResult) *Ticket {
+	status := TicketStatus_Submitted
+	if result.Failed() {
+		status = TicketStatus_Failed
+	}
+
+	return &Ticket{
+		Hash:   result.GetHash(),
+		Status: status,
+		Result: result,
+	}
+}
+
+// IsFinished returns true if the txs of the bridge outgoing data were sent or could not be sent
+func (x *Ticket) IsFinished() bool {
+	return x.GetStatus() == TicketStatus_Submitted || x.GetStatus() == TicketStatus_Failed
+}
+
+// ToMetadata returns the grpc metadata holding the proto encoded tickets
+func (x *Tickets) ToMetadata() (metadata.MD, error) {
+	buff, err := proto.Marshal(x)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.Pairs(TicketsMetadataKey, string(buff)), nil
+}
+
+// TicketsFromMetadata decodes the tickets from the grpc metadata received from the server
+func TicketsFromMetadata(md metadata.MD) (*Tickets, error) {
+	values := md.Get(TicketsMetadataKey)
+	if len(values) == 0 {
+		return nil, errNoTicketsInMetadata
+	}
+
+	tickets := &Tickets{}
+	err := proto.Unmarshal([]byte(values[0]), tickets)
+	if err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
@@ -0,0 +1,48 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewFinishedTicket(t *testing.T) {
+	t.Parallel()
+
+	results := createOperationsResult().Results
+
+	ticket := NewFinishedTicket(results[0])
+	require.Equal(t, []byte("hash1"), ticket.Hash)
+	require.Equal(t, TicketStatus_Failed, ticket.Status)
+	require.Equal(t, results[0], ticket.Result)
+	require.True(t, ticket.IsFinished())
+
+	ticket = NewFinishedTicket(results[2])
+	require.Equal(t, TicketStatus_Submitted, ticket.Status)
+	require.True(t, ticket.IsFinished())
+
+	require.False(t, (&Ticket{Status: TicketStatus_Queued}).IsFinished())
+	require.False(t, (&Ticket{Status: TicketStatus_Submitting}).IsFinished())
+}
+
+func TestTickets_Metadata(t *testing.T) {
+	t.Parallel()
+
+	_, err := TicketsFromMetadata(metadata.MD{})
+	require.Equal(t, errNoTicketsInMetadata, err)
+
+	tickets := &Tickets{
+		Tickets: []*Ticket{
+			{Hash: []byte("hash1"), Status: TicketStatus_Queued},
+			{Hash: []byte("hash2"), Status: TicketStatus_Queued},
+		},
+	}
+	md, err := tickets.ToMetadata()
+	require.Nil(t, err)
+
+	decodedTickets, err := TicketsFromMetadata(md)
+	require.Nil(t, err)
+	require.True(t, proto.Equal(tickets, decodedTickets))
+}
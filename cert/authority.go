@@ -0,0 +1,145 @@
+package cert
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+)
+
+// ArgsSignedCertificate holds necessary config to issue a certificate signed by a certificate authority. Ext key usage
+// distinguishes server certificates from client ones.
+type ArgsSignedCertificate struct {
+	CertCfg     CertCfg
+	CertFileCfg FileCfg
+	CAFileCfg   FileCfg
+	ExtKeyUsage x509.ExtKeyUsage
+}
+
+// GenerateCA will generate a self-signed certificate authority and its private key
+func GenerateCA(cfg CertCfg) ([]byte, *rsa.PrivateKey, error) {
+	pk, err := rsa.GenerateKey(rand.Reader, 4096)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, err := createTemplate(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template.IsCA = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, pk, nil
+}
+
+// GenerateCAFiles will generate a certificate authority and its private key files
+func GenerateCAFiles(cfg CertificateCfg) error {
+	cert, pk, err := GenerateCA(cfg.CertCfg)
+	if err != nil {
+		return err
+	}
+
+	return writeCertFiles(cfg.CertFileCfg, cert, pk)
+}
+
+// GenerateSignedCert will generate a certificate and private key signed by the provided certificate authority
+func GenerateSignedCert(cfg CertCfg, ca *x509.Certificate, caPk *rsa.PrivateKey, extKeyUsage x509.ExtKeyUsage) ([]byte, *rsa.PrivateKey, error) {
+	if !ca.IsCA {
+		return nil, nil, errNotCA
+	}
+
+	pk, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, err := createTemplate(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(cfg.DNSName) != 0 {
+		template.DNSNames = []string{cfg.DNSName}
+	}
+	ip := net.ParseIP(cfg.IPAddress)
+	if ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	}
+	template.KeyUsage = x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = []x509.ExtKeyUsage{extKeyUsage}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, ca, pk.Public(), caPk)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, pk, nil
+}
+
+// GenerateSignedCertFiles will generate a certificate and private key files signed by the certificate authority
+// loaded from files
+func GenerateSignedCertFiles(args ArgsSignedCertificate) error {
+	ca, caPk, err := LoadCA(args.CAFileCfg)
+	if err != nil {
+		return err
+	}
+
+	cert, pk, err := GenerateSignedCert(args.CertCfg, ca, caPk, args.ExtKeyUsage)
+	if err != nil {
+		return err
+	}
+
+	return writeCertFiles(args.CertFileCfg, cert, pk)
+}
+
+// LoadCA will load a certificate authority and its private key from files
+func LoadCA(cfg FileCfg) (*x509.Certificate, *rsa.PrivateKey, error) {
+	certBlock, err := readPEMBlock(cfg.CertFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ca, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ca.IsCA {
+		return nil, nil, fmt.Errorf("%w, cert file: %s", errNotCA, cfg.CertFile)
+	}
+
+	pkBlock, err := readPEMBlock(cfg.PkFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pk, err := x509.ParsePKCS1PrivateKey(pkBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ca, pk, nil
+}
+
+func readPEMBlock(file string) (*pem.Block, error) {
+	buff, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(buff)
+	if block == nil {
+		return nil, fmt.Errorf("%w, file: %s", errInvalidPEMFile, file)
+	}
+
+	return block, nil
+}
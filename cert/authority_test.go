@@ -0,0 +1,145 @@
+package cert
+
+import (
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createCA(t *testing.T) FileCfg {
+	caCfg := createFileCfg(t)
+	err := GenerateCAFiles(CertificateCfg{
+		CertCfg: CertCfg{
+			Organization: "MultiversX",
+			CommonName:   "MultiversX CA",
+			Availability: 1,
+		},
+		CertFileCfg: caCfg,
+	})
+	require.Nil(t, err)
+
+	return caCfg
+}
+
+func issueCert(t *testing.T, caCfg FileCfg, commonName string, extKeyUsage x509.ExtKeyUsage) FileCfg {
+	certCfg := createFileCfg(t)
+	err := GenerateSignedCertFiles(ArgsSignedCertificate{
+		CertCfg: CertCfg{
+			Organization: "MultiversX",
+			CommonName:   commonName,
+			DNSName:      "localhost",
+			IPAddress:    "127.0.0.1",
+			Availability: 1,
+		},
+		CertFileCfg: certCfg,
+		CAFileCfg:   caCfg,
+		ExtKeyUsage: extKeyUsage,
+	})
+	require.Nil(t, err)
+
+	certCfg.CAFile = caCfg.CertFile
+	return certCfg
+}
+
+func TestGenerateSignedCertFiles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing ca files", func(t *testing.T) {
+		err := GenerateSignedCertFiles(ArgsSignedCertificate{
+			CertFileCfg: createFileCfg(t),
+			CAFileCfg:   createFileCfg(t),
+			ExtKeyUsage: x509.ExtKeyUsageServerAuth,
+		})
+		require.NotNil(t, err)
+	})
+	t.Run("ca is not a certificate authority", func(t *testing.T) {
+		selfSignedCfg := createFileCfg(t)
+		err := GenerateCertFiles(CertificateCfg{
+			CertCfg: CertCfg{
+				Organization: "MultiversX",
+				DNSName:      "localhost",
+				IPAddress:    "127.0.0.1",
+				Availability: 1,
+			},
+			CertFileCfg: selfSignedCfg,
+		})
+		require.Nil(t, err)
+
+		err = GenerateSignedCertFiles(ArgsSignedCertificate{
+			CertFileCfg: createFileCfg(t),
+			CAFileCfg:   selfSignedCfg,
+			ExtKeyUsage: x509.ExtKeyUsageServerAuth,
+		})
+		require.ErrorIs(t, err, errNotCA)
+	})
+	t.Run("should work", func(t *testing.T) {
+		caCfg := createCA(t)
+		serverCfg := issueCert(t, caCfg, "bridge server", x509.ExtKeyUsageServerAuth)
+
+		infos, err := InspectCertificates(serverCfg.CertFile)
+		require.Nil(t, err)
+		require.Len(t, infos, 1)
+		require.Equal(t, "CN=bridge server,O=MultiversX", infos[0].Subject)
+		require.Equal(t, "CN=MultiversX CA,O=MultiversX", infos[0].Issuer)
+		require.Equal(t, []string{"localhost"}, infos[0].DNSNames)
+		require.Equal(t, []string{"127.0.0.1"}, infos[0].IPAddresses)
+		require.Equal(t, []string{"server auth"}, infos[0].ExtKeyUsages)
+		require.False(t, infos[0].IsCA)
+		require.Len(t, infos[0].Fingerprint, 64)
+
+		caInfos, err := InspectCertificates(caCfg.CertFile)
+		require.Nil(t, err)
+		require.True(t, caInfos[0].IsCA)
+	})
+}
+
+func TestCertificateAuthority_Handshake(t *testing.T) {
+	t.Parallel()
+
+	caCfg := createCA(t)
+	serverCfg := issueCert(t, caCfg, "bridge server", x509.ExtKeyUsageServerAuth)
+
+	serverReloader, err := NewCertificateReloader(serverCfg)
+	require.Nil(t, err)
+
+	t.Run("client signed by the ca", func(t *testing.T) {
+		clientReloader, err := NewCertificateReloader(issueCert(t, caCfg, "sovereign node 1", x509.ExtKeyUsageClientAuth))
+		require.Nil(t, err)
+
+		_, err = handshake(serverReloader.TLSServerConfig(), clientReloader.TLSClientConfig())
+		require.Nil(t, err)
+	})
+	t.Run("client signed by another ca", func(t *testing.T) {
+		clientReloader, err := NewCertificateReloader(issueCert(t, createCA(t), "sovereign node 1", x509.ExtKeyUsageClientAuth))
+		require.Nil(t, err)
+
+		_, err = handshake(serverReloader.TLSServerConfig(), clientReloader.TLSClientConfig())
+		require.NotNil(t, err)
+	})
+	t.Run("client holding a server certificate", func(t *testing.T) {
+		clientReloader, err := NewCertificateReloader(issueCert(t, caCfg, "sovereign node 1", x509.ExtKeyUsageServerAuth))
+		require.Nil(t, err)
+
+		_, err = handshake(serverReloader.TLSServerConfig(), clientReloader.TLSClientConfig())
+		require.NotNil(t, err)
+	})
+	t.Run("server holding a client certificate", func(t *testing.T) {
+		impostorReloader, err := NewCertificateReloader(issueCert(t, caCfg, "sovereign node 2", x509.ExtKeyUsageClientAuth))
+		require.Nil(t, err)
+		clientReloader, err := NewCertificateReloader(issueCert(t, caCfg, "sovereign node 1", x509.ExtKeyUsageClientAuth))
+		require.Nil(t, err)
+
+		_, err = handshake(impostorReloader.TLSServerConfig(), clientReloader.TLSClientConfig())
+		require.NotNil(t, err)
+	})
+	t.Run("missing ca file", func(t *testing.T) {
+		cfg := issueCert(t, caCfg, "sovereign node 1", x509.ExtKeyUsageClientAuth)
+		cfg.CAFile = filepath.Join(t.TempDir(), "missing.crt")
+
+		reloader, err := NewCertificateReloader(cfg)
+		require.Nil(t, reloader)
+		require.NotNil(t, err)
+	})
+}
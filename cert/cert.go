@@ -24,68 +24,93 @@ type CertificateCfg struct {
 	CertFileCfg FileCfg
 }
 
-// CertCfg holds necessary config to generate a certificate and private key
+// CertCfg holds necessary config to generate a certificate and private key. Common name identifies the certificate
+// holder, it defaults to the organization name.
 type CertCfg struct {
 	Organization string
+	CommonName   string
 	DNSName      string
 	IPAddress    string
 	Availability int64
 }
 
-// FileCfg holds necessary config for certificate files
+// FileCfg holds necessary config for certificate files. CA file is the bundle of certificate authorities trusted to
+// sign the peer certificates. If empty, only peers holding the same certificate are trusted. Peer certificates listed
+// in the CRL file, signed by a trusted certificate authority, are rejected. If the allowlist file is set, only peer
+// certificates whose fingerprint or subject common name is listed are accepted.
 type FileCfg struct {
-	CertFile string
-	PkFile   string
+	CertFile      string
+	PkFile        string
+	CAFile        string
+	CRLFile       string
+	AllowlistFile string
 }
 
 const day = time.Hour * 24
 
-// GenerateCert will generate a certificate and private key with specified configuration
+// GenerateCert will generate a self-signed certificate and private key with specified configuration
 func GenerateCert(cfg CertCfg) ([]byte, *rsa.PrivateKey, error) {
 	pk, err := rsa.GenerateKey(rand.Reader, 2048)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	template, err := createTemplate(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template.DNSNames = []string{cfg.DNSName}
+	template.IPAddresses = []net.IP{net.ParseIP(cfg.IPAddress)}
+	template.KeyUsage = x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, pk, nil
+}
+
+func createTemplate(cfg CertCfg) (*x509.Certificate, error) {
+	commonName := cfg.CommonName
+	if len(commonName) == 0 {
+		commonName = cfg.Organization
+	}
+
 	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	template := &x509.Certificate{
+	return &x509.Certificate{
 		SerialNumber: serialNumber,
 		Subject: pkix.Name{
 			Organization: []string{cfg.Organization},
-			CommonName:   cfg.Organization,
+			CommonName:   commonName,
 		},
-		DNSNames:              []string{cfg.DNSName},
-		IPAddresses:           []net.IP{net.ParseIP(cfg.IPAddress)},
 		NotBefore:             time.Now(),
 		NotAfter:              time.Now().Add(time.Duration(cfg.Availability) * day),
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
 		BasicConstraintsValid: true,
-	}
-
-	cert, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return cert, pk, nil
+	}, nil
 }
 
-// GenerateCertFiles will generate a certificate and private key files with specified configuration
+// GenerateCertFiles will generate a self-signed certificate and private key files with specified configuration
 func GenerateCertFiles(cfg CertificateCfg) error {
 	cert, pk, err := GenerateCert(cfg.CertCfg)
 	if err != nil {
 		return err
 	}
 
-	certOut, err := os.Create(cfg.CertFileCfg.CertFile)
+	return writeCertFiles(cfg.CertFileCfg, cert, pk)
+}
+
+func writeCertFiles(cfg FileCfg, cert []byte, pk *rsa.PrivateKey) error {
+	certOut, err := os.Create(cfg.CertFile)
 	if err != nil {
-		return fmt.Errorf("cannot create certificate file, cert file: %s,error: %w", cfg.CertFileCfg.CertFile, err)
+		return fmt.Errorf("cannot create certificate file, cert file: %s,error: %w", cfg.CertFile, err)
 	}
 	defer func() {
 		err = certOut.Close()
@@ -94,12 +119,12 @@ func GenerateCertFiles(cfg CertificateCfg) error {
 
 	err = pem.Encode(certOut, &pem.Block{Type: "CERTIFICATE", Bytes: cert})
 	if err != nil {
-		return fmt.Errorf("cannot create pem encoded file, cert file: %s,error: %w", cfg.CertFileCfg.CertFile, err)
+		return fmt.Errorf("cannot create pem encoded file, cert file: %s,error: %w", cfg.CertFile, err)
 	}
 
-	keyOut, err := os.Create(cfg.CertFileCfg.PkFile)
+	keyOut, err := os.OpenFile(cfg.PkFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
 	if err != nil {
-		return fmt.Errorf("cannot create certificate private key file, cert pk file: %s,error: %w", cfg.CertFileCfg.PkFile, err)
+		return fmt.Errorf("cannot create certificate private key file, cert pk file: %s,error: %w", cfg.PkFile, err)
 	}
 	defer func() {
 		err = keyOut.Close()
@@ -109,47 +134,81 @@ func GenerateCertFiles(cfg CertificateCfg) error {
 	pkBytes := x509.MarshalPKCS1PrivateKey(pk)
 	err = pem.Encode(keyOut, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: pkBytes})
 	if err != nil {
-		return fmt.Errorf("cannot create certificate pk file, cert pk file: %s,error: %w", cfg.CertFileCfg.PkFile, err)
+		return fmt.Errorf("cannot create certificate pk file, cert pk file: %s,error: %w", cfg.PkFile, err)
 	}
 
 	return nil
 }
 
-// LoadTLSServerConfig will load a tls server config
+// LoadTLSServerConfig will load a tls server config. Certificate files are reloaded on change, see NewCertificateReloader.
 func LoadTLSServerConfig(cfg FileCfg) (*tls.Config, error) {
-	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.PkFile)
+	reloader, err := NewCertificateReloader(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	certPool, err := createCertPool(cert)
+	return reloader.TLSServerConfig(), nil
+}
+
+// LoadTLSClientConfig will load a tls client config. Certificate files are reloaded on change, see NewCertificateReloader.
+func LoadTLSClientConfig(cfg FileCfg) (*tls.Config, error) {
+	reloader, err := NewCertificateReloader(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	return &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		ClientCAs:    certPool,
-		ClientAuth:   tls.RequireAndVerifyClientCert,
-	}, nil
+	return reloader.TLSClientConfig(), nil
 }
 
-// LoadTLSClientConfig will load a tls client config
-func LoadTLSClientConfig(cfg FileCfg) (*tls.Config, error) {
-	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.PkFile)
+// loadCertPool loads the trusted certificates from the CA file, if any, otherwise only the provided certificate is trusted
+func loadCertPool(cfg FileCfg, cert tls.Certificate) (*x509.CertPool, error) {
+	if len(cfg.CAFile) == 0 {
+		return createCertPool(cert)
+	}
+
+	caCerts, err := loadCACertificates(cfg.CAFile)
 	if err != nil {
 		return nil, err
 	}
 
-	certPool, err := createCertPool(cert)
+	certPool := x509.NewCertPool()
+	for _, caCert := range caCerts {
+		certPool.AddCert(caCert)
+	}
+
+	return certPool, nil
+}
+
+func loadCACertificates(caFile string) ([]*x509.Certificate, error) {
+	buff, err := os.ReadFile(caFile)
 	if err != nil {
 		return nil, err
 	}
 
-	return &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		RootCAs:      certPool,
-	}, nil
+	caCerts := make([]*x509.Certificate, 0)
+	for {
+		var block *pem.Block
+		block, buff = pem.Decode(buff)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		caCert, errParse := x509.ParseCertificate(block.Bytes)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		caCerts = append(caCerts, caCert)
+	}
+
+	if len(caCerts) == 0 {
+		return nil, fmt.Errorf("%w, ca file: %s", errNoCertificateInCAFile, caFile)
+	}
+
+	return caCerts, nil
 }
 
 func createCertPool(cert tls.Certificate) (*x509.CertPool, error) {
@@ -23,4 +23,31 @@ var (
 		Usage: "This flag specifies the certificate IP address",
 		Value: "127.0.0.1",
 	}
+	commonNameFlag = cli.StringFlag{
+		Name:  "name",
+		Usage: "This flag specifies the common name identifying the certificate holder, e.g. the sovereign node name. Defaults to the organization",
+	}
+	caCertFlag = cli.StringFlag{
+		Name:  "ca-cert",
+		Usage: "This flag specifies the certificate authority's certificate `file`",
+		Value: "ca.crt",
+	}
+	caKeyFlag = cli.StringFlag{
+		Name:  "ca-key",
+		Usage: "This flag specifies the certificate authority's private key `file`",
+		Value: "ca_private_key.pem",
+	}
+	certFileFlag = cli.StringFlag{
+		Name:  "cert",
+		Usage: "This flag specifies the certificate `file`. Defaults to <command>.crt",
+	}
+	keyFileFlag = cli.StringFlag{
+		Name:  "key",
+		Usage: "This flag specifies the private key `file`. Defaults to <command>_private_key.pem",
+	}
+	crlFileFlag = cli.StringFlag{
+		Name:  "crl",
+		Usage: "This flag specifies the certificate revocation list `file`, created if missing",
+		Value: "crl.pem",
+	}
 )
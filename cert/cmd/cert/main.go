@@ -1,7 +1,10 @@
 package main
 
 import (
+	"crypto/x509"
+	"fmt"
 	"os"
+	"strings"
 
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
@@ -24,7 +27,9 @@ func main() {
 		"->Ensuring Secure Transactions: Utilize the certificate-based authentication mechanism to ensure that only authorized sovereign nodes can access the hot wallet binary. " +
 		"This step is crucial in maintaining the integrity and security of transactions being sent from the sovereign shards to the main chain.\n" +
 		"->Ongoing Security Measures: Regularly review and update the certificate mechanism to maintain security. This includes renewal of certificates, " +
-		"implementing security best practices, and promptly revoking access for compromised or unauthorized clients."
+		"implementing security best practices, and promptly revoking access for compromised or unauthorized clients.\n" +
+		"->Certificate Authority: Instead of sharing one certificate, create a certificate authority with the ca command and issue a distinct " +
+		"certificate for the server and for each sovereign node with the server and client commands. Server and clients then trust the ca file."
 	app.Action = generateCertificate
 	app.Flags = []cli.Flag{
 		organizationFlag,
@@ -32,6 +37,51 @@ func main() {
 		availabilityFlag,
 		ipFlag,
 	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "ca",
+			Usage:  "Generate a certificate authority (.crt + .pem) to sign the server and clients certificates",
+			Action: generateCA,
+			Flags: []cli.Flag{
+				organizationFlag,
+				commonNameFlag,
+				availabilityFlag,
+				caCertFlag,
+				caKeyFlag,
+			},
+		},
+		{
+			Name:   "server",
+			Usage:  "Issue a server certificate (.crt + .pem) signed by the certificate authority",
+			Action: generateServerCertificate,
+			Flags:  signedCertificateFlags(),
+		},
+		{
+			Name:   "client",
+			Usage:  "Issue a client certificate (.crt + .pem) signed by the certificate authority, one for each sovereign node",
+			Action: generateClientCertificate,
+			Flags:  signedCertificateFlags(),
+		},
+		{
+			Name: "revoke",
+			Usage: "Add the provided certificates to the certificate revocation list signed by the certificate authority. " +
+				"Without any certificate, the revocation list is only renewed for the availability period",
+			ArgsUsage: "<certificate files>",
+			Action:    revokeCertificates,
+			Flags: []cli.Flag{
+				caCertFlag,
+				caKeyFlag,
+				crlFileFlag,
+				availabilityFlag,
+			},
+		},
+		{
+			Name:      "inspect",
+			Usage:     "Print the identity and validity of every certificate found in the provided files",
+			ArgsUsage: "<certificate files>",
+			Action:    inspectCertificates,
+		},
+	}
 
 	err := app.Run(os.Args)
 	if err != nil {
@@ -66,3 +116,133 @@ func generateCertificate(ctx *cli.Context) error {
 	log.Info("generated certificate files successfully")
 	return nil
 }
+
+func generateCA(ctx *cli.Context) error {
+	certFileCfg := cert.FileCfg{
+		CertFile: ctx.String(caCertFlag.Name),
+		PkFile:   ctx.String(caKeyFlag.Name),
+	}
+
+	err := cert.GenerateCAFiles(cert.CertificateCfg{
+		CertCfg: cert.CertCfg{
+			Organization: ctx.String(organizationFlag.Name),
+			CommonName:   ctx.String(commonNameFlag.Name),
+			Availability: ctx.Int64(availabilityFlag.Name),
+		},
+		CertFileCfg: certFileCfg,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("generated certificate authority files successfully", "cert", certFileCfg.CertFile, "key", certFileCfg.PkFile)
+	return nil
+}
+
+func signedCertificateFlags() []cli.Flag {
+	return []cli.Flag{
+		organizationFlag,
+		commonNameFlag,
+		dnsFlag,
+		ipFlag,
+		availabilityFlag,
+		caCertFlag,
+		caKeyFlag,
+		certFileFlag,
+		keyFileFlag,
+	}
+}
+
+func generateServerCertificate(ctx *cli.Context) error {
+	return generateSignedCertificate(ctx, x509.ExtKeyUsageServerAuth)
+}
+
+func generateClientCertificate(ctx *cli.Context) error {
+	return generateSignedCertificate(ctx, x509.ExtKeyUsageClientAuth)
+}
+
+func generateSignedCertificate(ctx *cli.Context, extKeyUsage x509.ExtKeyUsage) error {
+	certFileCfg := cert.FileCfg{
+		CertFile: getFileName(ctx, certFileFlag.Name, ctx.Command.Name+".crt"),
+		PkFile:   getFileName(ctx, keyFileFlag.Name, ctx.Command.Name+"_private_key.pem"),
+	}
+
+	err := cert.GenerateSignedCertFiles(cert.ArgsSignedCertificate{
+		CertCfg: cert.CertCfg{
+			Organization: ctx.String(organizationFlag.Name),
+			CommonName:   ctx.String(commonNameFlag.Name),
+			DNSName:      ctx.String(dnsFlag.Name),
+			IPAddress:    ctx.String(ipFlag.Name),
+			Availability: ctx.Int64(availabilityFlag.Name),
+		},
+		CertFileCfg: certFileCfg,
+		CAFileCfg: cert.FileCfg{
+			CertFile: ctx.String(caCertFlag.Name),
+			PkFile:   ctx.String(caKeyFlag.Name),
+		},
+		ExtKeyUsage: extKeyUsage,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("generated "+ctx.Command.Name+" certificate files successfully", "cert", certFileCfg.CertFile, "key", certFileCfg.PkFile)
+	return nil
+}
+
+func getFileName(ctx *cli.Context, flagName string, defaultName string) string {
+	fileName := ctx.String(flagName)
+	if len(fileName) == 0 {
+		return defaultName
+	}
+
+	return fileName
+}
+
+func revokeCertificates(ctx *cli.Context) error {
+	crlFile := ctx.String(crlFileFlag.Name)
+	err := cert.RevokeCertificates(cert.ArgsRevokeCertificates{
+		CAFileCfg: cert.FileCfg{
+			CertFile: ctx.String(caCertFlag.Name),
+			PkFile:   ctx.String(caKeyFlag.Name),
+		},
+		CRLFile:      crlFile,
+		CertFiles:    ctx.Args(),
+		Availability: ctx.Int64(availabilityFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("updated certificate revocation list successfully", "crl", crlFile)
+	return nil
+}
+
+func inspectCertificates(ctx *cli.Context) error {
+	if !ctx.Args().Present() {
+		return fmt.Errorf("no certificate file provided")
+	}
+
+	for _, certFile := range ctx.Args() {
+		infos, err := cert.InspectCertificates(certFile)
+		if err != nil {
+			return err
+		}
+
+		for _, info := range infos {
+			fmt.Printf("%s\n", certFile)
+			fmt.Printf("  subject:        %s\n", info.Subject)
+			fmt.Printf("  issuer:         %s\n", info.Issuer)
+			fmt.Printf("  serial number:  %s\n", info.SerialNumber)
+			fmt.Printf("  not before:     %s\n", info.NotBefore)
+			fmt.Printf("  not after:      %s\n", info.NotAfter)
+			fmt.Printf("  dns names:      %s\n", strings.Join(info.DNSNames, ", "))
+			fmt.Printf("  ip addresses:   %s\n", strings.Join(info.IPAddresses, ", "))
+			fmt.Printf("  is ca:          %t\n", info.IsCA)
+			fmt.Printf("  ext key usages: %s\n", strings.Join(info.ExtKeyUsages, ", "))
+			fmt.Printf("  fingerprint:    %s\n", info.Fingerprint)
+		}
+	}
+
+	return nil
+}
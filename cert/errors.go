@@ -0,0 +1,21 @@
+package cert
+
+import "errors"
+
+var errNoPeerCertificate = errors.New("no peer certificate provided")
+
+var errNoCertificateInCAFile = errors.New("no certificate found in ca file")
+
+var errInvalidPEMFile = errors.New("invalid pem file")
+
+var errNotCA = errors.New("certificate is not a certificate authority")
+
+var errCRLWithoutCAFile = errors.New("crl file requires a ca file")
+
+var errCRLNotSignedByCA = errors.New("crl is not signed by any trusted certificate authority")
+
+var errCertificateRevoked = errors.New("certificate revoked")
+
+var errCertificateNotAllowed = errors.New("certificate not allowlisted")
+
+var errCertificateNotIssuedByCA = errors.New("certificate not issued by the certificate authority")
@@ -0,0 +1,99 @@
+package cert
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"time"
+)
+
+var extKeyUsageNames = map[x509.ExtKeyUsage]string{
+	x509.ExtKeyUsageServerAuth: "server auth",
+	x509.ExtKeyUsageClientAuth: "client auth",
+}
+
+// CertificateInfo holds the identity and validity of a certificate
+type CertificateInfo struct {
+	Subject      string
+	Issuer       string
+	SerialNumber string
+	NotBefore    time.Time
+	NotAfter     time.Time
+	DNSNames     []string
+	IPAddresses  []string
+	IsCA         bool
+	ExtKeyUsages []string
+	Fingerprint  string
+}
+
+// Fingerprint returns the hex encoded sha256 hash of the certificate
+func Fingerprint(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(hash[:])
+}
+
+// InspectCertificates returns the info of every certificate found in the pem file
+func InspectCertificates(certFile string) ([]*CertificateInfo, error) {
+	buff, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*CertificateInfo, 0)
+	for {
+		var block *pem.Block
+		block, buff = pem.Decode(buff)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, errParse := x509.ParseCertificate(block.Bytes)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		infos = append(infos, getCertificateInfo(cert))
+	}
+
+	if len(infos) == 0 {
+		return nil, fmt.Errorf("%w, file: %s", errInvalidPEMFile, certFile)
+	}
+
+	return infos, nil
+}
+
+func getCertificateInfo(cert *x509.Certificate) *CertificateInfo {
+	ipAddresses := make([]string, 0, len(cert.IPAddresses))
+	for _, ip := range cert.IPAddresses {
+		ipAddresses = append(ipAddresses, ip.String())
+	}
+
+	extKeyUsages := make([]string, 0, len(cert.ExtKeyUsage))
+	for _, extKeyUsage := range cert.ExtKeyUsage {
+		name, found := extKeyUsageNames[extKeyUsage]
+		if !found {
+			name = fmt.Sprintf("unknown(%d)", extKeyUsage)
+		}
+
+		extKeyUsages = append(extKeyUsages, name)
+	}
+
+	return &CertificateInfo{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SerialNumber: cert.SerialNumber.String(),
+		NotBefore:    cert.NotBefore,
+		NotAfter:     cert.NotAfter,
+		DNSNames:     cert.DNSNames,
+		IPAddresses:  ipAddresses,
+		IsCA:         cert.IsCA,
+		ExtKeyUsages: extKeyUsages,
+		Fingerprint:  Fingerprint(cert),
+	}
+}
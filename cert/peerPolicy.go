@@ -0,0 +1,149 @@
+package cert
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const crlPEMType = "X509 CRL"
+
+// peerPolicy holds the revoked certificates and the allowed identities of peers
+type peerPolicy struct {
+	crlIssuer      []byte
+	crlAuthorityID []byte
+	revokedSerials map[string]struct{}
+	allowlist      map[string]struct{}
+}
+
+func loadPeerPolicy(cfg FileCfg) (*peerPolicy, error) {
+	policy := &peerPolicy{
+		revokedSerials: make(map[string]struct{}),
+	}
+
+	if len(cfg.CRLFile) != 0 {
+		crl, err := loadCRL(cfg.CRLFile, cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		policy.crlIssuer = crl.RawIssuer
+		policy.crlAuthorityID = crl.AuthorityKeyId
+		for _, entry := range crl.RevokedCertificateEntries {
+			policy.revokedSerials[entry.SerialNumber.String()] = struct{}{}
+		}
+	}
+
+	if len(cfg.AllowlistFile) != 0 {
+		allowlist, err := loadAllowlist(cfg.AllowlistFile)
+		if err != nil {
+			return nil, err
+		}
+
+		policy.allowlist = allowlist
+	}
+
+	return policy, nil
+}
+
+// loadCRL loads the certificate revocation list, which should be signed by one of the trusted certificate authorities
+func loadCRL(crlFile string, caFile string) (*x509.RevocationList, error) {
+	if len(caFile) == 0 {
+		return nil, errCRLWithoutCAFile
+	}
+
+	crl, err := readCRL(crlFile)
+	if err != nil {
+		return nil, err
+	}
+
+	caCerts, err := loadCACertificates(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, caCert := range caCerts {
+		if crl.CheckSignatureFrom(caCert) == nil {
+			return crl, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w, crl file: %s", errCRLNotSignedByCA, crlFile)
+}
+
+// readCRL reads a pem or der encoded certificate revocation list
+func readCRL(crlFile string) (*x509.RevocationList, error) {
+	buff, err := os.ReadFile(crlFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(buff)
+	if block != nil && block.Type == crlPEMType {
+		buff = block.Bytes
+	}
+
+	return x509.ParseRevocationList(buff)
+}
+
+// loadAllowlist loads the allowed certificate fingerprints and subject common names, one per line. Empty lines and
+// lines starting with # are ignored.
+func loadAllowlist(allowlistFile string) (map[string]struct{}, error) {
+	buff, err := os.ReadFile(allowlistFile)
+	if err != nil {
+		return nil, err
+	}
+
+	allowlist := make(map[string]struct{})
+	scanner := bufio.NewScanner(bytes.NewReader(buff))
+	for scanner.Scan() {
+		entry := strings.TrimSpace(scanner.Text())
+		if len(entry) == 0 || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		allowlist[entry] = struct{}{}
+		allowlist[normalizeFingerprint(entry)] = struct{}{}
+	}
+
+	return allowlist, scanner.Err()
+}
+
+// normalizeFingerprint allows fingerprints to be listed in upper case or separated by colons, as printed by openssl
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+}
+
+func (pp *peerPolicy) isIssuedByCRLIssuer(cert *x509.Certificate) bool {
+	if !bytes.Equal(cert.RawIssuer, pp.crlIssuer) {
+		return false
+	}
+
+	// distinguishes certificate authorities with the same subject
+	return len(pp.crlAuthorityID) == 0 || bytes.Equal(cert.AuthorityKeyId, pp.crlAuthorityID)
+}
+
+func (pp *peerPolicy) check(cert *x509.Certificate) error {
+	if pp.isIssuedByCRLIssuer(cert) {
+		_, revoked := pp.revokedSerials[cert.SerialNumber.String()]
+		if revoked {
+			return errCertificateRevoked
+		}
+	}
+
+	if pp.allowlist == nil {
+		return nil
+	}
+
+	_, allowedFingerprint := pp.allowlist[Fingerprint(cert)]
+	_, allowedName := pp.allowlist[cert.Subject.CommonName]
+	if !allowedFingerprint && !allowedName {
+		return errCertificateNotAllowed
+	}
+
+	return nil
+}
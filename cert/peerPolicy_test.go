@@ -0,0 +1,138 @@
+package cert
+
+import (
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func revoke(t *testing.T, caCfg FileCfg, crlFile string, certFiles ...string) {
+	err := RevokeCertificates(ArgsRevokeCertificates{
+		CAFileCfg:    caCfg,
+		CRLFile:      crlFile,
+		CertFiles:    certFiles,
+		Availability: 1,
+	})
+	require.Nil(t, err)
+}
+
+func TestRevokeCertificates(t *testing.T) {
+	t.Parallel()
+
+	caCfg := createCA(t)
+	crlFile := filepath.Join(t.TempDir(), "crl.pem")
+	client1 := issueCert(t, caCfg, "sovereign node 1", x509.ExtKeyUsageClientAuth)
+	client2 := issueCert(t, caCfg, "sovereign node 2", x509.ExtKeyUsageClientAuth)
+
+	revoke(t, caCfg, crlFile, client1.CertFile)
+	revoke(t, caCfg, crlFile, client1.CertFile, client2.CertFile)
+
+	crl, err := loadCRL(crlFile, caCfg.CertFile)
+	require.Nil(t, err)
+	require.Len(t, crl.RevokedCertificateEntries, 2)
+	require.Equal(t, int64(2), crl.Number.Int64())
+
+	otherCA := createCA(t)
+	err = RevokeCertificates(ArgsRevokeCertificates{
+		CAFileCfg: otherCA,
+		CRLFile:   crlFile,
+	})
+	require.ErrorIs(t, err, errCRLNotSignedByCA)
+
+	err = RevokeCertificates(ArgsRevokeCertificates{
+		CAFileCfg: otherCA,
+		CRLFile:   filepath.Join(t.TempDir(), "crl.pem"),
+		CertFiles: []string{client1.CertFile},
+	})
+	require.ErrorIs(t, err, errCertificateNotIssuedByCA)
+
+	_, err = loadCRL(crlFile, otherCA.CertFile)
+	require.ErrorIs(t, err, errCRLNotSignedByCA)
+
+	_, err = loadCRL(crlFile, "")
+	require.Equal(t, errCRLWithoutCAFile, err)
+}
+
+func TestCertificateReloader_PeerPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("revoked client", func(t *testing.T) {
+		caCfg := createCA(t)
+		client1 := issueCert(t, caCfg, "sovereign node 1", x509.ExtKeyUsageClientAuth)
+		client2 := issueCert(t, caCfg, "sovereign node 2", x509.ExtKeyUsageClientAuth)
+
+		serverCfg := issueCert(t, caCfg, "bridge server", x509.ExtKeyUsageServerAuth)
+		serverCfg.CRLFile = filepath.Join(t.TempDir(), "crl.pem")
+		revoke(t, caCfg, serverCfg.CRLFile)
+
+		serverReloader, err := NewCertificateReloader(serverCfg)
+		require.Nil(t, err)
+		client1Reloader, err := NewCertificateReloader(client1)
+		require.Nil(t, err)
+		client2Reloader, err := NewCertificateReloader(client2)
+		require.Nil(t, err)
+
+		serverConfig := serverReloader.TLSServerConfig()
+		_, err = handshake(serverConfig, client1Reloader.TLSClientConfig())
+		require.Nil(t, err)
+
+		revoke(t, caCfg, serverCfg.CRLFile, client1.CertFile)
+		require.Nil(t, serverReloader.Reload())
+
+		_, err = handshake(serverConfig, client1Reloader.TLSClientConfig())
+		require.ErrorIs(t, err, errCertificateRevoked)
+		_, err = handshake(serverConfig, client2Reloader.TLSClientConfig())
+		require.Nil(t, err)
+	})
+	t.Run("allowlisted clients", func(t *testing.T) {
+		caCfg := createCA(t)
+		client1 := issueCert(t, caCfg, "sovereign node 1", x509.ExtKeyUsageClientAuth)
+		client2 := issueCert(t, caCfg, "sovereign node 2", x509.ExtKeyUsageClientAuth)
+
+		serverCfg := issueCert(t, caCfg, "bridge server", x509.ExtKeyUsageServerAuth)
+		serverCfg.AllowlistFile = filepath.Join(t.TempDir(), "allowlist.txt")
+		require.Nil(t, os.WriteFile(serverCfg.AllowlistFile, []byte("# allowed sovereign nodes\nsovereign node 1\n"), 0644))
+
+		serverReloader, err := NewCertificateReloader(serverCfg)
+		require.Nil(t, err)
+		client1Reloader, err := NewCertificateReloader(client1)
+		require.Nil(t, err)
+		client2Reloader, err := NewCertificateReloader(client2)
+		require.Nil(t, err)
+
+		serverConfig := serverReloader.TLSServerConfig()
+		_, err = handshake(serverConfig, client1Reloader.TLSClientConfig())
+		require.Nil(t, err)
+		_, err = handshake(serverConfig, client2Reloader.TLSClientConfig())
+		require.ErrorIs(t, err, errCertificateNotAllowed)
+
+		infos, err := InspectCertificates(client2.CertFile)
+		require.Nil(t, err)
+
+		// allowlist is reloaded once changed, with fingerprints accepted in openssl format as well
+		modTime := time.Now().Add(time.Minute)
+		require.Nil(t, os.WriteFile(serverCfg.AllowlistFile, []byte(toOpenSSLFingerprint(infos[0].Fingerprint)), 0644))
+		require.Nil(t, os.Chtimes(serverCfg.AllowlistFile, modTime, modTime))
+
+		_, err = handshake(serverConfig, client2Reloader.TLSClientConfig())
+		require.Nil(t, err)
+		_, err = handshake(serverConfig, client1Reloader.TLSClientConfig())
+		require.ErrorIs(t, err, errCertificateNotAllowed)
+	})
+}
+
+func toOpenSSLFingerprint(fingerprint string) string {
+	formatted := ""
+	for i := 0; i < len(fingerprint); i += 2 {
+		if i > 0 {
+			formatted += ":"
+		}
+		formatted += fingerprint[i : i+2]
+	}
+
+	return formatted
+}
@@ -0,0 +1,239 @@
+package cert
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"os/signal"
+	"slices"
+	"sync"
+	"time"
+)
+
+type certificateReloader struct {
+	cfg FileCfg
+
+	mut         sync.RWMutex
+	certificate *tls.Certificate
+	certPool    *x509.CertPool
+	peerPolicy  *peerPolicy
+	modTimes    []time.Time
+}
+
+// NewCertificateReloader loads the key pair, the trusted certificate authorities, the revoked certificates and the
+// allowlist from the certificate files. The files are checked for changes before each tls handshake and can also be
+// reloaded on demand, so that new handshakes use the new files, while existing connections keep working. If reloading
+// fails, the previously loaded files are kept.
+func NewCertificateReloader(cfg FileCfg) (*certificateReloader, error) {
+	cr := &certificateReloader{
+		cfg: cfg,
+	}
+
+	err := cr.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return cr, nil
+}
+
+// Reload loads all the certificate files
+func (cr *certificateReloader) Reload() error {
+	modTimes, err := cr.getModTimes()
+	if err != nil {
+		return err
+	}
+
+	return cr.load(modTimes)
+}
+
+func (cr *certificateReloader) getModTimes() ([]time.Time, error) {
+	files := []string{cr.cfg.CertFile, cr.cfg.PkFile}
+	for _, optionalFile := range []string{cr.cfg.CAFile, cr.cfg.CRLFile, cr.cfg.AllowlistFile} {
+		if len(optionalFile) != 0 {
+			files = append(files, optionalFile)
+		}
+	}
+
+	modTimes := make([]time.Time, 0, len(files))
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+
+		modTimes = append(modTimes, info.ModTime())
+	}
+
+	return modTimes, nil
+}
+
+func (cr *certificateReloader) load(modTimes []time.Time) error {
+	cert, err := tls.LoadX509KeyPair(cr.cfg.CertFile, cr.cfg.PkFile)
+	if err != nil {
+		return err
+	}
+
+	certPool, err := loadCertPool(cr.cfg, cert)
+	if err != nil {
+		return err
+	}
+
+	policy, err := loadPeerPolicy(cr.cfg)
+	if err != nil {
+		return err
+	}
+
+	cr.mut.Lock()
+	cr.certificate = &cert
+	cr.certPool = certPool
+	cr.peerPolicy = policy
+	cr.modTimes = modTimes
+	cr.mut.Unlock()
+
+	log.Info("loaded certificate", "cert file", cr.cfg.CertFile, "pk file", cr.cfg.PkFile, "ca file", cr.cfg.CAFile,
+		"crl file", cr.cfg.CRLFile, "revoked", len(policy.revokedSerials), "allowlist file", cr.cfg.AllowlistFile)
+	return nil
+}
+
+// ReloadOnSignal reloads the key pair whenever the process receives any of the provided signals (e.g.: SIGHUP), until
+// the returned function is called
+func (cr *certificateReloader) ReloadOnSignal(signals ...os.Signal) func() {
+	received := make(chan os.Signal, 1)
+	signal.Notify(received, signals...)
+
+	done := make(chan struct{})
+	go func() {
+		defer signal.Stop(received)
+
+		for {
+			select {
+			case sig := <-received:
+				log.Info("reloading certificate", "signal", sig.String())
+				err := cr.Reload()
+				if err != nil {
+					log.Error("could not reload certificate, using the previous certificate", "cert file", cr.cfg.CertFile, "error", err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+	}
+}
+
+// reloadIfChanged reloads the key pair if any of the certificate files was modified since last loaded
+func (cr *certificateReloader) reloadIfChanged() {
+	modTimes, err := cr.getModTimes()
+	if err != nil {
+		log.Error("could not check certificate files, using the previous certificate", "error", err)
+		return
+	}
+
+	cr.mut.RLock()
+	changed := !slices.EqualFunc(modTimes, cr.modTimes, time.Time.Equal)
+	cr.mut.RUnlock()
+	if !changed {
+		return
+	}
+
+	err = cr.load(modTimes)
+	if err == nil {
+		return
+	}
+
+	log.Error("could not reload certificate, using the previous certificate", "cert file", cr.cfg.CertFile, "error", err)
+
+	// files are not reloaded again until modified, so that a failed reload is not retried at every handshake
+	cr.mut.Lock()
+	cr.modTimes = modTimes
+	cr.mut.Unlock()
+}
+
+func (cr *certificateReloader) getCertificate() *tls.Certificate {
+	cr.mut.RLock()
+	defer cr.mut.RUnlock()
+
+	return cr.certificate
+}
+
+func (cr *certificateReloader) getPeerVerification() (*x509.CertPool, *peerPolicy) {
+	cr.mut.RLock()
+	defer cr.mut.RUnlock()
+
+	return cr.certPool, cr.peerPolicy
+}
+
+// TLSServerConfig returns a tls server config which serves the current certificate and only accepts clients whose
+// certificate is signed by a trusted certificate authority, or is the same as the served one if no ca file is set
+func (cr *certificateReloader) TLSServerConfig() *tls.Config {
+	return &tls.Config{
+		GetCertificate: func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cr.reloadIfChanged()
+			return cr.getCertificate(), nil
+		},
+		// client certificates are verified against the current trusted pool in VerifyConnection, since ClientCAs
+		// cannot be changed once the config is in use
+		ClientAuth: tls.RequireAnyClientCert,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			return cr.verifyPeerCertificate(cs, "", x509.ExtKeyUsageClientAuth)
+		},
+	}
+}
+
+// TLSClientConfig returns a tls client config which presents the current certificate and only accepts servers whose
+// certificate is signed by a trusted certificate authority, or is the same as the presented one if no ca file is set
+func (cr *certificateReloader) TLSClientConfig() *tls.Config {
+	return &tls.Config{
+		GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return cr.getCertificate(), nil
+		},
+		// the server certificate is verified against the current trusted pool in VerifyConnection, since RootCAs
+		// cannot be changed once the config is in use
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			cr.reloadIfChanged()
+			return cr.verifyPeerCertificate(cs, cs.ServerName, x509.ExtKeyUsageServerAuth)
+		},
+	}
+}
+
+// verifyPeerCertificate verifies the peer certificate against the trusted certificate authorities, the revoked
+// certificates and the allowlist. Rejected peers are logged.
+func (cr *certificateReloader) verifyPeerCertificate(cs tls.ConnectionState, dnsName string, keyUsage x509.ExtKeyUsage) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errNoPeerCertificate
+	}
+
+	peerCert := cs.PeerCertificates[0]
+	intermediates := x509.NewCertPool()
+	for _, intermediate := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(intermediate)
+	}
+
+	certPool, policy := cr.getPeerVerification()
+	_, err := peerCert.Verify(x509.VerifyOptions{
+		Roots:         certPool,
+		Intermediates: intermediates,
+		DNSName:       dnsName,
+		KeyUsages:     []x509.ExtKeyUsage{keyUsage},
+	})
+	if err == nil {
+		err = policy.check(peerCert)
+	}
+	if err != nil {
+		log.Warn("rejected peer certificate", "subject", peerCert.Subject.String(),
+			"serial number", peerCert.SerialNumber.String(), "fingerprint", Fingerprint(peerCert), "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (cr *certificateReloader) IsInterfaceNil() bool {
+	return cr == nil
+}
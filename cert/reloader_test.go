@@ -0,0 +1,151 @@
+package cert
+
+import (
+	"bytes"
+	"crypto/tls"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func generateCertFiles(t *testing.T, cfg FileCfg, modTime time.Time) {
+	err := GenerateCertFiles(CertificateCfg{
+		CertCfg: CertCfg{
+			Organization: "MultiversX",
+			DNSName:      "localhost",
+			IPAddress:    "127.0.0.1",
+			Availability: 1,
+		},
+		CertFileCfg: cfg,
+	})
+	require.Nil(t, err)
+
+	require.Nil(t, os.Chtimes(cfg.CertFile, modTime, modTime))
+	require.Nil(t, os.Chtimes(cfg.PkFile, modTime, modTime))
+}
+
+func createFileCfg(t *testing.T) FileCfg {
+	dir := t.TempDir()
+	return FileCfg{
+		CertFile: filepath.Join(dir, "certificate.crt"),
+		PkFile:   filepath.Join(dir, "private_key.pem"),
+	}
+}
+
+// handshake connects a client to a server and returns the certificate presented by the server
+func handshake(serverConfig *tls.Config, clientConfig *tls.Config) ([]byte, error) {
+	serverConn, clientConn := net.Pipe()
+	defer func() {
+		_ = serverConn.Close()
+		_ = clientConn.Close()
+	}()
+
+	clientConfig = clientConfig.Clone()
+	clientConfig.ServerName = "localhost"
+
+	server := tls.Server(serverConn, serverConfig)
+	client := tls.Client(clientConn, clientConfig)
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- server.Handshake()
+	}()
+
+	err := client.Handshake()
+	if err != nil {
+		_ = clientConn.Close()
+		<-serverErr
+		return nil, err
+	}
+
+	// the server verifies the client certificate after the client finished its handshake, so any alert it sends is
+	// read here, otherwise writing it would block on the pipe
+	go func() {
+		_, _ = client.Read(make([]byte, 1))
+	}()
+
+	err = <-serverErr
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ConnectionState().PeerCertificates[0].Raw, nil
+}
+
+func TestNewCertificateReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing files", func(t *testing.T) {
+		reloader, err := NewCertificateReloader(createFileCfg(t))
+		require.NotNil(t, err)
+		require.Nil(t, reloader)
+	})
+	t.Run("should work", func(t *testing.T) {
+		cfg := createFileCfg(t)
+		generateCertFiles(t, cfg, time.Now())
+
+		reloader, err := NewCertificateReloader(cfg)
+		require.Nil(t, err)
+		require.False(t, reloader.IsInterfaceNil())
+	})
+}
+
+func TestCertificateReloader_Handshake(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should reload changed files", func(t *testing.T) {
+		cfg := createFileCfg(t)
+		generateCertFiles(t, cfg, time.Now().Add(-time.Minute))
+
+		serverReloader, err := NewCertificateReloader(cfg)
+		require.Nil(t, err)
+		clientReloader, err := NewCertificateReloader(cfg)
+		require.Nil(t, err)
+
+		serverConfig := serverReloader.TLSServerConfig()
+		clientConfig := clientReloader.TLSClientConfig()
+
+		oldCert, err := handshake(serverConfig, clientConfig)
+		require.Nil(t, err)
+		require.True(t, bytes.Equal(oldCert, serverReloader.getCertificate().Certificate[0]))
+
+		generateCertFiles(t, cfg, time.Now())
+		newCert, err := handshake(serverConfig, clientConfig)
+		require.Nil(t, err)
+		require.False(t, bytes.Equal(oldCert, newCert))
+		require.True(t, bytes.Equal(newCert, clientReloader.getCertificate().Certificate[0]))
+	})
+	t.Run("should keep previous certificate if reload fails", func(t *testing.T) {
+		cfg := createFileCfg(t)
+		generateCertFiles(t, cfg, time.Now().Add(-time.Minute))
+
+		reloader, err := NewCertificateReloader(cfg)
+		require.Nil(t, err)
+		oldCert := reloader.getCertificate()
+
+		require.Nil(t, os.WriteFile(cfg.CertFile, []byte("invalid certificate"), 0644))
+		require.NotNil(t, reloader.Reload())
+
+		peerCert, err := handshake(reloader.TLSServerConfig(), reloader.TLSClientConfig())
+		require.Nil(t, err)
+		require.True(t, bytes.Equal(oldCert.Certificate[0], peerCert))
+	})
+	t.Run("should reject peers holding another certificate", func(t *testing.T) {
+		serverCfg := createFileCfg(t)
+		generateCertFiles(t, serverCfg, time.Now())
+		clientCfg := createFileCfg(t)
+		generateCertFiles(t, clientCfg, time.Now())
+
+		serverReloader, err := NewCertificateReloader(serverCfg)
+		require.Nil(t, err)
+		clientReloader, err := NewCertificateReloader(clientCfg)
+		require.Nil(t, err)
+
+		_, err = handshake(serverReloader.TLSServerConfig(), clientReloader.TLSClientConfig())
+		require.NotNil(t, err)
+	})
+}
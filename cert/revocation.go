@@ -0,0 +1,101 @@
+package cert
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+)
+
+// ArgsRevokeCertificates holds necessary config to revoke certificates issued by a certificate authority. Revoked
+// certificates are added to the CRL file, which is created if missing. The CRL is valid for the provided number of days.
+type ArgsRevokeCertificates struct {
+	CAFileCfg    FileCfg
+	CRLFile      string
+	CertFiles    []string
+	Availability int64
+}
+
+// RevokeCertificates adds the certificates to the certificate revocation list signed by the certificate authority
+func RevokeCertificates(args ArgsRevokeCertificates) error {
+	ca, caPk, err := LoadCA(args.CAFileCfg)
+	if err != nil {
+		return err
+	}
+
+	entries, number, err := loadRevokedEntries(args.CRLFile, ca)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	revokedSerials := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		revokedSerials[entry.SerialNumber.String()] = struct{}{}
+	}
+
+	for _, certFile := range args.CertFiles {
+		certBlock, errRead := readPEMBlock(certFile)
+		if errRead != nil {
+			return errRead
+		}
+
+		cert, errParse := x509.ParseCertificate(certBlock.Bytes)
+		if errParse != nil {
+			return errParse
+		}
+		if cert.CheckSignatureFrom(ca) != nil {
+			return fmt.Errorf("%w, cert file: %s", errCertificateNotIssuedByCA, certFile)
+		}
+		if _, revoked := revokedSerials[cert.SerialNumber.String()]; revoked {
+			log.Info("certificate already revoked", "subject", cert.Subject.String(), "serial number", cert.SerialNumber.String())
+			continue
+		}
+
+		revokedSerials[cert.SerialNumber.String()] = struct{}{}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   cert.SerialNumber,
+			RevocationTime: now,
+		})
+		log.Info("revoked certificate", "subject", cert.Subject.String(), "serial number", cert.SerialNumber.String())
+	}
+
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    number.Add(number, big.NewInt(1)),
+		ThisUpdate:                now,
+		NextUpdate:                now.Add(time.Duration(args.Availability) * day),
+		RevokedCertificateEntries: entries,
+	}, ca, caPk)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(args.CRLFile, pem.EncodeToMemory(&pem.Block{Type: crlPEMType, Bytes: crl}), 0644)
+}
+
+// loadRevokedEntries loads the entries and number of the existing certificate revocation list, if any
+func loadRevokedEntries(crlFile string, ca *x509.Certificate) ([]x509.RevocationListEntry, *big.Int, error) {
+	crl, err := readCRL(crlFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return make([]x509.RevocationListEntry, 0), big.NewInt(0), nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = crl.CheckSignatureFrom(ca)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w, crl file: %s: %v", errCRLNotSignedByCA, crlFile, err)
+	}
+
+	number := crl.Number
+	if number == nil {
+		number = big.NewInt(0)
+	}
+
+	return crl.RevokedCertificateEntries, number, nil
+}
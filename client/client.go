@@ -4,11 +4,15 @@ import (
 	"context"
 
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
 )
 
 type client struct {
-	bridgeClient sovereign.BridgeTxSenderClient
-	conn         GRPCConn
+	bridgeClient  sovereign.BridgeTxSenderClient
+	senderClient  bridge.BridgeSenderClient
+	ticketsClient bridge.BridgeTicketsClient
+	conn          GRPCConn
 }
 
 // NewClient creates a wrapper over the grpc client connection and tx sender
@@ -21,8 +25,10 @@ func NewClient(bridgeClient sovereign.BridgeTxSenderClient, conn GRPCConn) (*cli
 	}
 
 	return &client{
-		conn:         conn,
-		bridgeClient: bridgeClient,
+		conn:          conn,
+		bridgeClient:  bridgeClient,
+		senderClient:  bridge.NewBridgeSenderClient(conn),
+		ticketsClient: bridge.NewBridgeTicketsClient(conn),
 	}, nil
 }
 
@@ -31,6 +37,18 @@ func (c *client) Send(ctx context.Context, data *sovereign.BridgeOperations) (*s
 	return c.bridgeClient.Send(ctx, data)
 }
 
+// SendOperations sends bridge operations to the server, returning the outcome of each bridge outgoing data and tx in
+// the response body. Bridge outgoing data which could not be sent is reported in the result, without an error.
+func (c *client) SendOperations(ctx context.Context, data *sovereign.BridgeOperations) (*bridge.SendResponse, error) {
+	return c.senderClient.SendOperations(ctx, data)
+}
+
+// GetTicket returns the ticket of the bridge outgoing data with the provided hash, accepted by a server running in
+// asynchronous mode
+func (c *client) GetTicket(ctx context.Context, hash []byte) (*bridge.Ticket, error) {
+	return c.ticketsClient.GetTicket(ctx, &bridge.TicketRequest{Hash: hash})
+}
+
 // Close closes internal grpc connection
 func (c *client) Close() error {
 	return c.conn.Close()
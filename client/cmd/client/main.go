@@ -25,6 +25,7 @@ const (
 	envGRPCPort   = "GRPC_PORT"
 	envCertFile   = "CERT_FILE"
 	envCertPkFile = "CERT_PK_FILE"
+	envCertCAFile = "CERT_CA_FILE"
 )
 
 func main() {
@@ -174,12 +175,14 @@ func loadConfig() (*config.ClientConfig, error) {
 	grpcPort := os.Getenv(envGRPCPort)
 	certFile := os.Getenv(envCertFile)
 	certPkFile := os.Getenv(envCertPkFile)
+	certCAFile := os.Getenv(envCertCAFile)
 
 	log.Info("loaded config", "grpc host", grpcHost)
 	log.Info("loaded config", "grpc port", grpcPort)
 
 	log.Info("loaded config", "certificate file", certFile)
 	log.Info("loaded config", "certificate pk", certPkFile)
+	log.Info("loaded config", "certificate ca", certCAFile)
 
 	return &config.ClientConfig{
 		Enabled:  true,
@@ -188,6 +191,7 @@ func loadConfig() (*config.ClientConfig, error) {
 		CertificateCfg: cert.FileCfg{
 			CertFile: certFile,
 			PkFile:   certPkFile,
+			CAFile:   certCAFile,
 		},
 	}, nil
 }
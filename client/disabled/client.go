@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
 )
 
 type client struct{}
@@ -18,6 +20,18 @@ func (c *client) Send(_ context.Context, _ *sovereign.BridgeOperations) (*sovere
 	return &sovereign.BridgeOperationsResponse{}, nil
 }
 
+// SendOperations does nothing and returns an empty send response
+func (c *client) SendOperations(_ context.Context, _ *sovereign.BridgeOperations) (*bridge.SendResponse, error) {
+	return &bridge.SendResponse{
+		Result: &bridge.OperationsResult{},
+	}, nil
+}
+
+// GetTicket does nothing and returns an empty ticket
+func (c *client) GetTicket(_ context.Context, _ []byte) (*bridge.Ticket, error) {
+	return &bridge.Ticket{}, nil
+}
+
 // Close returns no error
 func (c *client) Close() error {
 	return nil
@@ -5,11 +5,15 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"google.golang.org/grpc"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
 )
 
 // ClientHandler defines a wrapper over the grpc client connection and tx sender
 type ClientHandler interface {
 	Send(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error)
+	SendOperations(ctx context.Context, data *sovereign.BridgeOperations) (*bridge.SendResponse, error)
+	GetTicket(ctx context.Context, hash []byte) (*bridge.Ticket, error)
 	Close() error
 	IsInterfaceNil() bool
 }
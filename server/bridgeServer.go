@@ -2,51 +2,594 @@ package server
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txSender"
 )
 
 var log = logger.GetOrCreate("server")
 
+// maxHeaderSize is the max size of the result and tickets attached to the grpc response header, below the default
+// max header list size of grpc clients. Larger results are only returned in the body of SendOperations responses.
+const maxHeaderSize = 8 * 1024
+
+// queuedRetryDelay is the delay after which the background worker sends again the queued bridge outgoing data which
+// could not be sent
+const queuedRetryDelay = time.Second * 10
+
 type server struct {
-	txSender TxSender
+	txSender          TxSender
+	metricsHandler    MetricsHandler
+	signatureVerifier SignatureVerifier
 	*sovereign.UnimplementedBridgeTxSenderServer
+	bridge.UnimplementedBridgeSenderServer
+	bridge.UnimplementedBridgeTicketsServer
+
+	tickets    *ticketQueue
+	retryDelay time.Duration
+	ctx        context.Context
+	cancel     context.CancelFunc
+
+	mutSends      sync.Mutex
+	draining      bool
+	inFlightSends map[*sovereign.BridgeOperations]struct{}
+	drained       chan struct{}
 }
 
 // NewSovereignBridgeTxServer creates a new sovereign bridge operations server. This server receives bridge data operations from
 // sovereign nodes and sends transactions to main chain.
-func NewSovereignBridgeTxServer(txSender TxSender) (*server, error) {
+func NewSovereignBridgeTxServer(txSender TxSender, metricsHandler MetricsHandler, signatureVerifier SignatureVerifier) (*server, error) {
 	if check.IfNil(txSender) {
 		return nil, errNilTxSender
 	}
+	if check.IfNil(metricsHandler) {
+		return nil, errNilMetricsHandler
+	}
+	if check.IfNil(signatureVerifier) {
+		return nil, errNilSignatureVerifier
+	}
 
-	return &server{
-		txSender: txSender,
-	}, nil
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &server{
+		txSender:          txSender,
+		metricsHandler:    metricsHandler,
+		signatureVerifier: signatureVerifier,
+		ctx:               ctx,
+		cancel:            cancel,
+		inFlightSends:     make(map[*sovereign.BridgeOperations]struct{}),
+		drained:           make(chan struct{}),
+	}
+	txSender.RegisterValidatorSetChangeHandler(s.onValidatorSetChange)
+
+	return s, nil
+}
+
+// NewAsyncSovereignBridgeTxServer creates a new sovereign bridge operations server working in asynchronous mode. This
+// server durably enqueues the bridge operations received from sovereign nodes, responds with a ticket for each bridge
+// outgoing data and sends the transactions to main chain in a background worker.
+func NewAsyncSovereignBridgeTxServer(txSender TxSender, metricsHandler MetricsHandler, signatureVerifier SignatureVerifier) (*server, error) {
+	s, err := NewSovereignBridgeTxServer(txSender, metricsHandler, signatureVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	s.tickets = newTicketQueue()
+	s.retryDelay = queuedRetryDelay
+	txSender.RegisterHeldDataHandler(s.onHeldDataReleased)
+	go s.sendQueued(s.ctx)
+
+	return s, nil
+}
+
+// ResumeUnfinished sends, in background, the journaled txs which were not broadcast before the last shutdown. It should
+// be called once the server is started. The resume counts as an in-flight send, so that draining waits for it, and is
+// stopped when the server is closed.
+func (s *server) ResumeUnfinished() {
+	resume := &sovereign.BridgeOperations{}
+	err := s.startSend(resume)
+	if err != nil {
+		log.Warn("unfinished bridge operations not resumed", "error", err)
+		return
+	}
+
+	go func() {
+		defer s.endSend(resume)
+
+		result := s.txSender.ResumeUnfinished(s.ctx)
+		logTxHashes(result.TxHashes())
+		err := result.Err()
+		if err != nil {
+			log.Error("could not resume all unfinished bridge operations from journal", "error", err)
+			return
+		}
+
+		log.Info("resumed unfinished bridge operations", "bridge data", len(result.GetResults()))
+	}()
 }
 
-// Send should handle receiving data bridge operations from sovereign shard and forward transactions to main chain
+// Send should handle receiving data bridge operations from sovereign shard and forward transactions to main chain.
+// The outcome of each bridge outgoing data and tx is attached to the grpc response header, unless too large, in which
+// case it is only returned by SendOperations. If anything could not be sent, an error is returned, so that the
+// sovereign node retries; already sent txs are not sent again.
+// Bridge operations whose aggregated signature is invalid are refused with an invalid argument error, before sending
+// any tx. While the server is draining, new bridge operations are refused with an unavailable error.
+// In dry-run mode, either configured or requested through the grpc metadata, the signed txs are only attached to the
+// response header, without being sent.
+// In asynchronous mode, the bridge operations are journaled and queued, and their tickets are attached to the response
+// header instead; the txs are sent by a background worker. Dry-runs are always handled synchronously.
 func (s *server) Send(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
-	hashes, err := s.txSender.SendTxs(ctx, data)
+	response, err := s.handle(ctx, data)
 	if err != nil {
 		return nil, err
 	}
 
-	logTxHashes(hashes)
+	result := response.GetResult()
+	err = result.Err()
+	if response.GetTickets() != nil {
+		setTicketsHeader(ctx, response.GetTickets())
+	}
+	if response.GetTickets() == nil || err != nil {
+		setResultHeader(ctx, result)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	return &sovereign.BridgeOperationsResponse{
-		TxHashes: hashes,
+		TxHashes: result.TxHashes(),
 	}, nil
 }
 
+// SendOperations handles the bridge operations the same way as Send, but returns the outcome of each bridge outgoing
+// data and tx, or their tickets in asynchronous mode, in the response body. Bridge outgoing data which could not be
+// sent is only reported in the response, so the sovereign node should check it and retry. Bridge operations with an
+// invalid aggregated signature, as well as any bridge operations received while draining, are still refused with an
+// error.
+func (s *server) SendOperations(ctx context.Context, data *sovereign.BridgeOperations) (*bridge.SendResponse, error) {
+	return s.handle(ctx, data)
+}
+
+// handle verifies the signatures of the bridge operations, then sends their txs, or queues them in asynchronous mode
+func (s *server) handle(ctx context.Context, data *sovereign.BridgeOperations) (*bridge.SendResponse, error) {
+	err := s.verifySignatures(data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.startSend(data)
+	if err != nil {
+		s.removeValidatorSetChanges(data)
+		return nil, err
+	}
+
+	if bridge.IsDryRunRequested(ctx) {
+		defer s.endSend(data)
+		return s.send(txSender.WithDryRun(ctx), data), nil
+	}
+	if s.tickets != nil {
+		return s.accept(ctx, data), nil
+	}
+
+	defer s.endSend(data)
+	return s.send(ctx, data), nil
+}
+
+// send sends the txs of the bridge operations and returns their outcome
+func (s *server) send(ctx context.Context, data *sovereign.BridgeOperations) *bridge.SendResponse {
+	result := s.sendTxs(ctx, data)
+	if result.GetDryRun() {
+		s.removeValidatorSetChanges(data)
+	} else {
+		s.removeRejectedValidatorSetChanges(data, result)
+	}
+
+	err := result.Err()
+	if err != nil {
+		log.Error("could not send all bridge txs", "error", err)
+	}
+
+	return &bridge.SendResponse{
+		Result: result,
+	}
+}
+
+// sendTxs sends the txs of the bridge operations and records the send metrics
+func (s *server) sendTxs(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+	start := time.Now()
+	result := s.txSender.SendTxs(ctx, data)
+	if result.GetDryRun() {
+		log.Info("dry-run of bridge operations, no tx was sent", "bridge data", len(data.Data))
+	} else {
+		s.metricsHandler.ObserveSend(data, result, time.Since(start))
+	}
+	logTxHashes(result.TxHashes())
+
+	return result
+}
+
+// accept journals and queues the bridge operations, which are sent by the background worker. The validator set changes
+// remain pending, as added while verifying them, since the following bridge operations are signed by the new validator
+// set. Bridge outgoing data which could not be journaled or was rejected is reported in the result, without a ticket,
+// so that the sovereign node retries.
+func (s *server) accept(ctx context.Context, data *sovereign.BridgeOperations) *bridge.SendResponse {
+	result := s.txSender.Accept(data)
+	if result.GetDryRun() {
+		defer s.endSend(data)
+		return s.send(ctx, data)
+	}
+
+	s.removeRejectedValidatorSetChanges(data, result)
+	accepted := getAcceptedBridgeOperations(data, result)
+	tickets := &bridge.Tickets{
+		Tickets: make([]*bridge.Ticket, 0),
+	}
+	if len(accepted.Data) == 0 {
+		s.endSend(data)
+	} else {
+		s.replaceSend(data, accepted)
+		tickets = s.tickets.push(accepted)
+	}
+	log.Info("accepted bridge operations", "bridge data", len(accepted.Data), "rejected", len(data.Data)-len(accepted.Data))
+
+	err := result.Err()
+	if err != nil {
+		log.Error("could not accept all bridge operations", "error", err)
+	}
+
+	return &bridge.SendResponse{
+		Result:  result,
+		Tickets: tickets,
+	}
+}
+
+// getAcceptedBridgeOperations returns the bridge outgoing data whose result holds no error, or the provided bridge
+// operations if all of them were accepted
+func getAcceptedBridgeOperations(data *sovereign.BridgeOperations, result *bridge.OperationsResult) *sovereign.BridgeOperations {
+	if len(result.GetResults()) == len(data.Data) && result.Err() == nil {
+		return data
+	}
+
+	accepted := &sovereign.BridgeOperations{
+		Data: make([]*sovereign.BridgeOutGoingData, 0, len(data.Data)),
+	}
+	for idx, bridgeData := range data.Data {
+		if idx >= len(result.GetResults()) || result.GetResults()[idx].Failed() {
+			continue
+		}
+
+		accepted.Data = append(accepted.Data, bridgeData)
+	}
+
+	return accepted
+}
+
+// sendQueued sends the txs of all queued bridge operations at once, until the context is done. The tx sender sends
+// them by priority, so validator set changes jump ahead of the queued deposits, which keep their queued order. Bridge
+// outgoing data which could not be sent is queued again after a delay, so that the worker is never blocked by it. It
+// remains in-flight until queued again, so that draining waits for it. Held bridge outgoing data is journaled by the tx
+// sender, which sends it in background, so its ticket is finished once notified through onHeldDataReleased.
+func (s *server) sendQueued(ctx context.Context) {
+	for {
+		queued, ok := s.tickets.popAll(ctx)
+		if !ok {
+			return
+		}
+
+		data := mergeBridgeOperations(queued)
+		result := s.sendTxs(ctx, data)
+		unfinished := s.tickets.finish(data, result)
+		if len(unfinished.Data) != 0 {
+			s.addSend(unfinished)
+		}
+		for _, queuedData := range queued {
+			s.endSend(queuedData)
+		}
+
+		err := result.Err()
+		if err != nil {
+			log.Error("could not send all queued bridge txs", "error", err)
+		}
+		if len(unfinished.Data) != 0 {
+			log.Debug("queued bridge operations not finished, will be sent again", "bridge data", len(unfinished.Data))
+			go s.requeueAfterDelay(ctx, unfinished)
+		}
+	}
+}
+
+// requeueAfterDelay queues the bridge operations again after the retry delay. If the context is done first, they are no
+// longer in-flight, since they are resumed from journal at next start.
+func (s *server) requeueAfterDelay(ctx context.Context, data *sovereign.BridgeOperations) {
+	timer := time.NewTimer(s.retryDelay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		s.endSend(data)
+	case <-timer.C:
+		s.tickets.requeue(data)
+	}
+}
+
+// onHeldDataReleased is notified by the tx sender once the held bridge outgoing data was sent or rejected in background,
+// so that its ticket is finished. If its txs could not be sent, it is queued again after a delay, unless the server is
+// draining, since it is resumed from journal at next start.
+func (s *server) onHeldDataReleased(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult) {
+	unfinished := s.tickets.finishHeld(bridgeData, result)
+	if len(unfinished.Data) == 0 {
+		return
+	}
+
+	err := s.startSend(unfinished)
+	if err != nil {
+		log.Warn("released bridge operation not sent again", "hash", hex.EncodeToString(bridgeData.Hash), "error", err)
+		return
+	}
+
+	log.Debug("released bridge operation not finished, will be sent again", "hash", hex.EncodeToString(bridgeData.Hash))
+	go s.requeueAfterDelay(s.ctx, unfinished)
+}
+
+// mergeBridgeOperations returns the bridge outgoing data of all bridge operations, in order. Bridge outgoing data
+// queued again by retried requests is only kept once.
+func mergeBridgeOperations(queued []*sovereign.BridgeOperations) *sovereign.BridgeOperations {
+	merged := &sovereign.BridgeOperations{
+		Data: make([]*sovereign.BridgeOutGoingData, 0),
+	}
+	hashes := make(map[string]struct{})
+	for _, data := range queued {
+		for _, bridgeData := range data.Data {
+			if _, exists := hashes[string(bridgeData.Hash)]; exists {
+				continue
+			}
+
+			hashes[string(bridgeData.Hash)] = struct{}{}
+			merged.Data = append(merged.Data, bridgeData)
+		}
+	}
+
+	return merged
+}
+
+// GetTicket returns the ticket of the bridge outgoing data with the requested hash. Tickets are only kept in
+// asynchronous mode, for the bridge operations accepted since the server started.
+func (s *server) GetTicket(_ context.Context, req *bridge.TicketRequest) (*bridge.Ticket, error) {
+	if s.tickets == nil {
+		return nil, status.Error(codes.FailedPrecondition, errAsyncModeDisabled.Error())
+	}
+
+	ticket, found := s.tickets.get(req.GetHash())
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("%s, hash = %s", errTicketNotFound, hex.EncodeToString(req.GetHash())))
+	}
+
+	return ticket, nil
+}
+
+// verifySignatures checks the aggregated signature of all bridge outgoing data, in order, so that no gas is paid for txs
+// which would be rejected by the contracts. Each verified validator set change is added as pending before verifying the
+// next bridge outgoing data, since the following ones may be signed by the new validators. If any signature is invalid,
+// the bridge operations are refused, so the validator set changes added while verifying them are removed.
+func (s *server) verifySignatures(data *sovereign.BridgeOperations) error {
+	errs := make([]error, 0)
+	for _, bridgeData := range data.Data {
+		err := s.signatureVerifier.Verify(bridgeData)
+		if err != nil {
+			hash := hex.EncodeToString(bridgeData.Hash)
+			log.Error("rejected bridge operation with invalid signature", "hash", hash, "error", err)
+			errs = append(errs, fmt.Errorf("%w, hash = %s: %v", errInvalidBridgeData, hash, err))
+			continue
+		}
+
+		err = s.signatureVerifier.AddValidatorSetChange(bridgeData)
+		if err != nil {
+			log.Error("could not add validator set change", "hash", hex.EncodeToString(bridgeData.Hash), "error", err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	s.removeValidatorSetChanges(data)
+	return status.Error(codes.InvalidArgument, errors.Join(errs...).Error())
+}
+
+// removeRejectedValidatorSetChanges removes the pending validator sets of the validator set changes which were rejected,
+// since their txs may never be sent
+func (s *server) removeRejectedValidatorSetChanges(data *sovereign.BridgeOperations, result *bridge.OperationsResult) {
+	for idx, bridgeData := range data.Data {
+		if idx >= len(result.GetResults()) || result.GetResults()[idx].Failed() {
+			s.signatureVerifier.RemoveValidatorSetChange(bridgeData)
+		}
+	}
+}
+
+// removeValidatorSetChanges removes the pending validator sets of all validator set changes of the bridge operations,
+// which are not going to be sent
+func (s *server) removeValidatorSetChanges(data *sovereign.BridgeOperations) {
+	for _, bridgeData := range data.Data {
+		s.signatureVerifier.RemoveValidatorSetChange(bridgeData)
+	}
+}
+
+// onValidatorSetChange is notified by the tx sender once the tx of a validator set change is confirmed or failed
+// on-chain. The validator set is only rotated once the validator set change is confirmed.
+func (s *server) onValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData, confirmed bool) {
+	if !confirmed {
+		s.signatureVerifier.RemoveValidatorSetChange(bridgeData)
+		return
+	}
+
+	err := s.signatureVerifier.ConfirmValidatorSetChange(bridgeData)
+	if err != nil {
+		log.Error("could not confirm validator set change", "hash", hex.EncodeToString(bridgeData.Hash), "error", err)
+	}
+}
+
+func (s *server) startSend(data *sovereign.BridgeOperations) error {
+	s.mutSends.Lock()
+	defer s.mutSends.Unlock()
+
+	if s.draining {
+		return status.Error(codes.Unavailable, errServerDraining.Error())
+	}
+
+	s.inFlightSends[data] = struct{}{}
+	return nil
+}
+
+// replaceSend tracks the accepted bridge operations as in-flight instead of the received ones, which were partially
+// rejected
+func (s *server) replaceSend(data *sovereign.BridgeOperations, accepted *sovereign.BridgeOperations) {
+	if data == accepted {
+		return
+	}
+
+	s.addSend(accepted)
+	s.endSend(data)
+}
+
+// addSend tracks the bridge operations as in-flight, even while draining, since they are part of an in-flight send
+func (s *server) addSend(data *sovereign.BridgeOperations) {
+	s.mutSends.Lock()
+	s.inFlightSends[data] = struct{}{}
+	s.mutSends.Unlock()
+}
+
+// endSend stops tracking the bridge operations as in-flight. The draining is done once the last in-flight bridge
+// operations end; ending bridge operations which are not tracked has no effect.
+func (s *server) endSend(data *sovereign.BridgeOperations) {
+	s.mutSends.Lock()
+	defer s.mutSends.Unlock()
+
+	_, found := s.inFlightSends[data]
+	if !found {
+		return
+	}
+
+	delete(s.inFlightSends, data)
+	if s.draining && len(s.inFlightSends) == 0 {
+		close(s.drained)
+	}
+}
+
+// Drain refuses any new bridge operations and waits for the in-flight ones to be sent. If the context is done first,
+// the unfinished bridge operations are reported and an error is returned. Since every bridge operation is journaled
+// before sending its txs, unfinished ones are resumed at next start.
+func (s *server) Drain(ctx context.Context) error {
+	s.mutSends.Lock()
+	if !s.draining {
+		s.draining = true
+		log.Info("draining bridge sends", "in flight", len(s.inFlightSends))
+		if len(s.inFlightSends) == 0 {
+			close(s.drained)
+		}
+	}
+	s.mutSends.Unlock()
+
+	select {
+	case <-s.drained:
+		log.Info("drained all bridge sends")
+		return nil
+	case <-ctx.Done():
+		numUnfinished := s.logUnfinishedSends()
+		return fmt.Errorf("%w, unfinished sends = %d: %v", errDrainTimeout, numUnfinished, ctx.Err())
+	}
+}
+
+func (s *server) logUnfinishedSends() int {
+	s.mutSends.Lock()
+	defer s.mutSends.Unlock()
+
+	for data := range s.inFlightSends {
+		for _, bridgeData := range data.Data {
+			log.Warn("unfinished bridge operation, will be resumed from journal at next start",
+				"hash", hex.EncodeToString(bridgeData.Hash))
+		}
+	}
+
+	return len(s.inFlightSends)
+}
+
+func setResultHeader(ctx context.Context, result *bridge.OperationsResult) {
+	md, err := result.ToMetadata()
+	if err != nil {
+		log.Error("could not encode bridge operations result", "error", err)
+		return
+	}
+
+	setHeader(ctx, md, bridge.ResultMetadataKey)
+}
+
+func setTicketsHeader(ctx context.Context, tickets *bridge.Tickets) {
+	md, err := tickets.ToMetadata()
+	if err != nil {
+		log.Error("could not encode bridge tickets", "error", err)
+		return
+	}
+
+	setHeader(ctx, md, bridge.TicketsMetadataKey)
+}
+
+// setHeader attaches the metadata to the grpc response header, unless its value exceeds the max header size
+func setHeader(ctx context.Context, md metadata.MD, key string) {
+	size := 0
+	for _, value := range md.Get(key) {
+		size += len(value)
+	}
+	if size > maxHeaderSize {
+		log.Warn("response header too large, not attached; use SendOperations to get it in the response body",
+			"key", key, "size", size, "max size", maxHeaderSize)
+		return
+	}
+
+	err := grpc.SetHeader(ctx, md)
+	if err != nil {
+		log.Debug("could not set response header", "key", key, "error", err)
+	}
+}
+
 func logTxHashes(hashes []string) {
 	for _, hash := range hashes {
 		log.Info("sent tx", "hash", hash)
 	}
 }
 
+// GetOperationResult returns the outcome of all txs sent for the bridge outgoing data with the provided hash
+func (s *server) GetOperationResult(bridgeDataHash []byte) (*results.OperationResult, bool) {
+	return s.txSender.GetOperationResult(bridgeDataHash)
+}
+
+// GetWalletsStatus returns the nonce, balance and number of in-flight txs of each wallet used to send bridge txs
+func (s *server) GetWalletsStatus() []*results.WalletStatus {
+	return s.txSender.GetWalletsStatus()
+}
+
+// CheckNetwork checks that the network config is loaded and the proxy is reachable
+func (s *server) CheckNetwork(ctx context.Context) error {
+	return s.txSender.CheckNetwork(ctx)
+}
+
+// Close stops the background worker and resume, if any, and closes the internal tx sender
+func (s *server) Close() error {
+	s.cancel()
+
+	return s.txSender.Close()
+}
+
 // IsInterfaceNil checks if the underlying pointer is nil
 func (s *server) IsInterfaceNil() bool {
 	return s == nil
@@ -2,11 +2,21 @@ package server
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txSender"
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
 )
 
@@ -14,12 +24,22 @@ func TestNewSovereignBridgeTxServer(t *testing.T) {
 	t.Parallel()
 
 	t.Run("nil tx sender", func(t *testing.T) {
-		bridgeServer, err := NewSovereignBridgeTxServer(nil)
+		bridgeServer, err := NewSovereignBridgeTxServer(nil, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
 		require.Equal(t, errNilTxSender, err)
 		require.Nil(t, bridgeServer)
 	})
+	t.Run("nil metrics handler", func(t *testing.T) {
+		bridgeServer, err := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{}, nil, &testscommon.SignatureVerifierMock{})
+		require.Equal(t, errNilMetricsHandler, err)
+		require.Nil(t, bridgeServer)
+	})
+	t.Run("nil signature verifier", func(t *testing.T) {
+		bridgeServer, err := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{}, &testscommon.MetricsHandlerMock{}, nil)
+		require.Equal(t, errNilSignatureVerifier, err)
+		require.Nil(t, bridgeServer)
+	})
 	t.Run("should work", func(t *testing.T) {
-		bridgeServer, err := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{})
+		bridgeServer, err := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{}, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
 		require.Nil(t, err)
 		require.False(t, bridgeServer.IsInterfaceNil())
 	})
@@ -36,17 +56,949 @@ func TestServer_Send(t *testing.T) {
 			},
 		},
 	}
+
+	t.Run("all txs sent", func(t *testing.T) {
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				require.Equal(t, expectedBridgeOps, data)
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{
+							Hash: []byte("hash"),
+							Txs:  []*bridge.TxResult{{Data: []byte("txData"), Hash: "txHash"}},
+						},
+					},
+				}
+			},
+		}
+
+		observedSend := false
+		metricsHandler := &testscommon.MetricsHandlerMock{
+			ObserveSendCalled: func(data *sovereign.BridgeOperations, result *bridge.OperationsResult, duration time.Duration) {
+				require.Equal(t, expectedBridgeOps, data)
+				require.Equal(t, expectedTxHashes, result.TxHashes())
+				observedSend = true
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, metricsHandler, &testscommon.SignatureVerifierMock{})
+		res, err := bridgeServer.Send(context.Background(), expectedBridgeOps)
+		require.Nil(t, err)
+		require.Equal(t, &sovereign.BridgeOperationsResponse{
+			TxHashes: expectedTxHashes,
+		}, res)
+		require.True(t, observedSend)
+	})
+	t.Run("some txs failed", func(t *testing.T) {
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{
+							Hash: []byte("hash"),
+							Txs: []*bridge.TxResult{
+								{Data: []byte("txData1"), Hash: "txHash"},
+								{Data: []byte("txData2"), Stage: bridge.ErrorStage_Broadcast, Error: "broadcast error"},
+							},
+						},
+					},
+				}
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		res, err := bridgeServer.Send(context.Background(), expectedBridgeOps)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "broadcast error")
+		require.Nil(t, res)
+	})
+	t.Run("invalid signature, should not send txs", func(t *testing.T) {
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				require.Fail(t, "should not send txs")
+				return nil
+			},
+		}
+		signatureVerifier := &testscommon.SignatureVerifierMock{
+			VerifyCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+				return errors.New("invalid aggregated signature")
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, signatureVerifier)
+		res, err := bridgeServer.Send(context.Background(), expectedBridgeOps)
+		require.Nil(t, res)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Contains(t, err.Error(), "invalid aggregated signature")
+		require.Contains(t, err.Error(), hex.EncodeToString([]byte("hash")))
+	})
+	t.Run("should remove validator set changes which were rejected", func(t *testing.T) {
+		bridgeOps := &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{
+				{Hash: []byte("hash1")},
+				{Hash: []byte("hash2")},
+			},
+		}
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{Hash: []byte("hash1"), Txs: []*bridge.TxResult{{Data: []byte("txData1"), Hash: "txHash"}}},
+						{Hash: []byte("hash2"), Stage: bridge.ErrorStage_Journal, Error: "journal error"},
+					},
+				}
+			},
+		}
+		addedHashes := make([][]byte, 0)
+		removedHashes := make([][]byte, 0)
+		signatureVerifier := &testscommon.SignatureVerifierMock{
+			AddValidatorSetChangeCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+				addedHashes = append(addedHashes, bridgeData.Hash)
+				return nil
+			},
+			RemoveValidatorSetChangeCalled: func(bridgeData *sovereign.BridgeOutGoingData) {
+				removedHashes = append(removedHashes, bridgeData.Hash)
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, signatureVerifier)
+		_, err := bridgeServer.Send(context.Background(), bridgeOps)
+		require.NotNil(t, err)
+		require.Equal(t, [][]byte{[]byte("hash1"), []byte("hash2")}, addedHashes)
+		require.Equal(t, [][]byte{[]byte("hash2")}, removedHashes)
+	})
+	t.Run("should verify bridge data signed by the validators of a previous validator set change", func(t *testing.T) {
+		bridgeOps := &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{
+				{Hash: []byte("validatorSetChange")},
+				{Hash: []byte("hash")},
+			},
+		}
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{Hash: []byte("validatorSetChange"), Txs: []*bridge.TxResult{{Data: []byte("txData1"), Hash: "txHash1"}}},
+						{Hash: []byte("hash"), Txs: []*bridge.TxResult{{Data: []byte("txData2"), Hash: "txHash2"}}},
+					},
+				}
+			},
+		}
+		changedValidatorSet := false
+		signatureVerifier := &testscommon.SignatureVerifierMock{
+			VerifyCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+				if string(bridgeData.Hash) == "hash" && !changedValidatorSet {
+					return errors.New("invalid aggregated signature")
+				}
+				return nil
+			},
+			AddValidatorSetChangeCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+				changedValidatorSet = changedValidatorSet || string(bridgeData.Hash) == "validatorSetChange"
+				return nil
+			},
+			RemoveValidatorSetChangeCalled: func(bridgeData *sovereign.BridgeOutGoingData) {
+				require.Fail(t, "should not remove validator set change")
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, signatureVerifier)
+		res, err := bridgeServer.Send(context.Background(), bridgeOps)
+		require.Nil(t, err)
+		require.Equal(t, []string{"txHash1", "txHash2"}, res.TxHashes)
+	})
+	t.Run("invalid signature, should remove the validator set changes added while verifying", func(t *testing.T) {
+		bridgeOps := &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{
+				{Hash: []byte("validatorSetChange")},
+				{Hash: []byte("hash")},
+			},
+		}
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				require.Fail(t, "should not send txs")
+				return nil
+			},
+		}
+		removedHashes := make([][]byte, 0)
+		signatureVerifier := &testscommon.SignatureVerifierMock{
+			VerifyCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+				if string(bridgeData.Hash) == "hash" {
+					return errors.New("invalid aggregated signature")
+				}
+				return nil
+			},
+			RemoveValidatorSetChangeCalled: func(bridgeData *sovereign.BridgeOutGoingData) {
+				removedHashes = append(removedHashes, bridgeData.Hash)
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, signatureVerifier)
+		res, err := bridgeServer.Send(context.Background(), bridgeOps)
+		require.Nil(t, res)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Contains(t, removedHashes, []byte("validatorSetChange"))
+	})
+}
+
+func TestServer_OnValidatorSetChange(t *testing.T) {
+	t.Parallel()
+
+	var onValidatorSetChange func(bridgeData *sovereign.BridgeOutGoingData, confirmed bool)
 	txSender := &testscommon.TxSenderMock{
-		SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
-			require.Equal(t, expectedBridgeOps, data)
-			return expectedTxHashes, nil
+		RegisterValidatorSetChangeHandlerCalled: func(handler func(bridgeData *sovereign.BridgeOutGoingData, confirmed bool)) {
+			onValidatorSetChange = handler
+		},
+	}
+	confirmedHashes := make([][]byte, 0)
+	removedHashes := make([][]byte, 0)
+	signatureVerifier := &testscommon.SignatureVerifierMock{
+		ConfirmValidatorSetChangeCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+			confirmedHashes = append(confirmedHashes, bridgeData.Hash)
+			return errors.New("state file error")
+		},
+		RemoveValidatorSetChangeCalled: func(bridgeData *sovereign.BridgeOutGoingData) {
+			removedHashes = append(removedHashes, bridgeData.Hash)
+		},
+	}
+
+	_, err := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, signatureVerifier)
+	require.Nil(t, err)
+	require.NotNil(t, onValidatorSetChange)
+
+	onValidatorSetChange(&sovereign.BridgeOutGoingData{Hash: []byte("confirmed")}, true)
+	onValidatorSetChange(&sovereign.BridgeOutGoingData{Hash: []byte("failed")}, false)
+	require.Equal(t, [][]byte{[]byte("confirmed")}, confirmedHashes)
+	require.Equal(t, [][]byte{[]byte("failed")}, removedHashes)
+}
+
+func TestServer_SendOperations(t *testing.T) {
+	t.Parallel()
+
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("hash"),
+			},
+		},
+	}
+	failedResult := &bridge.OperationsResult{
+		Results: []*bridge.OutGoingDataResult{
+			{
+				Hash: []byte("hash"),
+				Txs: []*bridge.TxResult{
+					{Data: []byte("txData1"), Hash: "txHash"},
+					{Data: []byte("txData2"), Stage: bridge.ErrorStage_Broadcast, Error: "broadcast error"},
+				},
+			},
 		},
 	}
 
-	bridgeServer, _ := NewSovereignBridgeTxServer(txSender)
-	res, err := bridgeServer.Send(context.Background(), expectedBridgeOps)
+	t.Run("some txs failed, should return the result in the response body", func(t *testing.T) {
+		txSenderMock := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return failedResult
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		res, err := bridgeServer.SendOperations(context.Background(), bridgeOps)
+		require.Nil(t, err)
+		require.Equal(t, failedResult, res.Result)
+		require.Nil(t, res.Tickets)
+		require.Equal(t, []string{"txHash"}, res.Result.TxHashes())
+		require.NotNil(t, res.Result.Err())
+	})
+	t.Run("invalid signature, should return error", func(t *testing.T) {
+		signatureVerifier := &testscommon.SignatureVerifierMock{
+			VerifyCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+				return errors.New("invalid aggregated signature")
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{}, &testscommon.MetricsHandlerMock{}, signatureVerifier)
+		res, err := bridgeServer.SendOperations(context.Background(), bridgeOps)
+		require.Nil(t, res)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("asynchronous mode, should return the tickets in the response body", func(t *testing.T) {
+		txSenderMock := &testscommon.TxSenderMock{
+			AcceptCalled: func(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{Results: []*bridge.OutGoingDataResult{{Hash: []byte("hash")}}}
+			},
+		}
+
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		defer func() {
+			_ = bridgeServer.Close()
+		}()
+
+		res, err := bridgeServer.SendOperations(context.Background(), bridgeOps)
+		require.Nil(t, err)
+		require.Nil(t, res.Result.Err())
+		require.Len(t, res.Tickets.Tickets, 1)
+		require.Equal(t, []byte("hash"), res.Tickets.Tickets[0].Hash)
+	})
+}
+
+type headerTransportStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (stream *headerTransportStream) SetHeader(md metadata.MD) error {
+	stream.header = metadata.Join(stream.header, md)
+	return nil
+}
+
+func TestServer_SendResultHeader(t *testing.T) {
+	t.Parallel()
+
+	sendWithTxData := func(txData []byte) metadata.MD {
+		txSenderMock := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{
+							Hash: []byte("hash"),
+							Txs:  []*bridge.TxResult{{Data: txData, Hash: "txHash"}},
+						},
+					},
+				}
+			},
+		}
+
+		stream := &headerTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		res, err := bridgeServer.Send(ctx, &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("hash")}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, []string{"txHash"}, res.TxHashes)
+
+		return stream.header
+	}
+
+	header := sendWithTxData([]byte("txData"))
+	result, err := bridge.OperationsResultFromMetadata(header)
 	require.Nil(t, err)
-	require.Equal(t, &sovereign.BridgeOperationsResponse{
-		TxHashes: expectedTxHashes,
-	}, res)
+	require.Equal(t, []string{"txHash"}, result.TxHashes())
+
+	header = sendWithTxData(make([]byte, maxHeaderSize))
+	require.Empty(t, header.Get(bridge.ResultMetadataKey))
+}
+
+func TestServer_SendDryRun(t *testing.T) {
+	t.Parallel()
+
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("hash"),
+			},
+		},
+	}
+	txSenderMock := &testscommon.TxSenderMock{
+		SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+			require.True(t, txSender.IsDryRun(ctx))
+			return &bridge.OperationsResult{
+				Results: []*bridge.OutGoingDataResult{
+					{
+						Hash: []byte("hash"),
+						Txs:  []*bridge.TxResult{{Data: []byte("txData"), Tx: "{}"}},
+					},
+				},
+				DryRun: true,
+			}
+		},
+	}
+	metricsHandler := &testscommon.MetricsHandlerMock{
+		ObserveSendCalled: func(data *sovereign.BridgeOperations, result *bridge.OperationsResult, duration time.Duration) {
+			require.Fail(t, "should not observe dry-run")
+		},
+	}
+	removedHashes := make([][]byte, 0)
+	signatureVerifier := &testscommon.SignatureVerifierMock{
+		RemoveValidatorSetChangeCalled: func(bridgeData *sovereign.BridgeOutGoingData) {
+			removedHashes = append(removedHashes, bridgeData.Hash)
+		},
+	}
+
+	bridgeServer, _ := NewSovereignBridgeTxServer(txSenderMock, metricsHandler, signatureVerifier)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(bridge.DryRunMetadataKey, "true"))
+	res, err := bridgeServer.Send(ctx, bridgeOps)
+	require.Nil(t, err)
+	require.Empty(t, res.TxHashes)
+	require.Equal(t, [][]byte{[]byte("hash")}, removedHashes)
+}
+
+func TestServer_Drain(t *testing.T) {
+	t.Parallel()
+
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("hash"),
+			},
+		},
+	}
+
+	t.Run("no in-flight sends", func(t *testing.T) {
+		bridgeServer, _ := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{}, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+
+		res, err := bridgeServer.Send(context.Background(), bridgeOps)
+		require.Nil(t, res)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	})
+	t.Run("should wait for in-flight sends", func(t *testing.T) {
+		sendStarted := make(chan struct{})
+		finishSend := make(chan struct{})
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				close(sendStarted)
+				<-finishSend
+				return &bridge.OperationsResult{}
+			},
+		}
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+
+		sendErr := make(chan error)
+		go func() {
+			_, err := bridgeServer.Send(context.Background(), bridgeOps)
+			sendErr <- err
+		}()
+		<-sendStarted
+
+		drainErr := make(chan error)
+		go func() {
+			drainErr <- bridgeServer.Drain(context.Background())
+		}()
+
+		select {
+		case <-drainErr:
+			require.Fail(t, "should not drain while a send is in-flight")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(finishSend)
+		require.Nil(t, <-sendErr)
+		require.Nil(t, <-drainErr)
+	})
+	t.Run("timeout", func(t *testing.T) {
+		finishSend := make(chan struct{})
+		sendStarted := make(chan struct{})
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				close(sendStarted)
+				<-finishSend
+				return &bridge.OperationsResult{}
+			},
+		}
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		defer close(finishSend)
+
+		go func() {
+			_, _ = bridgeServer.Send(context.Background(), bridgeOps)
+		}()
+		<-sendStarted
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := bridgeServer.Drain(ctx)
+		require.ErrorIs(t, err, errDrainTimeout)
+		require.ErrorContains(t, err, "unfinished sends = 1")
+	})
+	t.Run("asynchronous mode, should wait for bridge data which is sent again", func(t *testing.T) {
+		numSends := atomic.Int32{}
+		firstSendDone := make(chan struct{})
+		txSenderMock := &testscommon.TxSenderMock{
+			AcceptCalled: func(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{Results: []*bridge.OutGoingDataResult{{Hash: []byte("hash")}}}
+			},
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				if numSends.Add(1) == 1 {
+					defer close(firstSendDone)
+					return &bridge.OperationsResult{
+						Results: []*bridge.OutGoingDataResult{
+							{
+								Hash: []byte("hash"),
+								Txs:  []*bridge.TxResult{{Data: []byte("txData"), Stage: bridge.ErrorStage_Broadcast, Error: "broadcast error"}},
+							},
+						},
+					}
+				}
+
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{
+							Hash: []byte("hash"),
+							Txs:  []*bridge.TxResult{{Data: []byte("txData"), Hash: "txHash"}},
+						},
+					},
+				}
+			},
+		}
+
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		bridgeServer.retryDelay = 50 * time.Millisecond
+		defer func() {
+			_ = bridgeServer.Close()
+		}()
+
+		_, err := bridgeServer.Send(context.Background(), bridgeOps)
+		require.Nil(t, err)
+		<-firstSendDone
+
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+		require.Equal(t, int32(2), numSends.Load())
+
+		ticket, err := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("hash")})
+		require.Nil(t, err)
+		require.Equal(t, bridge.TicketStatus_Submitted, ticket.Status)
+	})
+	t.Run("asynchronous mode, closed while bridge data waits to be sent again, should end draining", func(t *testing.T) {
+		sendDone := make(chan struct{})
+		txSenderMock := &testscommon.TxSenderMock{
+			AcceptCalled: func(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{Results: []*bridge.OutGoingDataResult{{Hash: []byte("hash")}}}
+			},
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				defer close(sendDone)
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{
+							Hash: []byte("hash"),
+							Txs:  []*bridge.TxResult{{Data: []byte("txData"), Stage: bridge.ErrorStage_Broadcast, Error: "broadcast error"}},
+						},
+					},
+				}
+			},
+		}
+
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		_, err := bridgeServer.Send(context.Background(), bridgeOps)
+		require.Nil(t, err)
+		<-sendDone
+
+		drainErr := make(chan error)
+		go func() {
+			drainErr <- bridgeServer.Drain(context.Background())
+		}()
+
+		select {
+		case <-drainErr:
+			require.Fail(t, "should not drain while bridge data waits to be sent again")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		_ = bridgeServer.Close()
+		require.Nil(t, <-drainErr)
+	})
+}
+
+func TestServer_ResumeUnfinished(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should resume in background until drained", func(t *testing.T) {
+		resumeStarted := make(chan struct{})
+		finishResume := make(chan struct{})
+		txSender := &testscommon.TxSenderMock{
+			ResumeUnfinishedCalled: func(ctx context.Context) *bridge.OperationsResult {
+				close(resumeStarted)
+				<-finishResume
+				return &bridge.OperationsResult{}
+			},
+		}
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+
+		bridgeServer.ResumeUnfinished()
+		<-resumeStarted
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, bridgeServer.Drain(ctx), errDrainTimeout)
+
+		close(finishResume)
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+	})
+	t.Run("should stop resuming when closed", func(t *testing.T) {
+		resumeStopped := make(chan struct{})
+		txSender := &testscommon.TxSenderMock{
+			ResumeUnfinishedCalled: func(ctx context.Context) *bridge.OperationsResult {
+				<-ctx.Done()
+				close(resumeStopped)
+				return &bridge.OperationsResult{}
+			},
+		}
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+
+		bridgeServer.ResumeUnfinished()
+		require.Nil(t, bridgeServer.Close())
+
+		select {
+		case <-resumeStopped:
+		case <-time.After(time.Second):
+			require.Fail(t, "resume should stop when the server is closed")
+		}
+	})
+	t.Run("not resumed while draining", func(t *testing.T) {
+		txSender := &testscommon.TxSenderMock{
+			ResumeUnfinishedCalled: func(ctx context.Context) *bridge.OperationsResult {
+				require.Fail(t, "should not resume while draining")
+				return nil
+			},
+		}
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+
+		bridgeServer.ResumeUnfinished()
+	})
+}
+
+func TestServer_SendAsync(t *testing.T) {
+	t.Parallel()
+
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("hash"),
+			},
+		},
+	}
+
+	t.Run("should accept bridge operations and send their txs in background", func(t *testing.T) {
+		finishSend := make(chan struct{})
+		sent := make(chan struct{})
+		txSenderMock := &testscommon.TxSenderMock{
+			AcceptCalled: func(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				require.Equal(t, bridgeOps, data)
+				return &bridge.OperationsResult{Results: []*bridge.OutGoingDataResult{{Hash: []byte("hash")}}}
+			},
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				require.Equal(t, bridgeOps, data)
+				<-finishSend
+				defer close(sent)
+
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{
+							Hash: []byte("hash"),
+							Txs:  []*bridge.TxResult{{Data: []byte("txData"), Hash: "txHash"}},
+						},
+					},
+				}
+			},
+		}
+		changedValidatorSet := false
+		signatureVerifier := &testscommon.SignatureVerifierMock{
+			AddValidatorSetChangeCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+				changedValidatorSet = true
+				return nil
+			},
+		}
+
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, signatureVerifier)
+		defer func() {
+			_ = bridgeServer.Close()
+		}()
+
+		res, err := bridgeServer.Send(context.Background(), bridgeOps)
+		require.Nil(t, err)
+		require.Empty(t, res.TxHashes)
+		require.True(t, changedValidatorSet)
+
+		ticket, err := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("hash")})
+		require.Nil(t, err)
+		require.False(t, ticket.IsFinished())
+
+		close(finishSend)
+		<-sent
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+
+		ticket, err = bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("hash")})
+		require.Nil(t, err)
+		require.Equal(t, bridge.TicketStatus_Submitted, ticket.Status)
+		require.Equal(t, []string{"txHash"}, ticket.Result.TxHashes())
+	})
+	t.Run("could not journal bridge operations, should return error", func(t *testing.T) {
+		txSenderMock := &testscommon.TxSenderMock{
+			AcceptCalled: func(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{Hash: []byte("hash"), Stage: bridge.ErrorStage_Journal, Error: "journal error"},
+					},
+				}
+			},
+		}
+
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		defer func() {
+			_ = bridgeServer.Close()
+		}()
+
+		res, err := bridgeServer.Send(context.Background(), bridgeOps)
+		require.NotNil(t, err)
+		require.Nil(t, res)
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+
+		_, err = bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("hash")})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("rejected bridge data, should return its error without a ticket", func(t *testing.T) {
+		data := &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{
+				{Hash: []byte("hash")},
+				{Hash: []byte("staleHash")},
+			},
+		}
+		sent := make(chan *sovereign.BridgeOperations, 1)
+		txSenderMock := &testscommon.TxSenderMock{
+			AcceptCalled: func(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{Hash: []byte("hash")},
+						{Hash: []byte("staleHash"), Stage: bridge.ErrorStage_Epoch, Error: "stale epoch"},
+					},
+				}
+			},
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				sent <- data
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{
+							Hash: []byte("hash"),
+							Txs:  []*bridge.TxResult{{Data: []byte("txData"), Hash: "txHash"}},
+						},
+					},
+				}
+			},
+		}
+
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		defer func() {
+			_ = bridgeServer.Close()
+		}()
+
+		res, err := bridgeServer.SendOperations(context.Background(), data)
+		require.Nil(t, err)
+		require.ErrorContains(t, res.Result.Err(), "stale epoch")
+		require.Len(t, res.Tickets.Tickets, 1)
+		require.Equal(t, []byte("hash"), res.Tickets.Tickets[0].Hash)
+
+		sentData := <-sent
+		require.Equal(t, data.Data[:1], sentData.Data)
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+
+		_, err = bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("staleHash")})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("bridge data which could not be sent, should be sent again until its ticket is failed", func(t *testing.T) {
+		numSends := atomic.Int32{}
+		txSenderMock := &testscommon.TxSenderMock{
+			AcceptCalled: func(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{Results: []*bridge.OutGoingDataResult{{Hash: []byte("hash")}}}
+			},
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				numSends.Add(1)
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{
+							Hash: []byte("hash"),
+							Txs:  []*bridge.TxResult{{Data: []byte("txData"), Stage: bridge.ErrorStage_Broadcast, Error: "broadcast error"}},
+						},
+					},
+				}
+			},
+		}
+
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		bridgeServer.retryDelay = time.Millisecond
+		defer func() {
+			_ = bridgeServer.Close()
+		}()
+
+		_, err := bridgeServer.Send(context.Background(), bridgeOps)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			ticket, _ := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("hash")})
+			return ticket.IsFinished()
+		}, time.Second, time.Millisecond)
+
+		ticket, err := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("hash")})
+		require.Nil(t, err)
+		require.Equal(t, bridge.TicketStatus_Failed, ticket.Status)
+		require.True(t, ticket.Result.Failed())
+		require.Equal(t, "broadcast error", ticket.Result.Txs[0].Error)
+		require.Equal(t, int32(maxSendAttempts), numSends.Load())
+	})
+	t.Run("held bridge data should not be sent again and its ticket should be finished once released", func(t *testing.T) {
+		deposit := &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("deposit"), Epoch: 1}},
+		}
+		numSends := atomic.Int32{}
+		var onHeldDataReleased func(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult)
+		txSenderMock := &testscommon.TxSenderMock{
+			AcceptCalled: func(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{Results: []*bridge.OutGoingDataResult{{Hash: data.Data[0].Hash}}}
+			},
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				numSends.Add(1)
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{{Hash: data.Data[0].Hash, Held: true}},
+				}
+			},
+			RegisterHeldDataHandlerCalled: func(handler func(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult)) {
+				onHeldDataReleased = handler
+			},
+		}
+
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		bridgeServer.retryDelay = time.Millisecond
+		defer func() {
+			_ = bridgeServer.Close()
+		}()
+
+		_, err := bridgeServer.Send(context.Background(), deposit)
+		require.Nil(t, err)
+		require.Eventually(t, func() bool {
+			ticket, _ := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("deposit")})
+			return ticket.GetResult().GetHeld()
+		}, time.Second, time.Millisecond)
+
+		ticket, err := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("deposit")})
+		require.Nil(t, err)
+		require.Equal(t, bridge.TicketStatus_Submitting, ticket.Status)
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+		require.Equal(t, int32(1), numSends.Load())
+
+		// the tx sender sends the held bridge data in background, once the validator set of its epoch is rotated
+		onHeldDataReleased(deposit.Data[0], &bridge.OutGoingDataResult{
+			Hash: []byte("deposit"),
+			Txs:  []*bridge.TxResult{{Data: []byte("txData"), Hash: "depositTxHash"}},
+		})
+
+		ticket, err = bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("deposit")})
+		require.Nil(t, err)
+		require.Equal(t, bridge.TicketStatus_Submitted, ticket.Status)
+		require.Equal(t, []string{"depositTxHash"}, ticket.Result.TxHashes())
+		require.Equal(t, int32(1), numSends.Load())
+	})
+	t.Run("released bridge data which could not be sent, should be sent again", func(t *testing.T) {
+		deposit := &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("deposit"), Epoch: 1}},
+		}
+		numSends := atomic.Int32{}
+		var onHeldDataReleased func(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult)
+		txSenderMock := &testscommon.TxSenderMock{
+			AcceptCalled: func(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{Results: []*bridge.OutGoingDataResult{{Hash: data.Data[0].Hash}}}
+			},
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				if numSends.Add(1) == 1 {
+					return &bridge.OperationsResult{
+						Results: []*bridge.OutGoingDataResult{{Hash: data.Data[0].Hash, Held: true}},
+					}
+				}
+
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{Hash: data.Data[0].Hash, Txs: []*bridge.TxResult{{Data: []byte("txData"), Hash: "depositTxHash"}}},
+					},
+				}
+			},
+			RegisterHeldDataHandlerCalled: func(handler func(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult)) {
+				onHeldDataReleased = handler
+			},
+		}
+
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		bridgeServer.retryDelay = time.Millisecond
+		defer func() {
+			_ = bridgeServer.Close()
+		}()
+
+		_, err := bridgeServer.Send(context.Background(), deposit)
+		require.Nil(t, err)
+		require.Eventually(t, func() bool {
+			ticket, _ := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("deposit")})
+			return ticket.GetResult().GetHeld()
+		}, time.Second, time.Millisecond)
+
+		onHeldDataReleased(deposit.Data[0], &bridge.OutGoingDataResult{
+			Hash: []byte("deposit"),
+			Txs:  []*bridge.TxResult{{Data: []byte("txData"), Stage: bridge.ErrorStage_Broadcast, Error: "broadcast error"}},
+		})
+		require.Eventually(t, func() bool {
+			ticket, _ := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("deposit")})
+			return ticket.IsFinished()
+		}, time.Second, time.Millisecond)
+
+		ticket, err := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("deposit")})
+		require.Nil(t, err)
+		require.Equal(t, bridge.TicketStatus_Submitted, ticket.Status)
+		require.Equal(t, []string{"depositTxHash"}, ticket.Result.TxHashes())
+		require.Equal(t, int32(2), numSends.Load())
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+
+		// released again after the ticket was finished, should be ignored
+		onHeldDataReleased(deposit.Data[0], &bridge.OutGoingDataResult{Hash: []byte("deposit"), Stage: bridge.ErrorStage_Epoch, Error: "stale epoch"})
+		ticket, err = bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("deposit")})
+		require.Nil(t, err)
+		require.Equal(t, bridge.TicketStatus_Submitted, ticket.Status)
+	})
+	t.Run("configured dry-run, should send synchronously", func(t *testing.T) {
+		txSenderMock := &testscommon.TxSenderMock{
+			AcceptCalled: func(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{DryRun: true}
+			},
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+				return &bridge.OperationsResult{
+					Results: []*bridge.OutGoingDataResult{
+						{
+							Hash: []byte("hash"),
+							Txs:  []*bridge.TxResult{{Data: []byte("txData"), Tx: "{}"}},
+						},
+					},
+					DryRun: true,
+				}
+			},
+		}
+
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(txSenderMock, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		defer func() {
+			_ = bridgeServer.Close()
+		}()
+
+		_, err := bridgeServer.Send(context.Background(), bridgeOps)
+		require.Nil(t, err)
+		require.Nil(t, bridgeServer.Drain(context.Background()))
+
+		_, err = bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("hash")})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestServer_GetTicket(t *testing.T) {
+	t.Parallel()
+
+	t.Run("synchronous mode", func(t *testing.T) {
+		bridgeServer, _ := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{}, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		ticket, err := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("hash")})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.Nil(t, ticket)
+	})
+	t.Run("unknown hash", func(t *testing.T) {
+		bridgeServer, _ := NewAsyncSovereignBridgeTxServer(&testscommon.TxSenderMock{}, &testscommon.MetricsHandlerMock{}, &testscommon.SignatureVerifierMock{})
+		defer func() {
+			_ = bridgeServer.Close()
+		}()
+
+		ticket, err := bridgeServer.GetTicket(context.Background(), &bridge.TicketRequest{Hash: []byte("hash")})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.Nil(t, ticket)
+	})
 }
@@ -1,14 +1,44 @@
 package config
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
+
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/cert"
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txSender"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/signer"
 )
 
-// ServerConfig holds necessary config for the grpc server
+// ServerConfig holds necessary config for the grpc server. Drain timeout, in milliseconds, is the max time to wait
+// for in-flight bridge sends at shutdown. Validators file holds the validator set used to verify the bridge operations
+// signatures, which are not verified if empty. In async mode, bridge operations are acknowledged with tickets as soon
+// as they are journaled, and their txs are sent in the background.
 type ServerConfig struct {
-	GRPCPort          string
-	TxSenderConfig    txSender.TxSenderConfig
-	WalletConfig      txSender.WalletConfig
-	CertificateConfig cert.FileCfg
+	GRPCPort            string
+	DrainTimeout        int
+	ValidatorsFile      string
+	AsyncMode           bool
+	TxSenderConfig      txSender.TxSenderConfig
+	WalletsConfig       []txSender.WalletConfig
+	RemoteSignersConfig []signer.RemoteSignerConfig
+	CertificateConfig   cert.FileCfg
+	HealthConfig        HealthConfig
+}
+
+// HealthConfig holds the config of the readiness checks. Min wallet balance is denominated, check interval is in
+// milliseconds. If set, the health probes are also served without client certificates on the port.
+type HealthConfig struct {
+	MinWalletBalance string
+	CheckInterval    int
+	Port             string
+}
+
+// LoadConfig loads the server config from the provided toml file
+func LoadConfig(filePath string) (*ServerConfig, error) {
+	cfg := &ServerConfig{}
+	err := core.LoadTomlFile(cfg, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
 }
@@ -0,0 +1,45 @@
+package config
+
+import "errors"
+
+var errInvalidGRPCPort = errors.New("invalid grpc port, should be a number between 1 and 65535")
+
+var errInvalidHealthPort = errors.New("invalid health port, should be a number between 1 and 65535")
+
+var errHealthPortInUse = errors.New("health port should differ from the grpc port")
+
+var errNoWallets = errors.New("no wallets or remote signers provided")
+
+var errNoWalletPath = errors.New("no wallet path provided")
+
+var errNoRemoteSignerAddress = errors.New("no remote signer address provided")
+
+var errFileNotFound = errors.New("file not found")
+
+var errInvalidProxyURL = errors.New("invalid proxy url, should be an http(s) url")
+
+var errInvalidSCAddress = errors.New("invalid sc address, should be a bech32 address")
+
+var errInvalidHasher = errors.New("invalid hasher")
+
+var errNoJournalDir = errors.New("no journal dir provided")
+
+var errInvalidInterval = errors.New("invalid interval")
+
+var errInvalidGasEstimationMultiplier = errors.New("invalid gas estimation multiplier, should be at least 1")
+
+var errInvalidMaxRetryAttempts = errors.New("invalid max retry attempts, should be at least 1")
+
+var errInvalidMaxBatchSize = errors.New("invalid max txs batch size")
+
+var errInvalidJournalHistorySize = errors.New("invalid journal history size, should be positive when journal pruning is enabled")
+
+var errDuplicatedTypePriority = errors.New("duplicated bridge outgoing data type priority")
+
+var errInvalidGasPriceBump = errors.New("invalid gas price bump percentage, should be positive when stuck txs replacement is enabled")
+
+var errInvalidMaxGasPrice = errors.New("invalid max gas price, should be positive when stuck txs replacement is enabled")
+
+var errInvalidMinWalletBalance = errors.New("invalid min wallet balance, should be a non-negative integer")
+
+var errCRLWithoutCAFile = errors.New("crl file requires a ca file")
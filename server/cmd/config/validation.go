@@ -0,0 +1,329 @@
+package config
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-sdk-go/data"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/cert"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txSender"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/signer"
+)
+
+// allowed ranges of the configured intervals, in milliseconds
+const (
+	minIntervalToSend     = 1
+	maxIntervalToSend     = 60_000
+	minStatusPollInterval = 100
+	maxStatusPollInterval = 600_000
+	minRetryBackoff       = 1
+	maxRetryBackoff       = 30_000
+	maxStuckTxTimeout     = 86_400_000
+	maxJournalRetention   = 2_592_000_000
+	minHealthInterval     = 1_000
+	maxHealthInterval     = 600_000
+	minDrainTimeout       = 1_000
+	maxDrainTimeout       = 600_000
+)
+
+// maxTxsBatchSize is the max number of txs broadcast in a single request to the proxy
+const maxTxsBatchSize = 1000
+
+// CheckServerConfig validates the server config, so that misconfigurations are reported at startup
+func CheckServerConfig(cfg *ServerConfig) error {
+	err := checkGRPCPort(cfg.GRPCPort)
+	if err != nil {
+		return err
+	}
+
+	err = checkInterval("drain timeout", cfg.DrainTimeout, minDrainTimeout, maxDrainTimeout)
+	if err != nil {
+		return err
+	}
+
+	err = checkCertificateConfig(cfg.CertificateConfig)
+	if err != nil {
+		return err
+	}
+
+	if len(cfg.ValidatorsFile) != 0 {
+		err = checkFileExists(cfg.ValidatorsFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = checkSignersConfig(cfg.WalletsConfig, cfg.RemoteSignersConfig)
+	if err != nil {
+		return err
+	}
+
+	err = checkTxSenderConfig(cfg.TxSenderConfig)
+	if err != nil {
+		return err
+	}
+
+	return checkHealthConfig(cfg.HealthConfig, cfg.GRPCPort)
+}
+
+func checkGRPCPort(grpcPort string) error {
+	port, err := strconv.Atoi(grpcPort)
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("%w, port = %s", errInvalidGRPCPort, grpcPort)
+	}
+
+	return nil
+}
+
+func checkHealthPort(healthPort string, grpcPort string) error {
+	port, err := strconv.Atoi(healthPort)
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("%w, port = %s", errInvalidHealthPort, healthPort)
+	}
+
+	// the grpc port is already validated
+	listenPort, _ := strconv.Atoi(grpcPort)
+	if port == listenPort {
+		return fmt.Errorf("%w, port = %s", errHealthPortInUse, healthPort)
+	}
+
+	return nil
+}
+
+func checkCertificateConfig(cfg cert.FileCfg) error {
+	err := checkFileExists(cfg.CertFile)
+	if err != nil {
+		return err
+	}
+
+	err = checkFileExists(cfg.PkFile)
+	if err != nil {
+		return err
+	}
+
+	// without a ca file, only peers holding the same certificate are trusted
+	if len(cfg.CAFile) == 0 && len(cfg.CRLFile) != 0 {
+		return errCRLWithoutCAFile
+	}
+
+	for _, optionalFile := range []string{cfg.CAFile, cfg.CRLFile, cfg.AllowlistFile} {
+		if len(optionalFile) == 0 {
+			continue
+		}
+
+		err = checkFileExists(optionalFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkSignersConfig checks the remote signers, if any are configured, since local wallets are then not loaded
+func checkSignersConfig(walletsConfig []txSender.WalletConfig, remoteSignersConfig []signer.RemoteSignerConfig) error {
+	if len(remoteSignersConfig) != 0 {
+		return checkRemoteSignersConfig(remoteSignersConfig)
+	}
+	if len(walletsConfig) == 0 {
+		return errNoWallets
+	}
+
+	for _, walletCfg := range walletsConfig {
+		if len(walletCfg.Path) == 0 {
+			return errNoWalletPath
+		}
+
+		err := checkFileExists(walletCfg.Path)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkRemoteSignersConfig(remoteSignersConfig []signer.RemoteSignerConfig) error {
+	for _, remoteSignerCfg := range remoteSignersConfig {
+		if len(remoteSignerCfg.Address) == 0 {
+			return errNoRemoteSignerAddress
+		}
+		if strings.HasPrefix(remoteSignerCfg.Address, signer.UnixSocketPrefix) {
+			continue
+		}
+
+		err := checkCertificateConfig(remoteSignerCfg.CertificateCfg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkTxSenderConfig(cfg txSender.TxSenderConfig) error {
+	err := checkProxyURL(cfg.Proxy)
+	if err != nil {
+		return err
+	}
+
+	err = checkRouting(cfg)
+	if err != nil {
+		return err
+	}
+
+	_, err = factory.NewHasher(cfg.Hasher)
+	if err != nil {
+		return fmt.Errorf("%w, hasher = %s: %v", errInvalidHasher, cfg.Hasher, err)
+	}
+	if len(cfg.JournalDir) == 0 {
+		return errNoJournalDir
+	}
+
+	err = checkInterval("interval to send", cfg.IntervalToSend, minIntervalToSend, maxIntervalToSend)
+	if err != nil {
+		return err
+	}
+	err = checkInterval("status poll interval", cfg.StatusPollInterval, minStatusPollInterval, maxStatusPollInterval)
+	if err != nil {
+		return err
+	}
+	err = checkInterval("retry backoff", cfg.RetryBackoff, minRetryBackoff, maxRetryBackoff)
+	if err != nil {
+		return err
+	}
+	err = checkInterval("stuck tx timeout", cfg.StuckTxTimeout, 0, maxStuckTxTimeout)
+	if err != nil {
+		return err
+	}
+	err = checkInterval("journal retention", cfg.JournalRetention, 0, maxJournalRetention)
+	if err != nil {
+		return err
+	}
+	if cfg.JournalRetention != 0 && cfg.JournalHistorySize < 1 {
+		return fmt.Errorf("%w, history size = %d", errInvalidJournalHistorySize, cfg.JournalHistorySize)
+	}
+
+	if cfg.GasEstimationMultiplier < 1 {
+		return fmt.Errorf("%w, multiplier = %f", errInvalidGasEstimationMultiplier, cfg.GasEstimationMultiplier)
+	}
+	if cfg.MaxRetryAttempts < 1 {
+		return fmt.Errorf("%w, attempts = %d", errInvalidMaxRetryAttempts, cfg.MaxRetryAttempts)
+	}
+	if cfg.MaxBatchSize < 1 || cfg.MaxBatchSize > maxTxsBatchSize {
+		return fmt.Errorf("%w, batch size = %d, allowed = [1, %d]", errInvalidMaxBatchSize, cfg.MaxBatchSize, maxTxsBatchSize)
+	}
+	err = checkTypePriorities(cfg.TypePriorities)
+	if err != nil {
+		return err
+	}
+
+	// stuck txs replacement is disabled
+	if cfg.StuckTxTimeout == 0 {
+		return nil
+	}
+	if cfg.GasPriceBumpPercentage == 0 {
+		return errInvalidGasPriceBump
+	}
+	if cfg.MaxGasPrice == 0 {
+		return errInvalidMaxGasPrice
+	}
+
+	return nil
+}
+
+func checkTypePriorities(cfgs []txSender.TypePriorityConfig) error {
+	types := make(map[int32]struct{}, len(cfgs))
+	for _, cfg := range cfgs {
+		if _, exists := types[cfg.Type]; exists {
+			return fmt.Errorf("%w, type = %d", errDuplicatedTypePriority, cfg.Type)
+		}
+
+		types[cfg.Type] = struct{}{}
+	}
+
+	return nil
+}
+
+func checkHealthConfig(cfg HealthConfig, grpcPort string) error {
+	_, err := ParseMinWalletBalance(cfg.MinWalletBalance)
+	if err != nil {
+		return err
+	}
+
+	if len(cfg.Port) != 0 {
+		err = checkHealthPort(cfg.Port, grpcPort)
+		if err != nil {
+			return err
+		}
+	}
+
+	return checkInterval("health check interval", cfg.CheckInterval, minHealthInterval, maxHealthInterval)
+}
+
+// ParseMinWalletBalance parses the denominated min wallet balance, below which the server is not ready
+func ParseMinWalletBalance(minWalletBalance string) (*big.Int, error) {
+	balance, ok := big.NewInt(0).SetString(minWalletBalance, 10)
+	if !ok || balance.Sign() < 0 {
+		return nil, fmt.Errorf("%w, balance = %s", errInvalidMinWalletBalance, minWalletBalance)
+	}
+
+	return balance, nil
+}
+
+func checkProxyURL(proxy string) error {
+	proxyURL, err := url.Parse(proxy)
+	if err != nil || (proxyURL.Scheme != "http" && proxyURL.Scheme != "https") || len(proxyURL.Host) == 0 {
+		return fmt.Errorf("%w, proxy = %s", errInvalidProxyURL, proxy)
+	}
+
+	return nil
+}
+
+// checkRouting checks the routing table file, if configured, otherwise the sc addresses of the default routing table
+func checkRouting(cfg txSender.TxSenderConfig) error {
+	if len(cfg.RoutingTableFile) != 0 {
+		return checkFileExists(cfg.RoutingTableFile)
+	}
+
+	scAddresses := []struct {
+		name    string
+		address string
+	}{
+		{name: "header verifier", address: cfg.HeaderVerifierSCAddress},
+		{name: "esdt safe", address: cfg.EsdtSafeSCAddress},
+		{name: "change validators", address: cfg.ChangeValidatorsSCAddress},
+		{name: "chain config", address: cfg.ChainConfigSCAddress},
+	}
+	for _, sc := range scAddresses {
+		_, err := data.NewAddressFromBech32String(sc.address)
+		if err != nil {
+			return fmt.Errorf("%w, sc = %s, address = %s", errInvalidSCAddress, sc.name, sc.address)
+		}
+	}
+
+	return nil
+}
+
+func checkInterval(name string, value int, minValue int, maxValue int) error {
+	if value < minValue || value > maxValue {
+		return fmt.Errorf("%w, %s = %d ms, should be between %d and %d ms", errInvalidInterval, name, value, minValue, maxValue)
+	}
+
+	return nil
+}
+
+func checkFileExists(filePath string) error {
+	_, err := os.Stat(filePath)
+	if err != nil {
+		return fmt.Errorf("%w, file = %s: %v", errFileNotFound, filePath, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,296 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/cert"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txSender"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/signer"
+)
+
+const scAddress = "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+
+func createFile(t *testing.T, name string) string {
+	filePath := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(filePath, []byte("content"), 0644)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+func createServerConfig(t *testing.T) *ServerConfig {
+	return &ServerConfig{
+		GRPCPort:     "8085",
+		DrainTimeout: 30000,
+		TxSenderConfig: txSender.TxSenderConfig{
+			HeaderVerifierSCAddress:   scAddress,
+			EsdtSafeSCAddress:         scAddress,
+			ChangeValidatorsSCAddress: scAddress,
+			ChainConfigSCAddress:      scAddress,
+			Proxy:                     "https://testnet-gateway.multiversx.com",
+			IntervalToSend:            1,
+			Hasher:                    "sha256",
+			JournalDir:                "journal",
+			StatusPollInterval:        6000,
+			GasEstimationMultiplier:   1.2,
+			MaxRetryAttempts:          5,
+			RetryBackoff:              500,
+			GasPriceBumpPercentage:    20,
+			MaxGasPrice:               5000000000,
+			StuckTxTimeout:            60000,
+			MaxBatchSize:              100,
+			JournalRetention:          86400000,
+			JournalHistorySize:        100000,
+		},
+		WalletsConfig: []txSender.WalletConfig{
+			{
+				Path: createFile(t, "wallet.pem"),
+			},
+		},
+		CertificateConfig: cert.FileCfg{
+			CertFile: createFile(t, "certificate.crt"),
+			PkFile:   createFile(t, "private_key.pem"),
+		},
+		HealthConfig: HealthConfig{
+			MinWalletBalance: "100000000000000000",
+			CheckInterval:    10000,
+			Port:             "8086",
+		},
+	}
+}
+
+func TestCheckServerConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid grpc port", func(t *testing.T) {
+		cfg := createServerConfig(t)
+
+		for _, port := range []string{"", "port", "0", "65536"} {
+			cfg.GRPCPort = port
+			require.ErrorIs(t, CheckServerConfig(cfg), errInvalidGRPCPort)
+		}
+	})
+	t.Run("missing certificate file", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.CertificateConfig.PkFile = filepath.Join(t.TempDir(), "missing.pem")
+
+		require.ErrorIs(t, CheckServerConfig(cfg), errFileNotFound)
+	})
+	t.Run("ca file", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.CertificateConfig.CAFile = createFile(t, "ca.crt")
+		require.Nil(t, CheckServerConfig(cfg))
+
+		cfg.CertificateConfig.CAFile = filepath.Join(t.TempDir(), "missing.crt")
+		require.ErrorIs(t, CheckServerConfig(cfg), errFileNotFound)
+	})
+	t.Run("crl and allowlist files", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.CertificateConfig.CRLFile = createFile(t, "crl.pem")
+		require.Equal(t, errCRLWithoutCAFile, CheckServerConfig(cfg))
+
+		cfg.CertificateConfig.CAFile = createFile(t, "ca.crt")
+		cfg.CertificateConfig.AllowlistFile = createFile(t, "allowlist.txt")
+		require.Nil(t, CheckServerConfig(cfg))
+
+		cfg.CertificateConfig.AllowlistFile = filepath.Join(t.TempDir(), "missing.txt")
+		require.ErrorIs(t, CheckServerConfig(cfg), errFileNotFound)
+	})
+	t.Run("validators file", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.ValidatorsFile = createFile(t, "validators.toml")
+		require.Nil(t, CheckServerConfig(cfg))
+
+		cfg.ValidatorsFile = filepath.Join(t.TempDir(), "missing.toml")
+		require.ErrorIs(t, CheckServerConfig(cfg), errFileNotFound)
+	})
+	t.Run("no wallets", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.WalletsConfig = nil
+
+		require.Equal(t, errNoWallets, CheckServerConfig(cfg))
+	})
+	t.Run("missing wallet file", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.WalletsConfig[0].Path = filepath.Join(t.TempDir(), "missing.pem")
+
+		require.ErrorIs(t, CheckServerConfig(cfg), errFileNotFound)
+	})
+	t.Run("remote signers", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.WalletsConfig = nil
+		cfg.RemoteSignersConfig = []signer.RemoteSignerConfig{
+			{Address: signer.UnixSocketPrefix + "/var/run/signer.sock"},
+			{Address: "localhost:8090", CertificateCfg: cfg.CertificateConfig},
+		}
+		require.Nil(t, CheckServerConfig(cfg))
+
+		cfg.RemoteSignersConfig[1].CertificateCfg = cert.FileCfg{}
+		require.ErrorIs(t, CheckServerConfig(cfg), errFileNotFound)
+
+		cfg.RemoteSignersConfig[1].Address = ""
+		require.Equal(t, errNoRemoteSignerAddress, CheckServerConfig(cfg))
+	})
+	t.Run("invalid proxy", func(t *testing.T) {
+		cfg := createServerConfig(t)
+
+		for _, proxy := range []string{"", "testnet-gateway.multiversx.com", "ftp://testnet-gateway.multiversx.com"} {
+			cfg.TxSenderConfig.Proxy = proxy
+			require.ErrorIs(t, CheckServerConfig(cfg), errInvalidProxyURL)
+		}
+	})
+	t.Run("invalid sc address", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.ChainConfigSCAddress = ""
+
+		err := CheckServerConfig(cfg)
+		require.ErrorIs(t, err, errInvalidSCAddress)
+		require.ErrorContains(t, err, "chain config")
+	})
+	t.Run("routing table file", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.ChainConfigSCAddress = ""
+		cfg.TxSenderConfig.RoutingTableFile = createFile(t, "routing.toml")
+		require.Nil(t, CheckServerConfig(cfg))
+
+		cfg.TxSenderConfig.RoutingTableFile = filepath.Join(t.TempDir(), "missing.toml")
+		require.ErrorIs(t, CheckServerConfig(cfg), errFileNotFound)
+	})
+	t.Run("invalid hasher", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.Hasher = "md5"
+
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidHasher)
+	})
+	t.Run("no journal dir", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.JournalDir = ""
+
+		require.Equal(t, errNoJournalDir, CheckServerConfig(cfg))
+	})
+	t.Run("invalid intervals", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.IntervalToSend = 0
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidInterval)
+
+		cfg = createServerConfig(t)
+		cfg.TxSenderConfig.StatusPollInterval = minStatusPollInterval - 1
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidInterval)
+
+		cfg = createServerConfig(t)
+		cfg.TxSenderConfig.RetryBackoff = maxRetryBackoff + 1
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidInterval)
+
+		cfg = createServerConfig(t)
+		cfg.TxSenderConfig.StuckTxTimeout = -1
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidInterval)
+
+		cfg = createServerConfig(t)
+		cfg.DrainTimeout = 0
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidInterval)
+	})
+	t.Run("invalid gas estimation multiplier", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.GasEstimationMultiplier = 0.9
+
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidGasEstimationMultiplier)
+	})
+	t.Run("duplicated type priority", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.TypePriorities = []txSender.TypePriorityConfig{
+			{Type: 1, Priority: 2},
+			{Type: 1, Priority: 1},
+		}
+
+		require.ErrorIs(t, CheckServerConfig(cfg), errDuplicatedTypePriority)
+	})
+	t.Run("invalid max retry attempts", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.MaxRetryAttempts = 0
+
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidMaxRetryAttempts)
+	})
+	t.Run("invalid max batch size", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.MaxBatchSize = 0
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidMaxBatchSize)
+
+		cfg.TxSenderConfig.MaxBatchSize = maxTxsBatchSize + 1
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidMaxBatchSize)
+	})
+	t.Run("journal pruning", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.JournalRetention = maxJournalRetention + 1
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidInterval)
+
+		cfg.TxSenderConfig.JournalRetention = 60000
+		cfg.TxSenderConfig.JournalHistorySize = 0
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidJournalHistorySize)
+
+		cfg.TxSenderConfig.JournalRetention = 0
+		require.Nil(t, CheckServerConfig(cfg))
+	})
+	t.Run("stuck txs replacement", func(t *testing.T) {
+		cfg := createServerConfig(t)
+		cfg.TxSenderConfig.GasPriceBumpPercentage = 0
+		require.Equal(t, errInvalidGasPriceBump, CheckServerConfig(cfg))
+
+		cfg.TxSenderConfig.GasPriceBumpPercentage = 20
+		cfg.TxSenderConfig.MaxGasPrice = 0
+		require.Equal(t, errInvalidMaxGasPrice, CheckServerConfig(cfg))
+
+		cfg.TxSenderConfig.StuckTxTimeout = 0
+		require.Nil(t, CheckServerConfig(cfg))
+	})
+	t.Run("invalid health config", func(t *testing.T) {
+		cfg := createServerConfig(t)
+
+		for _, balance := range []string{"", "-1", "0.1"} {
+			cfg.HealthConfig.MinWalletBalance = balance
+			require.ErrorIs(t, CheckServerConfig(cfg), errInvalidMinWalletBalance)
+		}
+
+		cfg = createServerConfig(t)
+		cfg.HealthConfig.CheckInterval = minHealthInterval - 1
+		require.ErrorIs(t, CheckServerConfig(cfg), errInvalidInterval)
+
+		cfg = createServerConfig(t)
+		for _, port := range []string{"port", "0", "65536"} {
+			cfg.HealthConfig.Port = port
+			require.ErrorIs(t, CheckServerConfig(cfg), errInvalidHealthPort)
+		}
+
+		cfg.HealthConfig.Port = cfg.GRPCPort
+		require.ErrorIs(t, CheckServerConfig(cfg), errHealthPortInUse)
+
+		cfg.HealthConfig.Port = ""
+		require.Nil(t, CheckServerConfig(cfg))
+	})
+	t.Run("should work", func(t *testing.T) {
+		require.Nil(t, CheckServerConfig(createServerConfig(t)))
+	})
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := LoadConfig("../server/config.toml")
+	require.Nil(t, err)
+	require.Equal(t, "8085", cfg.GRPCPort)
+	require.Equal(t, 30000, cfg.DrainTimeout)
+	require.Equal(t, []txSender.WalletConfig{{Path: "wallet.pem"}}, cfg.WalletsConfig)
+	require.Empty(t, cfg.RemoteSignersConfig)
+	require.Equal(t, "certificate.crt", cfg.CertificateConfig.CertFile)
+	require.Equal(t, scAddress, cfg.TxSenderConfig.EsdtSafeSCAddress)
+	require.Equal(t, 6000, cfg.TxSenderConfig.StatusPollInterval)
+	require.Equal(t, 1.2, cfg.TxSenderConfig.GasEstimationMultiplier)
+	require.Equal(t, uint64(5000000000), cfg.TxSenderConfig.MaxGasPrice)
+	require.Equal(t, HealthConfig{MinWalletBalance: "100000000000000000", CheckInterval: 10000, Port: "8086"}, cfg.HealthConfig)
+
+	_, err = LoadConfig(filepath.Join(t.TempDir(), "missing.toml"))
+	require.NotNil(t, err)
+}
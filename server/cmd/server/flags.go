@@ -22,4 +22,9 @@ var (
 		Name:  "disable-ansi-color",
 		Usage: "Boolean option for disabling ANSI colors in the logging system.",
 	}
+	configFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `filepath` of the toml config file. Environment variables, also loaded from the .env file, override" +
+			" the values from the config file.",
+	}
 )
@@ -1,18 +1,25 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/cert"
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/server"
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/cmd/config"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/health"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/metrics"
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txSender"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/signer"
 
 	"github.com/joho/godotenv"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -24,6 +31,8 @@ import (
 	"github.com/urfave/cli"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 var log = logger.GetOrCreate("sov-bridge-sender")
@@ -38,6 +47,7 @@ const (
 
 const (
 	envGRPCPort               = "GRPC_PORT"
+	envDrainTimeout           = "DRAIN_TIMEOUT"
 	envWallet                 = "WALLET_PATH"
 	envPassword               = "WALLET_PASSWORD"
 	envHeaderVerifierSCAddr   = "HEADER_VERIFIER_SC_ADDRESS"
@@ -48,7 +58,29 @@ const (
 	envIntervalToSend         = "INTERVAL_TO_SEND"
 	envCertFile               = "CERT_FILE"
 	envCertPkFile             = "CERT_PK_FILE"
+	envCertCAFile             = "CERT_CA_FILE"
+	envCertCRLFile            = "CERT_CRL_FILE"
+	envCertAllowlistFile      = "CERT_ALLOWLIST_FILE"
 	envHasher                 = "HASHER"
+	envJournalDir             = "JOURNAL_DIR"
+	envJournalRetention       = "JOURNAL_RETENTION"
+	envJournalHistorySize     = "JOURNAL_HISTORY_SIZE"
+	envStatusPollInterval     = "STATUS_POLL_INTERVAL"
+	envGasMultiplier          = "GAS_ESTIMATION_MULTIPLIER"
+	envMaxRetryAttempts       = "MAX_RETRY_ATTEMPTS"
+	envRetryBackoff           = "RETRY_BACKOFF"
+	envStuckTxTimeout         = "STUCK_TX_TIMEOUT"
+	envGasPriceBump           = "GAS_PRICE_BUMP_PERCENTAGE"
+	envMaxGasPrice            = "MAX_GAS_PRICE"
+	envRemoteSigners          = "REMOTE_SIGNERS"
+	envRoutingTableFile       = "ROUTING_TABLE_FILE"
+	envMinWalletBalance       = "MIN_WALLET_BALANCE"
+	envHealthCheckInterval    = "HEALTH_CHECK_INTERVAL"
+	envHealthPort             = "HEALTH_PORT"
+	envValidatorsFile         = "VALIDATORS_FILE"
+	envMaxBatchSize           = "MAX_BATCH_SIZE"
+	envDryRun                 = "DRY_RUN"
+	envAsyncMode              = "ASYNC_MODE"
 )
 
 func main() {
@@ -57,6 +89,17 @@ func main() {
 	app.Action = startServer
 	app.Flags = []cli.Flag{
 		logLevel,
+		configFile,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "validate-config",
+			Usage:  "Loads and validates the config, without starting the server",
+			Action: validateConfig,
+			Flags: []cli.Flag{
+				configFile,
+			},
+		},
 	}
 
 	err := app.Run(os.Args)
@@ -67,7 +110,7 @@ func main() {
 }
 
 func startServer(ctx *cli.Context) error {
-	cfg, err := loadConfig()
+	cfg, err := loadConfig(getConfigFile(ctx))
 	if err != nil {
 		return err
 	}
@@ -77,24 +120,62 @@ func startServer(ctx *cli.Context) error {
 		return err
 	}
 
-	tlsConfig, err := cert.LoadTLSServerConfig(cfg.CertificateConfig)
+	certReloader, err := cert.NewCertificateReloader(cfg.CertificateConfig)
 	if err != nil {
 		return err
 	}
 
-	tlsCredentials := credentials.NewTLS(tlsConfig)
+	stopCertReload := certReloader.ReloadOnSignal(syscall.SIGHUP)
+	defer stopCertReload()
+
+	tlsCredentials := credentials.NewTLS(certReloader.TLSServerConfig())
 	grpcServer := grpc.NewServer(
 		grpc.Creds(tlsCredentials),
 	)
-	bridgeServer, err := server.CreateSovereignBridgeServer(cfg)
+	bridgeMetrics := metrics.NewBridgeMetrics()
+	bridgeServer, err := server.CreateSovereignBridgeServer(cfg, bridgeMetrics)
+	if err != nil {
+		return err
+	}
+
+	err = bridgeMetrics.RegisterWalletsProvider(bridgeServer)
 	if err != nil {
 		return err
 	}
 
 	sovereign.RegisterBridgeTxSenderServer(grpcServer, bridgeServer)
+	bridge.RegisterBridgeSenderServer(grpcServer, bridgeServer)
+	bridge.RegisterBridgeTicketsServer(grpcServer, bridgeServer)
+
+	healthServer := grpcHealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	minWalletBalance, err := config.ParseMinWalletBalance(cfg.HealthConfig.MinWalletBalance)
+	if err != nil {
+		return err
+	}
+
+	readinessChecker, err := health.NewReadinessChecker(health.ArgsReadinessChecker{
+		NetworkChecker:   bridgeServer,
+		WalletsProvider:  bridgeServer,
+		StatusSetter:     healthServer,
+		MinWalletBalance: minWalletBalance,
+		CertificateFile:  cfg.CertificateConfig.CertFile,
+		CheckInterval:    time.Duration(cfg.HealthConfig.CheckInterval) * time.Millisecond,
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Info("starting server...")
 
-	ginHandler, err := server.NewGinHandler(&marshal.GogoProtoMarshalizer{})
+	ginHandler, err := server.NewGinHandler(server.ArgsGinHandler{
+		Marshaller:         &marshal.GogoProtoMarshalizer{},
+		OperationsProvider: bridgeServer,
+		WalletsProvider:    bridgeServer,
+		ReadinessProvider:  readinessChecker,
+		MetricsHandler:     bridgeMetrics.HTTPHandler(),
+	})
 	if err != nil {
 		return err
 	}
@@ -104,20 +185,18 @@ func startServer(ctx *cli.Context) error {
 		return err
 	}
 
-	go func() {
-		for {
-			err = http.ListenAndServeTLS(
-				fmt.Sprintf(":%s", cfg.GRPCPort),
-				cfg.CertificateConfig.CertFile,
-				cfg.CertificateConfig.PkFile,
-				serverHandler,
-			)
-			if err != nil {
-				log.Error("sovereign bridge tx sender: ListenAndServeTLS", "error", err)
-				time.Sleep(retrialTimeServe * time.Second)
-			}
-		}
-	}()
+	httpServer := &http.Server{
+		Addr:      fmt.Sprintf(":%s", cfg.GRPCPort),
+		Handler:   serverHandler,
+		TLSConfig: certReloader.TLSServerConfig(),
+	}
+	go serveHTTP(httpServer)
+	bridgeServer.ResumeUnfinished()
+
+	healthHTTPServer, healthGRPCServer, err := createHealthServers(cfg.HealthConfig.Port, healthServer, readinessChecker)
+	if err != nil {
+		return err
+	}
 
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
@@ -125,7 +204,15 @@ func startServer(ctx *cli.Context) error {
 	<-interrupt
 	log.Info("closing app at user's signal")
 
-	grpcServer.Stop()
+	err = readinessChecker.Close()
+	log.LogIfError(err)
+
+	healthServer.Shutdown()
+	shutdownServers(httpServer, grpcServer, bridgeServer, time.Duration(cfg.DrainTimeout)*time.Millisecond)
+	shutdownHealthServers(healthHTTPServer, healthGRPCServer)
+
+	err = bridgeServer.Close()
+	log.LogIfError(err)
 
 	if !check.IfNilReflect(logFile) {
 		err = logFile.Close()
@@ -135,63 +222,385 @@ func startServer(ctx *cli.Context) error {
 	return nil
 }
 
-func loadConfig() (*config.ServerConfig, error) {
+// serveHTTP serves with the tls config of the http server, whose certificate is reloaded without restarting
+func serveHTTP(httpServer *http.Server) {
+	for {
+		err := httpServer.ListenAndServeTLS("", "")
+		if errors.Is(err, http.ErrServerClosed) {
+			log.Debug("sovereign bridge tx sender: http server closed")
+			return
+		}
+
+		log.Error("sovereign bridge tx sender: ListenAndServeTLS", "error", err)
+		time.Sleep(retrialTimeServe * time.Second)
+	}
+}
+
+// createHealthServers serves the health probes on a separate plain listener, if the health port is set, since the grpc
+// port requires client certificates, which orchestrators' probes usually lack
+func createHealthServers(
+	port string,
+	healthServer healthpb.HealthServer,
+	readinessProvider server.ReadinessStatusProvider,
+) (*http.Server, *grpc.Server, error) {
+	if len(port) == 0 {
+		return nil, nil, nil
+	}
+
+	healthGRPCServer := grpc.NewServer()
+	healthpb.RegisterHealthServer(healthGRPCServer, healthServer)
+
+	healthHandler, err := server.NewHealthHandler(readinessProvider, healthGRPCServer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	healthHTTPServer := &http.Server{
+		Addr:    fmt.Sprintf(":%s", port),
+		Handler: healthHandler,
+	}
+	go serveHealthHTTP(healthHTTPServer)
+
+	return healthHTTPServer, healthGRPCServer, nil
+}
+
+// serveHealthHTTP serves the health probes over plain connections
+func serveHealthHTTP(httpServer *http.Server) {
+	for {
+		err := httpServer.ListenAndServe()
+		if errors.Is(err, http.ErrServerClosed) {
+			log.Debug("sovereign bridge tx sender: health http server closed")
+			return
+		}
+
+		log.Error("sovereign bridge tx sender: health ListenAndServe", "error", err)
+		time.Sleep(retrialTimeServe * time.Second)
+	}
+}
+
+// shutdownHealthServers closes the health probes listener, once the bridge server is shut down
+func shutdownHealthServers(httpServer *http.Server, grpcServer *grpc.Server) {
+	if httpServer == nil {
+		return
+	}
+
+	log.LogIfError(httpServer.Close())
+	grpcServer.Stop()
+}
+
+// shutdownServers refuses new bridge operations and waits for the in-flight ones to be sent, then gracefully shuts down
+// the http and grpc servers. If the drain timeout expires, all connections are closed instead.
+func shutdownServers(httpServer *http.Server, grpcServer *grpc.Server, bridgeServer server.Drainer, drainTimeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	err := bridgeServer.Drain(ctx)
+	if err == nil {
+		err = httpServer.Shutdown(ctx)
+	}
+	if err != nil {
+		log.Warn("could not gracefully shut down the server, closing all connections", "error", err)
+		log.LogIfError(httpServer.Close())
+		grpcServer.Stop()
+		return
+	}
+
+	// grpc requests are only served through the http server, which is already shut down, so no rpc is left pending.
+	// Graceful stop would otherwise panic, since draining is not implemented for connections served over http.
+	grpcServer.GracefulStop()
+	log.Info("server gracefully shut down")
+}
+
+func validateConfig(ctx *cli.Context) error {
+	_, err := loadConfig(getConfigFile(ctx))
+	if err != nil {
+		return err
+	}
+
+	log.Info("config is valid")
+	return nil
+}
+
+// getConfigFile returns the config file flag, which can be set both globally and on the validate-config command
+func getConfigFile(ctx *cli.Context) string {
+	if ctx.IsSet(configFile.Name) {
+		return ctx.String(configFile.Name)
+	}
+
+	return ctx.GlobalString(configFile.Name)
+}
+
+// loadConfig loads the config file, if provided, and overrides it with the environment variables, which can also be
+// set in the .env file. The resulting config is validated.
+func loadConfig(configFile string) (*config.ServerConfig, error) {
+	cfg := &config.ServerConfig{}
+	if len(configFile) != 0 {
+		var err error
+		cfg, err = config.LoadConfig(configFile)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("loaded config file", "file", configFile)
+	}
+
+	// the .env file is optional only when a config file is provided
 	err := godotenv.Load(".env")
+	if err != nil && (len(configFile) == 0 || !errors.Is(err, os.ErrNotExist)) {
+		return nil, err
+	}
+
+	err = applyEnvOverrides(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	grpcPort := os.Getenv(envGRPCPort)
-	walletPath := os.Getenv(envWallet)
-	walletPassword := os.Getenv(envPassword)
-	headerVerifierSCAddress := os.Getenv(envHeaderVerifierSCAddr)
-	esdtSafeSCAddress := os.Getenv(envEsdtSafeSCAddr)
-	changeValidatorsSCAddress := os.Getenv(envChangeValidatorsSCAddr)
-	chainConfigSCAddress := os.Getenv(envChainConfigSCAddr)
+	logConfig(cfg)
 
-	proxy := os.Getenv(envMultiversXProxy)
-	intervalToSendStr := os.Getenv(envIntervalToSend)
-	certFile := os.Getenv(envCertFile)
-	certPkFile := os.Getenv(envCertPkFile)
-	hasher := os.Getenv(envHasher)
+	err = config.CheckServerConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
 
-	intervalToSend, err := strconv.Atoi(intervalToSendStr)
+	return cfg, nil
+}
+
+// applyEnvOverrides overrides the config with every environment variable which is set to a non-empty value
+func applyEnvOverrides(cfg *config.ServerConfig) error {
+	txSenderCfg := &cfg.TxSenderConfig
+
+	overrideString(&cfg.GRPCPort, envGRPCPort)
+	overrideString(&cfg.ValidatorsFile, envValidatorsFile)
+	overrideString(&cfg.CertificateConfig.CertFile, envCertFile)
+	overrideString(&cfg.CertificateConfig.PkFile, envCertPkFile)
+	overrideString(&cfg.CertificateConfig.CAFile, envCertCAFile)
+	overrideString(&cfg.CertificateConfig.CRLFile, envCertCRLFile)
+	overrideString(&cfg.CertificateConfig.AllowlistFile, envCertAllowlistFile)
+	overrideString(&txSenderCfg.HeaderVerifierSCAddress, envHeaderVerifierSCAddr)
+	overrideString(&txSenderCfg.EsdtSafeSCAddress, envEsdtSafeSCAddr)
+	overrideString(&txSenderCfg.ChangeValidatorsSCAddress, envChangeValidatorsSCAddr)
+	overrideString(&txSenderCfg.ChainConfigSCAddress, envChainConfigSCAddr)
+	overrideString(&txSenderCfg.RoutingTableFile, envRoutingTableFile)
+	overrideString(&txSenderCfg.Proxy, envMultiversXProxy)
+	overrideString(&txSenderCfg.Hasher, envHasher)
+	overrideString(&txSenderCfg.JournalDir, envJournalDir)
+	overrideString(&cfg.HealthConfig.MinWalletBalance, envMinWalletBalance)
+	overrideString(&cfg.HealthConfig.Port, envHealthPort)
+
+	intOverrides := map[string]*int{
+		envDrainTimeout:        &cfg.DrainTimeout,
+		envIntervalToSend:      &txSenderCfg.IntervalToSend,
+		envStatusPollInterval:  &txSenderCfg.StatusPollInterval,
+		envMaxRetryAttempts:    &txSenderCfg.MaxRetryAttempts,
+		envRetryBackoff:        &txSenderCfg.RetryBackoff,
+		envStuckTxTimeout:      &txSenderCfg.StuckTxTimeout,
+		envMaxBatchSize:        &txSenderCfg.MaxBatchSize,
+		envJournalRetention:    &txSenderCfg.JournalRetention,
+		envJournalHistorySize:  &txSenderCfg.JournalHistorySize,
+		envHealthCheckInterval: &cfg.HealthConfig.CheckInterval,
+	}
+	for envName, dest := range intOverrides {
+		err := overrideInt(dest, envName)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := overrideBool(&txSenderCfg.DryRun, envDryRun)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	err = overrideBool(&cfg.AsyncMode, envAsyncMode)
+	if err != nil {
+		return err
+	}
+	err = overrideUint64(&txSenderCfg.GasPriceBumpPercentage, envGasPriceBump)
+	if err != nil {
+		return err
+	}
+	err = overrideUint64(&txSenderCfg.MaxGasPrice, envMaxGasPrice)
+	if err != nil {
+		return err
+	}
+	err = overrideFloat(&txSenderCfg.GasEstimationMultiplier, envGasMultiplier)
+	if err != nil {
+		return err
 	}
 
-	log.Info("loaded config", "grpc port", grpcPort)
-	log.Info("loaded config", "headerVerifierSCAddress", headerVerifierSCAddress)
-	log.Info("loaded config", "esdtSafeSCAddress", esdtSafeSCAddress)
-	log.Info("loaded config", "changeValidatorsSCAddress", changeValidatorsSCAddress)
-	log.Info("loaded config", "chainConfigSCAddress", chainConfigSCAddress)
-	log.Info("loaded config", "proxy", proxy)
-	log.Info("loaded config", "intervalToSend", intervalToSend)
-	log.Info("loaded config", "hasher", hasher)
+	walletPaths, found := lookupEnv(envWallet)
+	if found {
+		cfg.WalletsConfig, err = loadWalletsConfig(walletPaths, os.Getenv(envPassword))
+		if err != nil {
+			return err
+		}
+	}
 
-	log.Info("loaded config", "certificate file", certFile)
-	log.Info("loaded config", "certificate pk", certPkFile)
+	// remote signers reachable over tcp use the server's certificate, so it should be overridden first
+	remoteSigners, found := lookupEnv(envRemoteSigners)
+	if found {
+		cfg.RemoteSignersConfig = loadRemoteSignersConfig(remoteSigners, cfg.CertificateConfig)
+	}
 
-	return &config.ServerConfig{
-		GRPCPort: grpcPort,
-		WalletConfig: txSender.WalletConfig{
-			Path:     walletPath,
-			Password: walletPassword,
-		},
-		TxSenderConfig: txSender.TxSenderConfig{
-			HeaderVerifierSCAddress:   headerVerifierSCAddress,
-			EsdtSafeSCAddress:         esdtSafeSCAddress,
-			ChangeValidatorsSCAddress: changeValidatorsSCAddress,
-			ChainConfigSCAddress:      chainConfigSCAddress,
-			Proxy:                     proxy,
-			IntervalToSend:            intervalToSend,
-			Hasher:                    hasher,
-		},
-		CertificateConfig: cert.FileCfg{
-			CertFile: certFile,
-			PkFile:   certPkFile,
-		},
-	}, nil
+	return nil
+}
+
+func lookupEnv(envName string) (string, bool) {
+	value := os.Getenv(envName)
+	return value, len(value) != 0
+}
+
+func overrideString(dest *string, envName string) {
+	value, found := lookupEnv(envName)
+	if found {
+		*dest = value
+	}
+}
+
+func overrideInt(dest *int, envName string) error {
+	value, found := lookupEnv(envName)
+	if !found {
+		return nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", envName, err)
+	}
+
+	*dest = parsed
+	return nil
+}
+
+func overrideBool(dest *bool, envName string) error {
+	value, found := lookupEnv(envName)
+	if !found {
+		return nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", envName, err)
+	}
+
+	*dest = parsed
+	return nil
+}
+
+func overrideUint64(dest *uint64, envName string) error {
+	value, found := lookupEnv(envName)
+	if !found {
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", envName, err)
+	}
+
+	*dest = parsed
+	return nil
+}
+
+func overrideFloat(dest *float64, envName string) error {
+	value, found := lookupEnv(envName)
+	if !found {
+		return nil
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", envName, err)
+	}
+
+	*dest = parsed
+	return nil
+}
+
+func logConfig(cfg *config.ServerConfig) {
+	txSenderCfg := cfg.TxSenderConfig
+
+	log.Info("loaded config", "grpc port", cfg.GRPCPort)
+	log.Info("loaded config", "drainTimeout", cfg.DrainTimeout)
+	log.Info("loaded config", "validatorsFile", cfg.ValidatorsFile)
+	log.Info("loaded config", "asyncMode", cfg.AsyncMode)
+	log.Info("loaded config", "headerVerifierSCAddress", txSenderCfg.HeaderVerifierSCAddress)
+	log.Info("loaded config", "esdtSafeSCAddress", txSenderCfg.EsdtSafeSCAddress)
+	log.Info("loaded config", "changeValidatorsSCAddress", txSenderCfg.ChangeValidatorsSCAddress)
+	log.Info("loaded config", "chainConfigSCAddress", txSenderCfg.ChainConfigSCAddress)
+	log.Info("loaded config", "routingTableFile", txSenderCfg.RoutingTableFile)
+	log.Info("loaded config", "proxy", txSenderCfg.Proxy)
+	log.Info("loaded config", "intervalToSend", txSenderCfg.IntervalToSend)
+	log.Info("loaded config", "statusPollInterval", txSenderCfg.StatusPollInterval)
+	log.Info("loaded config", "gasEstimationMultiplier", txSenderCfg.GasEstimationMultiplier)
+	log.Info("loaded config", "maxRetryAttempts", txSenderCfg.MaxRetryAttempts)
+	log.Info("loaded config", "retryBackoff", txSenderCfg.RetryBackoff)
+	log.Info("loaded config", "stuckTxTimeout", txSenderCfg.StuckTxTimeout)
+	log.Info("loaded config", "gasPriceBumpPercentage", txSenderCfg.GasPriceBumpPercentage)
+	log.Info("loaded config", "maxGasPrice", txSenderCfg.MaxGasPrice)
+	log.Info("loaded config", "maxBatchSize", txSenderCfg.MaxBatchSize)
+	log.Info("loaded config", "hasher", txSenderCfg.Hasher)
+	log.Info("loaded config", "journalDir", txSenderCfg.JournalDir)
+	log.Info("loaded config", "journalRetention", txSenderCfg.JournalRetention)
+	log.Info("loaded config", "journalHistorySize", txSenderCfg.JournalHistorySize)
+	log.Info("loaded config", "dryRun", txSenderCfg.DryRun)
+	log.Info("loaded config", "typePriorities", txSenderCfg.TypePriorities)
+	log.Info("loaded config", "wallets", len(cfg.WalletsConfig))
+	log.Info("loaded config", "remote signers", len(cfg.RemoteSignersConfig))
+
+	log.Info("loaded config", "certificate file", cfg.CertificateConfig.CertFile)
+	log.Info("loaded config", "certificate pk", cfg.CertificateConfig.PkFile)
+	log.Info("loaded config", "certificate ca", cfg.CertificateConfig.CAFile)
+	log.Info("loaded config", "certificate crl", cfg.CertificateConfig.CRLFile)
+	log.Info("loaded config", "certificate allowlist", cfg.CertificateConfig.AllowlistFile)
+	log.Info("loaded config", "minWalletBalance", cfg.HealthConfig.MinWalletBalance)
+	log.Info("loaded config", "healthCheckInterval", cfg.HealthConfig.CheckInterval)
+	log.Info("loaded config", "healthPort", cfg.HealthConfig.Port)
+}
+
+// loadWalletsConfig splits the comma separated wallet paths and passwords. Passwords are matched by index with the
+// wallet paths, while a single password is used for all wallets.
+func loadWalletsConfig(pathsStr string, passwordsStr string) ([]txSender.WalletConfig, error) {
+	paths := strings.Split(pathsStr, ",")
+	passwords := strings.Split(passwordsStr, ",")
+	if len(passwords) != 1 && len(passwords) != len(paths) {
+		return nil, fmt.Errorf("invalid number of wallet passwords, wallets: %d, passwords: %d", len(paths), len(passwords))
+	}
+
+	walletsConfig := make([]txSender.WalletConfig, 0, len(paths))
+	for idx, path := range paths {
+		password := passwords[0]
+		if len(passwords) > 1 {
+			password = passwords[idx]
+		}
+
+		walletsConfig = append(walletsConfig, txSender.WalletConfig{
+			Path:     strings.TrimSpace(path),
+			Password: password,
+		})
+	}
+
+	return walletsConfig, nil
+}
+
+// loadRemoteSignersConfig splits the comma separated remote signer addresses. Signers reachable over tcp use the
+// server's certificate, while the clients allowlist does not apply to them.
+func loadRemoteSignersConfig(addressesStr string, certificateConfig cert.FileCfg) []signer.RemoteSignerConfig {
+	certificateConfig.AllowlistFile = ""
+
+	remoteSignersConfig := make([]signer.RemoteSignerConfig, 0)
+	for _, address := range strings.Split(addressesStr, ",") {
+		address = strings.TrimSpace(address)
+		if len(address) == 0 {
+			continue
+		}
+
+		remoteSignersConfig = append(remoteSignersConfig, signer.RemoteSignerConfig{
+			Address:        address,
+			CertificateCfg: certificateConfig,
+		})
+	}
+
+	return remoteSignersConfig
 }
 
 func initializeLogger(ctx *cli.Context) (closing.Closer, error) {
@@ -4,8 +4,34 @@ import "errors"
 
 var errNilTxSender = errors.New("nil tx sender provided")
 
+var errNilMetricsHandler = errors.New("nil metrics handler provided")
+
+var errNilSignatureVerifier = errors.New("nil signature verifier provided")
+
+var errNilMetricsHTTPHandler = errors.New("nil metrics http handler provided")
+
 var errNilMarshaller = errors.New("nil marshaller provided")
 
 var errNilGinHandler = errors.New("nil gin handler provided")
 
 var errNilGRPCHandler = errors.New("nil grpc handler provided")
+
+var errNilOperationsProvider = errors.New("nil operations provider provided")
+
+var errNilWalletsProvider = errors.New("nil wallets status provider provided")
+
+var errNilReadinessProvider = errors.New("nil readiness status provider provided")
+
+var errInvalidOperationHash = errors.New("invalid hex encoded operation hash")
+
+var errOperationNotFound = errors.New("operation not found")
+
+var errServerDraining = errors.New("server is shutting down, not accepting bridge operations")
+
+var errDrainTimeout = errors.New("timeout while draining bridge sends")
+
+var errInvalidBridgeData = errors.New("invalid bridge operation")
+
+var errTicketNotFound = errors.New("ticket not found")
+
+var errAsyncModeDisabled = errors.New("asynchronous mode is disabled, no tickets are kept")
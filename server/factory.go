@@ -1,22 +1,139 @@
 package server
 
 import (
-	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"fmt"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclMultiSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/multisig"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/cmd/config"
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txSender"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/verifier"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/verifier/disabled"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/signer"
 )
 
-// CreateSovereignBridgeServer creates a new bridge txs sender grpc server
-func CreateSovereignBridgeServer(cfg *config.ServerConfig) (sovereign.BridgeTxSenderServer, error) {
-	wallet, err := txSender.LoadWallet(cfg.WalletConfig)
+// validatorsStateFile is the file, in the journal dir, holding the validator set of each epoch rotated on-chain
+const validatorsStateFile = "validators.toml"
+
+// CreateSovereignBridgeServer creates a new bridge txs sender grpc server. Data formatters can be registered for bridge
+// outgoing data types which are not formatted by default.
+func CreateSovereignBridgeServer(
+	cfg *config.ServerConfig,
+	metricsHandler MetricsHandler,
+	registrations ...txSender.DataFormatterRegistration,
+) (*server, error) {
+	signers, err := createTxSigners(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	txSnd, err := txSender.CreateTxSender(signers, cfg.TxSenderConfig, registrations...)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureVerifier, err := createSignatureVerifier(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.AsyncMode {
+		return NewAsyncSovereignBridgeTxServer(txSnd, metricsHandler, signatureVerifier)
+	}
+
+	return NewSovereignBridgeTxServer(txSnd, metricsHandler, signatureVerifier)
+}
+
+// createSignatureVerifier creates the verifier of aggregated bls signatures, if a validators file is configured.
+// Otherwise, signatures are only verified by the contracts.
+func createSignatureVerifier(cfg *config.ServerConfig) (SignatureVerifier, error) {
+	if len(cfg.ValidatorsFile) == 0 {
+		log.Warn("no validators file provided, bridge operations signatures are not verified before sending txs")
+		return disabled.NewDisabledSignatureVerifier(), nil
+	}
+
+	validatorsCfg, err := verifier.LoadValidatorsConfig(cfg.ValidatorsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys, err := validatorsCfg.DecodePubKeys()
 	if err != nil {
 		return nil, err
 	}
 
-	txSnd, err := txSender.CreateTxSender(wallet, cfg.TxSenderConfig)
+	multiSigVerifier, err := multisig.NewBLSMultisig(&mclMultiSig.BlsMultiSignerKOSK{}, signing.NewKeyGenerator(mcl.NewSuiteBLS12()))
 	if err != nil {
 		return nil, err
 	}
 
-	return NewSovereignBridgeTxServer(txSnd)
+	hasher, err := factory.NewHasher(cfg.TxSenderConfig.Hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return verifier.NewSignatureVerifier(verifier.ArgsSignatureVerifier{
+		MultiSigVerifier: multiSigVerifier,
+		Hasher:           hasher,
+		Epoch:            validatorsCfg.Epoch,
+		PubKeys:          pubKeys,
+		StateFile:        filepath.Join(cfg.TxSenderConfig.JournalDir, validatorsStateFile),
+	})
+}
+
+// createTxSigners connects to the remote signers, if any is configured. Otherwise, wallets are loaded in-process.
+func createTxSigners(cfg *config.ServerConfig) ([]txSender.TxSigner, error) {
+	if len(cfg.RemoteSignersConfig) != 0 {
+		return createRemoteSigners(cfg.RemoteSignersConfig)
+	}
+
+	return createLocalSigners(cfg.WalletsConfig)
+}
+
+func createRemoteSigners(cfgs []signer.RemoteSignerConfig) ([]txSender.TxSigner, error) {
+	signers := make([]txSender.TxSigner, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		remoteSigner, err := signer.CreateRemoteSigner(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%w, signer = %s", err, cfg.Address)
+		}
+
+		signers = append(signers, remoteSigner)
+	}
+
+	return signers, nil
+}
+
+func createLocalSigners(cfgs []txSender.WalletConfig) ([]txSender.TxSigner, error) {
+	wallets, err := txSender.LoadWallets(cfgs)
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([]txSender.TxSigner, 0, len(wallets))
+	for _, wallet := range wallets {
+		localSigner, err := signer.NewLocalSigner(signer.ArgsLocalSigner{
+			Wallet:       wallet,
+			TxInteractor: txBuilder,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		signers = append(signers, localSigner)
+	}
+
+	return signers, nil
 }
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"encoding/hex"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -10,14 +11,39 @@ import (
 	"github.com/multiversx/mx-chain-go/api/logs"
 )
 
+// ArgsGinHandler holds args to create a new gin handler
+type ArgsGinHandler struct {
+	Marshaller         marshal.Marshalizer
+	OperationsProvider OperationResultsProvider
+	WalletsProvider    WalletsStatusProvider
+	ReadinessProvider  ReadinessStatusProvider
+	MetricsHandler     http.Handler
+}
+
 // NewGinHandler will create a gin handler
-func NewGinHandler(marshaller marshal.Marshalizer) (*gin.Engine, error) {
-	if check.IfNilReflect(marshaller) {
+func NewGinHandler(args ArgsGinHandler) (*gin.Engine, error) {
+	if check.IfNilReflect(args.Marshaller) {
 		return nil, errNilMarshaller
 	}
+	if check.IfNil(args.OperationsProvider) {
+		return nil, errNilOperationsProvider
+	}
+	if check.IfNil(args.WalletsProvider) {
+		return nil, errNilWalletsProvider
+	}
+	if check.IfNil(args.ReadinessProvider) {
+		return nil, errNilReadinessProvider
+	}
+	if args.MetricsHandler == nil {
+		return nil, errNilMetricsHTTPHandler
+	}
 
 	router := gin.Default()
-	registerLoggerWsRoute(router, marshaller)
+	registerLoggerWsRoute(router, args.Marshaller)
+	registerOperationsRoute(router, args.OperationsProvider)
+	registerWalletsRoute(router, args.WalletsProvider)
+	registerHealthRoutes(router, args.ReadinessProvider)
+	router.GET("/metrics", gin.WrapH(args.MetricsHandler))
 
 	return router, nil
 }
@@ -45,3 +71,45 @@ func registerLoggerWsRoute(ws *gin.Engine, marshaller marshal.Marshalizer) {
 		ls.StartSendingBlocking()
 	})
 }
+
+func registerOperationsRoute(router *gin.Engine, operationsProvider OperationResultsProvider) {
+	router.GET("/operations/:hash", func(c *gin.Context) {
+		hash, err := hex.DecodeString(c.Param("hash"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidOperationHash.Error()})
+			return
+		}
+
+		opResult, found := operationsProvider.GetOperationResult(hash)
+		if !found {
+			c.JSON(http.StatusNotFound, gin.H{"error": errOperationNotFound.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, opResult)
+	})
+}
+
+func registerWalletsRoute(router *gin.Engine, walletsProvider WalletsStatusProvider) {
+	router.GET("/wallets", func(c *gin.Context) {
+		c.JSON(http.StatusOK, walletsProvider.GetWalletsStatus())
+	})
+}
+
+// registerHealthRoutes registers the liveness probe, which succeeds as long as the server responds, and the readiness
+// probe, which reports the latest readiness check outcome
+func registerHealthRoutes(router *gin.Engine, readinessProvider ReadinessStatusProvider) {
+	router.GET("/health/live", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "alive"})
+	})
+
+	router.GET("/health/ready", func(c *gin.Context) {
+		status := readinessProvider.GetReadinessStatus()
+		if !status.Ready {
+			c.JSON(http.StatusServiceUnavailable, status)
+			return
+		}
+
+		c.JSON(http.StatusOK, status)
+	})
+}
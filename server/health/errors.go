@@ -0,0 +1,25 @@
+package health
+
+import "errors"
+
+var errNilNetworkChecker = errors.New("nil network checker provided")
+
+var errNilWalletsProvider = errors.New("nil wallets status provider provided")
+
+var errNilStatusSetter = errors.New("nil serving status setter provided")
+
+var errInvalidMinWalletBalance = errors.New("invalid min wallet balance, should not be negative")
+
+var errInvalidCheckInterval = errors.New("invalid readiness check interval")
+
+var errNoWallets = errors.New("no wallets available")
+
+var errUnknownWalletBalance = errors.New("wallet balance not fetched yet")
+
+var errLowWalletBalance = errors.New("wallet balance below threshold")
+
+var errNoCertificate = errors.New("no certificate found in file")
+
+var errCertificateNotValid = errors.New("certificate not valid at current time")
+
+var errNotChecked = errors.New("readiness not checked yet")
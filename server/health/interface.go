@@ -0,0 +1,26 @@
+package health
+
+import (
+	"context"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+)
+
+// NetworkChecker defines a component which checks that the network can be reached
+type NetworkChecker interface {
+	CheckNetwork(ctx context.Context) error
+	IsInterfaceNil() bool
+}
+
+// WalletsStatusProvider defines a provider of the status of wallets used to send bridge txs
+type WalletsStatusProvider interface {
+	GetWalletsStatus() []*results.WalletStatus
+	IsInterfaceNil() bool
+}
+
+// ServingStatusSetter defines a component which reports the serving status, such as the grpc health server
+type ServingStatusSetter interface {
+	SetServingStatus(service string, servingStatus grpc_health_v1.HealthCheckResponse_ServingStatus)
+}
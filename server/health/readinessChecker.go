@@ -0,0 +1,234 @@
+package health
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+)
+
+var log = logger.GetOrCreate("health")
+
+const minCheckInterval = time.Second
+
+// names of the readiness checks, as reported in the readiness status
+const (
+	networkCheck     = "network"
+	walletsCheck     = "wallets"
+	certificateCheck = "certificate"
+)
+
+// ArgsReadinessChecker holds args to create a new readiness checker
+type ArgsReadinessChecker struct {
+	NetworkChecker   NetworkChecker
+	WalletsProvider  WalletsStatusProvider
+	StatusSetter     ServingStatusSetter
+	MinWalletBalance *big.Int
+	CertificateFile  string
+	CheckInterval    time.Duration
+}
+
+type readinessChecker struct {
+	networkChecker   NetworkChecker
+	walletsProvider  WalletsStatusProvider
+	statusSetter     ServingStatusSetter
+	minWalletBalance *big.Int
+	certificateFile  string
+	checkInterval    time.Duration
+
+	mut    sync.RWMutex
+	status *results.ReadinessStatus
+	cancel context.CancelFunc
+}
+
+// NewReadinessChecker creates a component which periodically checks that the proxy is reachable, the network config
+// is loaded, the wallets hold enough balance and the certificate is valid. The outcome is reported to the serving
+// status setter and is available for the readiness probes.
+func NewReadinessChecker(args ArgsReadinessChecker) (*readinessChecker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &readinessChecker{
+		networkChecker:   args.NetworkChecker,
+		walletsProvider:  args.WalletsProvider,
+		statusSetter:     args.StatusSetter,
+		minWalletBalance: args.MinWalletBalance,
+		certificateFile:  args.CertificateFile,
+		checkInterval:    args.CheckInterval,
+		status: &results.ReadinessStatus{
+			Ready:  false,
+			Checks: map[string]string{networkCheck: errNotChecked.Error()},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rc.cancel = cancel
+
+	rc.checkReadiness(ctx)
+	go rc.checkReadinessLoop(ctx)
+
+	return rc, nil
+}
+
+func checkArgs(args ArgsReadinessChecker) error {
+	if check.IfNil(args.NetworkChecker) {
+		return errNilNetworkChecker
+	}
+	if check.IfNil(args.WalletsProvider) {
+		return errNilWalletsProvider
+	}
+	if args.StatusSetter == nil {
+		return errNilStatusSetter
+	}
+	if args.MinWalletBalance == nil || args.MinWalletBalance.Sign() < 0 {
+		return errInvalidMinWalletBalance
+	}
+	if args.CheckInterval < minCheckInterval {
+		return fmt.Errorf("%w, interval = %v, min interval = %v", errInvalidCheckInterval, args.CheckInterval, minCheckInterval)
+	}
+
+	return nil
+}
+
+func (rc *readinessChecker) checkReadinessLoop(ctx context.Context) {
+	ticker := time.NewTicker(rc.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing readiness check loop")
+			return
+		case <-ticker.C:
+			rc.checkReadiness(ctx)
+		}
+	}
+}
+
+func (rc *readinessChecker) checkReadiness(ctx context.Context) {
+	checkCtx, cancel := context.WithTimeout(ctx, rc.checkInterval)
+	defer cancel()
+
+	checks := []struct {
+		name string
+		err  error
+	}{
+		{name: networkCheck, err: rc.networkChecker.CheckNetwork(checkCtx)},
+		{name: walletsCheck, err: rc.checkWallets()},
+		{name: certificateCheck, err: checkCertificate(rc.certificateFile, time.Now())},
+	}
+
+	status := &results.ReadinessStatus{
+		Ready:  true,
+		Checks: make(map[string]string, len(checks)),
+	}
+	for _, c := range checks {
+		if c.err != nil {
+			log.Warn("readiness check failed", "check", c.name, "error", c.err)
+			status.Ready = false
+			status.Checks[c.name] = c.err.Error()
+			continue
+		}
+
+		status.Checks[c.name] = "ok"
+	}
+
+	rc.setStatus(status)
+}
+
+func (rc *readinessChecker) checkWallets() error {
+	walletsStatus := rc.walletsProvider.GetWalletsStatus()
+	if len(walletsStatus) == 0 {
+		return errNoWallets
+	}
+
+	for _, walletStatus := range walletsStatus {
+		balance, ok := big.NewInt(0).SetString(walletStatus.Balance, 10)
+		if !ok {
+			return fmt.Errorf("%w, address = %s", errUnknownWalletBalance, walletStatus.Address)
+		}
+		if balance.Cmp(rc.minWalletBalance) < 0 {
+			return fmt.Errorf("%w, address = %s, balance = %s, min balance = %s",
+				errLowWalletBalance, walletStatus.Address, walletStatus.Balance, rc.minWalletBalance.String())
+		}
+	}
+
+	return nil
+}
+
+// checkCertificate checks that the first certificate found in the pem file is valid at the provided time
+func checkCertificate(certificateFile string, now time.Time) error {
+	buff, err := os.ReadFile(certificateFile)
+	if err != nil {
+		return err
+	}
+
+	block, _ := pem.Decode(buff)
+	if block == nil {
+		return fmt.Errorf("%w, file = %s", errNoCertificate, certificateFile)
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+	if now.Before(certificate.NotBefore) || now.After(certificate.NotAfter) {
+		return fmt.Errorf("%w, not before = %v, not after = %v",
+			errCertificateNotValid, certificate.NotBefore, certificate.NotAfter)
+	}
+
+	return nil
+}
+
+func (rc *readinessChecker) setStatus(status *results.ReadinessStatus) {
+	rc.mut.Lock()
+	wasReady := rc.status.Ready
+	rc.status = status
+	rc.mut.Unlock()
+
+	if wasReady != status.Ready {
+		log.Info("readiness changed", "ready", status.Ready)
+	}
+
+	rc.statusSetter.SetServingStatus("", toServingStatus(status.Ready))
+}
+
+func toServingStatus(ready bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if ready {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
+
+// GetReadinessStatus returns the outcome of the latest readiness check
+func (rc *readinessChecker) GetReadinessStatus() *results.ReadinessStatus {
+	rc.mut.RLock()
+	defer rc.mut.RUnlock()
+
+	return rc.status
+}
+
+// Close stops the readiness checks and reports that the server is no longer serving
+func (rc *readinessChecker) Close() error {
+	rc.cancel()
+	rc.statusSetter.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (rc *readinessChecker) IsInterfaceNil() bool {
+	return rc == nil
+}
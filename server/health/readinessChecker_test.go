@@ -0,0 +1,234 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/cert"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+func createCertificateFile(t *testing.T) string {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "certificate.crt")
+	err := cert.GenerateCertFiles(cert.CertificateCfg{
+		CertCfg: cert.CertCfg{
+			Organization: "MultiversX",
+			DNSName:      "localhost",
+			IPAddress:    "127.0.0.1",
+			Availability: 1,
+		},
+		CertFileCfg: cert.FileCfg{
+			CertFile: certFile,
+			PkFile:   filepath.Join(dir, "private_key.pem"),
+		},
+	})
+	require.Nil(t, err)
+
+	return certFile
+}
+
+func createArgs(t *testing.T) ArgsReadinessChecker {
+	txSender := &testscommon.TxSenderMock{
+		GetWalletsStatusCalled: func() []*results.WalletStatus {
+			return []*results.WalletStatus{
+				{Address: "erd1a", Balance: "1000"},
+				{Address: "erd1b", Balance: "100"},
+			}
+		},
+	}
+
+	return ArgsReadinessChecker{
+		NetworkChecker:   txSender,
+		WalletsProvider:  txSender,
+		StatusSetter:     &testscommon.ServingStatusSetterMock{},
+		MinWalletBalance: big.NewInt(100),
+		CertificateFile:  createCertificateFile(t),
+		CheckInterval:    time.Hour,
+	}
+}
+
+func TestNewReadinessChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network checker", func(t *testing.T) {
+		args := createArgs(t)
+		args.NetworkChecker = nil
+
+		rc, err := NewReadinessChecker(args)
+		require.Nil(t, rc)
+		require.Equal(t, errNilNetworkChecker, err)
+	})
+	t.Run("nil wallets provider", func(t *testing.T) {
+		args := createArgs(t)
+		args.WalletsProvider = nil
+
+		rc, err := NewReadinessChecker(args)
+		require.Nil(t, rc)
+		require.Equal(t, errNilWalletsProvider, err)
+	})
+	t.Run("nil status setter", func(t *testing.T) {
+		args := createArgs(t)
+		args.StatusSetter = nil
+
+		rc, err := NewReadinessChecker(args)
+		require.Nil(t, rc)
+		require.Equal(t, errNilStatusSetter, err)
+	})
+	t.Run("invalid min wallet balance", func(t *testing.T) {
+		args := createArgs(t)
+		args.MinWalletBalance = big.NewInt(-1)
+
+		rc, err := NewReadinessChecker(args)
+		require.Nil(t, rc)
+		require.Equal(t, errInvalidMinWalletBalance, err)
+	})
+	t.Run("invalid check interval", func(t *testing.T) {
+		args := createArgs(t)
+		args.CheckInterval = time.Millisecond
+
+		rc, err := NewReadinessChecker(args)
+		require.Nil(t, rc)
+		require.ErrorIs(t, err, errInvalidCheckInterval)
+	})
+	t.Run("should work", func(t *testing.T) {
+		rc, err := NewReadinessChecker(createArgs(t))
+		require.Nil(t, err)
+		require.False(t, rc.IsInterfaceNil())
+		require.Nil(t, rc.Close())
+	})
+}
+
+func TestReadinessChecker_GetReadinessStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all checks passed", func(t *testing.T) {
+		args := createArgs(t)
+
+		servingStatuses := make([]grpc_health_v1.HealthCheckResponse_ServingStatus, 0)
+		mut := sync.Mutex{}
+		args.StatusSetter = &testscommon.ServingStatusSetterMock{
+			SetServingStatusCalled: func(service string, servingStatus grpc_health_v1.HealthCheckResponse_ServingStatus) {
+				require.Empty(t, service)
+
+				mut.Lock()
+				servingStatuses = append(servingStatuses, servingStatus)
+				mut.Unlock()
+			},
+		}
+
+		rc, _ := NewReadinessChecker(args)
+		require.Equal(t, &results.ReadinessStatus{
+			Ready: true,
+			Checks: map[string]string{
+				networkCheck:     "ok",
+				walletsCheck:     "ok",
+				certificateCheck: "ok",
+			},
+		}, rc.GetReadinessStatus())
+
+		require.Nil(t, rc.Close())
+
+		mut.Lock()
+		require.Equal(t, []grpc_health_v1.HealthCheckResponse_ServingStatus{
+			grpc_health_v1.HealthCheckResponse_SERVING,
+			grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		}, servingStatuses)
+		mut.Unlock()
+	})
+	t.Run("network not ready", func(t *testing.T) {
+		args := createArgs(t)
+		errNetwork := errors.New("proxy unreachable")
+		args.NetworkChecker = &testscommon.TxSenderMock{
+			CheckNetworkCalled: func(ctx context.Context) error {
+				return errNetwork
+			},
+		}
+
+		var servingStatus grpc_health_v1.HealthCheckResponse_ServingStatus
+		args.StatusSetter = &testscommon.ServingStatusSetterMock{
+			SetServingStatusCalled: func(service string, status grpc_health_v1.HealthCheckResponse_ServingStatus) {
+				servingStatus = status
+			},
+		}
+
+		rc, _ := NewReadinessChecker(args)
+		defer func() {
+			_ = rc.Close()
+		}()
+
+		status := rc.GetReadinessStatus()
+		require.False(t, status.Ready)
+		require.Equal(t, errNetwork.Error(), status.Checks[networkCheck])
+		require.Equal(t, "ok", status.Checks[walletsCheck])
+		require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus)
+	})
+	t.Run("missing certificate", func(t *testing.T) {
+		args := createArgs(t)
+		args.CertificateFile = filepath.Join(t.TempDir(), "missing.crt")
+
+		rc, _ := NewReadinessChecker(args)
+		defer func() {
+			_ = rc.Close()
+		}()
+
+		status := rc.GetReadinessStatus()
+		require.False(t, status.Ready)
+		require.NotEqual(t, "ok", status.Checks[certificateCheck])
+	})
+}
+
+func TestReadinessChecker_CheckWallets(t *testing.T) {
+	t.Parallel()
+
+	walletsStatus := make([]*results.WalletStatus, 0)
+	rc := &readinessChecker{
+		walletsProvider: &testscommon.TxSenderMock{
+			GetWalletsStatusCalled: func() []*results.WalletStatus {
+				return walletsStatus
+			},
+		},
+		minWalletBalance: big.NewInt(100),
+	}
+	require.Equal(t, errNoWallets, rc.checkWallets())
+
+	walletsStatus = []*results.WalletStatus{{Address: "erd1a", Balance: ""}}
+	require.ErrorIs(t, rc.checkWallets(), errUnknownWalletBalance)
+
+	walletsStatus = []*results.WalletStatus{
+		{Address: "erd1a", Balance: "100"},
+		{Address: "erd1b", Balance: "99"},
+	}
+	err := rc.checkWallets()
+	require.ErrorIs(t, err, errLowWalletBalance)
+	require.ErrorContains(t, err, "erd1b")
+
+	walletsStatus[1].Balance = "101"
+	require.Nil(t, rc.checkWallets())
+}
+
+func TestCheckCertificate(t *testing.T) {
+	t.Parallel()
+
+	certFile := createCertificateFile(t)
+	require.Nil(t, checkCertificate(certFile, time.Now()))
+	require.ErrorIs(t, checkCertificate(certFile, time.Now().Add(-time.Hour)), errCertificateNotValid)
+	require.ErrorIs(t, checkCertificate(certFile, time.Now().Add(48*time.Hour)), errCertificateNotValid)
+
+	invalidFile := filepath.Join(t.TempDir(), "invalid.crt")
+	err := os.WriteFile(invalidFile, []byte("content"), 0644)
+	require.Nil(t, err)
+	require.ErrorIs(t, checkCertificate(invalidFile, time.Now()), errNoCertificate)
+
+	require.NotNil(t, checkCertificate(filepath.Join(t.TempDir(), "missing.crt"), time.Now()))
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+)
+
+// NewHealthHandler creates a handler serving only the http health probes and the grpc health service over plain
+// connections, so that orchestrators without a client certificate can probe the server. Grpc requests are served
+// over cleartext http2.
+func NewHealthHandler(readinessProvider ReadinessStatusProvider, grpcHandler *grpc.Server) (http.Handler, error) {
+	if check.IfNil(readinessProvider) {
+		return nil, errNilReadinessProvider
+	}
+
+	router := gin.New()
+	router.Use(gin.Recovery())
+	registerHealthRoutes(router, readinessProvider)
+
+	handler, err := NewServerHandler(router, grpcHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return h2c.NewHandler(handler, &http2.Server{}), nil
+}
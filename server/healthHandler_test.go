@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+func TestNewHealthHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil readiness provider", func(t *testing.T) {
+		handler, err := NewHealthHandler(nil, grpc.NewServer())
+		require.Equal(t, errNilReadinessProvider, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil grpc handler", func(t *testing.T) {
+		handler, err := NewHealthHandler(&testscommon.ReadinessStatusProviderMock{}, nil)
+		require.Equal(t, errNilGRPCHandler, err)
+		require.Nil(t, handler)
+	})
+	t.Run("should serve http and grpc probes over plain connections", func(t *testing.T) {
+		readinessProvider := &testscommon.ReadinessStatusProviderMock{
+			GetReadinessStatusCalled: func() *results.ReadinessStatus {
+				return &results.ReadinessStatus{Ready: false}
+			},
+		}
+		grpcServer := grpc.NewServer()
+		healthServer := grpcHealth.NewServer()
+		healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+		handler, err := NewHealthHandler(readinessProvider, grpcServer)
+		require.Nil(t, err)
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.Nil(t, err)
+		httpServer := &http.Server{Handler: handler}
+		go func() {
+			_ = httpServer.Serve(listener)
+		}()
+		defer func() {
+			_ = httpServer.Close()
+		}()
+
+		address := listener.Addr().String()
+		resp, err := http.Get("http://" + address + "/health/live")
+		require.Nil(t, err)
+		_ = resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = http.Get("http://" + address + "/health/ready")
+		require.Nil(t, err)
+		_ = resp.Body.Close()
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+		resp, err = http.Get("http://" + address + "/wallets")
+		require.Nil(t, err)
+		_ = resp.Body.Close()
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		clientConn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.Nil(t, err)
+		defer func() {
+			_ = clientConn.Close()
+		}()
+
+		healthResp, err := healthpb.NewHealthClient(clientConn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		require.Nil(t, err)
+		require.Equal(t, healthpb.HealthCheckResponse_SERVING, healthResp.Status)
+	})
+}
@@ -2,12 +2,63 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
 )
 
 // TxSender defines a tx sender for bridge operations
 type TxSender interface {
-	SendTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error)
+	SendTxs(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult
+	Accept(data *sovereign.BridgeOperations) *bridge.OperationsResult
+	ResumeUnfinished(ctx context.Context) *bridge.OperationsResult
+	RegisterValidatorSetChangeHandler(handler func(bridgeData *sovereign.BridgeOutGoingData, confirmed bool))
+	RegisterHeldDataHandler(handler func(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult))
+	GetOperationResult(bridgeDataHash []byte) (*results.OperationResult, bool)
+	GetWalletsStatus() []*results.WalletStatus
+	CheckNetwork(ctx context.Context) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// MetricsHandler defines a handler which records metrics of received bridge operations and their sending outcome
+type MetricsHandler interface {
+	ObserveSend(data *sovereign.BridgeOperations, result *bridge.OperationsResult, duration time.Duration)
+	IsInterfaceNil() bool
+}
+
+// SignatureVerifier defines a verifier of the aggregated signature of bridge outgoing data, which keeps the validator
+// set of each epoch
+type SignatureVerifier interface {
+	Verify(bridgeData *sovereign.BridgeOutGoingData) error
+	AddValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) error
+	ConfirmValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) error
+	RemoveValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData)
+	IsInterfaceNil() bool
+}
+
+// OperationResultsProvider defines a provider of sent bridge operations outcome
+type OperationResultsProvider interface {
+	GetOperationResult(bridgeDataHash []byte) (*results.OperationResult, bool)
 	IsInterfaceNil() bool
 }
+
+// WalletsStatusProvider defines a provider of the status of wallets used to send bridge txs
+type WalletsStatusProvider interface {
+	GetWalletsStatus() []*results.WalletStatus
+	IsInterfaceNil() bool
+}
+
+// ReadinessStatusProvider defines a provider of the latest readiness check outcome
+type ReadinessStatusProvider interface {
+	GetReadinessStatus() *results.ReadinessStatus
+	IsInterfaceNil() bool
+}
+
+// Drainer defines a component which stops accepting new work and waits for the in-flight one to finish
+type Drainer interface {
+	Drain(ctx context.Context) error
+}
@@ -0,0 +1,218 @@
+package journal
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"google.golang.org/protobuf/proto"
+)
+
+// TxState defines the state of a journaled bridge tx
+type TxState string
+
+const (
+	// TxBuilt is the state of a tx whose data was created, but has no nonce or signature yet
+	TxBuilt TxState = "built"
+	// TxSigned is the state of a tx which has a nonce and a signature, but was not broadcast yet
+	TxSigned TxState = "signed"
+	// TxBroadcast is the state of a tx which was sent to the network
+	TxBroadcast TxState = "broadcast"
+	// TxConfirmed is the state of a tx which was successfully executed on the network
+	TxConfirmed TxState = "confirmed"
+	// TxFailed is the state of a tx which was executed on the network, but failed
+	TxFailed TxState = "failed"
+)
+
+// TxRecord holds the journaled info of a bridge tx. Error is set for a tx which was cancelled, without being sent, since
+// the tx it depends on failed. ReplacedHashes holds the hashes of the stuck txs previously sent with the same nonce,
+// any of which may still be executed instead, until the outcome of one of them is known.
+type TxRecord struct {
+	Data           []byte   `json:"data"`
+	Sender         string   `json:"sender"`
+	Nonce          uint64   `json:"nonce"`
+	GasLimit       uint64   `json:"gasLimit"`
+	GasPrice       uint64   `json:"gasPrice"`
+	Hash           string   `json:"hash"`
+	State          TxState  `json:"state"`
+	Error          string   `json:"error,omitempty"`
+	ReplacedHashes []string `json:"replacedHashes,omitempty"`
+}
+
+// IsBroadcast returns true if the tx was already sent to the network
+func (tr *TxRecord) IsBroadcast() bool {
+	return tr.State == TxBroadcast || tr.IsFinal()
+}
+
+// IsFinal returns true if the tx outcome on the network is known
+func (tr *TxRecord) IsFinal() bool {
+	return tr.State == TxConfirmed || tr.State == TxFailed
+}
+
+// HasHash returns true if the provided hash is the hash of the tx, or of a stuck tx it replaced
+func (tr *TxRecord) HasHash(txHash string) bool {
+	if tr.Hash == txHash {
+		return true
+	}
+
+	for _, replacedHash := range tr.ReplacedHashes {
+		if replacedHash == txHash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Entry holds a journaled bridge outgoing data together with the state of all its txs. CompletedAt is the unix time,
+// in milliseconds, at which the entry was completed, or zero if it was not. Failed is set, together with its error, if
+// the txs of the entry can never be built.
+type Entry struct {
+	Hash            []byte      `json:"hash"`
+	BridgeData      []byte      `json:"bridgeData"`
+	OperationHashes [][]byte    `json:"operationHashes"`
+	TxsBuilt        bool        `json:"txsBuilt"`
+	Txs             []*TxRecord `json:"txs"`
+	CompletedAt     int64       `json:"completedAt,omitempty"`
+	Failed          bool        `json:"failed,omitempty"`
+	Error           string      `json:"error,omitempty"`
+}
+
+// IsFinished returns true if all txs of the entry were built and broadcast, or if they can never be built
+func (e *Entry) IsFinished() bool {
+	if e.Failed {
+		return true
+	}
+	if !e.TxsBuilt {
+		return false
+	}
+
+	for _, tx := range e.Txs {
+		if !tx.IsBroadcast() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsConfirmed returns true if all txs of the entry were built and confirmed on the network
+func (e *Entry) IsConfirmed() bool {
+	if !e.TxsBuilt {
+		return false
+	}
+
+	for _, tx := range e.Txs {
+		if tx.State != TxConfirmed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsCompleted returns true if the entry reached a final state: the outcome of all its txs on the network is known, or
+// its txs can never be built
+func (e *Entry) IsCompleted() bool {
+	if e.Failed {
+		return true
+	}
+	if !e.TxsBuilt {
+		return false
+	}
+
+	for _, tx := range e.Txs {
+		if !tx.IsFinal() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasFailedTxs returns true if any tx of the entry failed on the network
+func (e *Entry) HasFailedTxs() bool {
+	return e.hasTxsInState(TxFailed)
+}
+
+// TxHashes returns the hashes of all broadcast txs of the entry
+func (e *Entry) TxHashes() []string {
+	txHashes := make([]string, 0, len(e.Txs))
+	for _, tx := range e.Txs {
+		if tx.IsBroadcast() && len(tx.Hash) != 0 {
+			txHashes = append(txHashes, tx.Hash)
+		}
+	}
+
+	return txHashes
+}
+
+// Sender returns the address of the wallet which signed the entry's txs, or an empty string if none was signed yet
+func (e *Entry) Sender() string {
+	for _, tx := range e.Txs {
+		if len(tx.Sender) != 0 {
+			return tx.Sender
+		}
+	}
+
+	return ""
+}
+
+func (e *Entry) hasTxsInState(state TxState) bool {
+	for _, tx := range e.Txs {
+		if tx.State == state {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BridgeOutGoingData returns the journaled bridge outgoing data
+func (e *Entry) BridgeOutGoingData() (*sovereign.BridgeOutGoingData, error) {
+	bridgeData := &sovereign.BridgeOutGoingData{}
+	err := proto.Unmarshal(e.BridgeData, bridgeData)
+	if err != nil {
+		return nil, err
+	}
+
+	return bridgeData, nil
+}
+
+func (e *Entry) clone() *Entry {
+	txs := make([]*TxRecord, 0, len(e.Txs))
+	for _, tx := range e.Txs {
+		txCopy := *tx
+		txs = append(txs, &txCopy)
+	}
+
+	return &Entry{
+		Hash:            e.Hash,
+		BridgeData:      e.BridgeData,
+		OperationHashes: e.OperationHashes,
+		TxsBuilt:        e.TxsBuilt,
+		Txs:             txs,
+		CompletedAt:     e.CompletedAt,
+		Failed:          e.Failed,
+		Error:           e.Error,
+	}
+}
+
+// compact returns a copy of the completed entry holding only its hashes and outcome, so that it can still be found once
+// pruned
+func (e *Entry) compact() *Entry {
+	txs := make([]*TxRecord, 0, len(e.Txs))
+	for _, tx := range e.Txs {
+		txs = append(txs, &TxRecord{
+			Hash:  tx.Hash,
+			State: tx.State,
+		})
+	}
+
+	return &Entry{
+		Hash:            e.Hash,
+		OperationHashes: e.OperationHashes,
+		TxsBuilt:        e.TxsBuilt,
+		Txs:             txs,
+		CompletedAt:     e.CompletedAt,
+		Failed:          e.Failed,
+		Error:           e.Error,
+	}
+}
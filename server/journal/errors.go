@@ -0,0 +1,15 @@
+package journal
+
+import "errors"
+
+var errEmptyJournalDir = errors.New("empty journal dir provided")
+
+var errNilBridgeData = errors.New("nil bridge data provided")
+
+var errEntryNotFound = errors.New("journal entry not found")
+
+var errInvalidTxIndex = errors.New("invalid journal tx index")
+
+var errTxNotFound = errors.New("journal tx not found")
+
+var errNilTxRecord = errors.New("nil tx record provided")
@@ -0,0 +1,585 @@
+package journal
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"google.golang.org/protobuf/proto"
+)
+
+var log = logger.GetOrCreate("journal")
+
+const (
+	entryFileExtension = ".json"
+	tmpFileExtension   = ".tmp"
+	historyFileName    = "pruned.history"
+	dirPermissions     = 0700
+	filePermissions    = 0600
+)
+
+type fileJournal struct {
+	mut        sync.RWMutex
+	dir        string
+	entries    map[string]*Entry
+	operations map[string]string
+	// compact copies of the pruned entries, kept in the order they were pruned
+	history      map[string]*Entry
+	historyOrder []string
+}
+
+// NewFileJournal creates an on-disk outbox journal. Each received bridge outgoing data is stored in its own file, named
+// after its hex encoded hash, inside the provided directory. Existing entries are loaded at creation time, together
+// with the history of pruned entries.
+func NewFileJournal(dir string) (*fileJournal, error) {
+	if len(dir) == 0 {
+		return nil, errEmptyJournalDir
+	}
+
+	err := os.MkdirAll(dir, dirPermissions)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create journal dir %s, error: %w", dir, err)
+	}
+
+	fj := &fileJournal{
+		dir:        dir,
+		entries:    make(map[string]*Entry),
+		operations: make(map[string]string),
+		history:    make(map[string]*Entry),
+	}
+
+	err = fj.loadHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	err = fj.loadEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	return fj, nil
+}
+
+func (fj *fileJournal) loadEntries() error {
+	files, err := os.ReadDir(fj.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), entryFileExtension) {
+			continue
+		}
+
+		entry, errLoad := loadEntry(filepath.Join(fj.dir, file.Name()))
+		if errLoad != nil {
+			return fmt.Errorf("cannot load journal entry %s, error: %w", file.Name(), errLoad)
+		}
+
+		if len(entry.OperationHashes) == 0 {
+			entry.OperationHashes, errLoad = getOperationHashes(entry)
+			if errLoad != nil {
+				return fmt.Errorf("cannot decode journal entry %s, error: %w", file.Name(), errLoad)
+			}
+		}
+
+		// entries completed before their completion time was journaled are retained from now on
+		if entry.IsCompleted() && entry.CompletedAt == 0 {
+			entry.CompletedAt = time.Now().UnixMilli()
+		}
+
+		fj.entries[hex.EncodeToString(entry.Hash)] = entry
+		fj.indexOperations(entry)
+	}
+
+	log.Debug("loaded journal", "dir", fj.dir, "num entries", len(fj.entries), "num pruned entries", len(fj.history))
+	return nil
+}
+
+func (fj *fileJournal) loadHistory() error {
+	buff, err := os.ReadFile(filepath.Join(fj.dir, historyFileName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	history := make([]*Entry, 0)
+	err = json.Unmarshal(buff, &history)
+	if err != nil {
+		return fmt.Errorf("cannot load journal history, error: %w", err)
+	}
+
+	for _, entry := range history {
+		key := hex.EncodeToString(entry.Hash)
+		fj.history[key] = entry
+		fj.historyOrder = append(fj.historyOrder, key)
+		fj.indexOperations(entry)
+	}
+
+	return nil
+}
+
+func loadEntry(path string) (*Entry, error) {
+	buff, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &Entry{}
+	err = json.Unmarshal(buff, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Add persists the received bridge outgoing data, if not already journaled
+func (fj *fileJournal) Add(bridgeData *sovereign.BridgeOutGoingData) error {
+	if bridgeData == nil {
+		return errNilBridgeData
+	}
+
+	bridgeDataBytes, err := proto.Marshal(bridgeData)
+	if err != nil {
+		return err
+	}
+
+	fj.mut.Lock()
+	defer fj.mut.Unlock()
+
+	key := hex.EncodeToString(bridgeData.Hash)
+	if _, exists := fj.entries[key]; exists {
+		return nil
+	}
+	if _, isPruned := fj.history[key]; isPruned {
+		return nil
+	}
+
+	operationHashes := make([][]byte, 0, len(bridgeData.OutGoingOperations))
+	for _, operation := range bridgeData.OutGoingOperations {
+		operationHashes = append(operationHashes, operation.Hash)
+	}
+
+	entry := &Entry{
+		Hash:            bridgeData.Hash,
+		BridgeData:      bridgeDataBytes,
+		OperationHashes: operationHashes,
+		Txs:             make([]*TxRecord, 0),
+	}
+
+	err = fj.persist(entry)
+	if err != nil {
+		return err
+	}
+
+	fj.entries[key] = entry
+	fj.indexOperations(entry)
+	return nil
+}
+
+// getOperationHashes decodes the operation hashes of entries journaled before they were stored separately
+func getOperationHashes(entry *Entry) ([][]byte, error) {
+	if len(entry.BridgeData) == 0 {
+		return nil, nil
+	}
+
+	bridgeData, err := entry.BridgeOutGoingData()
+	if err != nil {
+		return nil, err
+	}
+
+	operationHashes := make([][]byte, 0, len(bridgeData.OutGoingOperations))
+	for _, operation := range bridgeData.OutGoingOperations {
+		operationHashes = append(operationHashes, operation.Hash)
+	}
+
+	return operationHashes, nil
+}
+
+// indexOperations maps each outgoing operation hash of the entry to the entry itself. If the same operation is found in
+// several entries, the latest one is kept.
+func (fj *fileJournal) indexOperations(entry *Entry) {
+	entryKey := hex.EncodeToString(entry.Hash)
+	for _, operationHash := range entry.OperationHashes {
+		fj.operations[hex.EncodeToString(operationHash)] = entryKey
+	}
+}
+
+// SetTxsData stores the txs data built for a journaled bridge outgoing data and marks them as built
+func (fj *fileJournal) SetTxsData(hash []byte, txsData [][]byte) error {
+	fj.mut.Lock()
+	defer fj.mut.Unlock()
+
+	entry, err := fj.getEntry(hash)
+	if err != nil {
+		return err
+	}
+
+	updatedEntry := entry.clone()
+	updatedEntry.TxsBuilt = true
+	updatedEntry.Txs = make([]*TxRecord, 0, len(txsData))
+	for _, txData := range txsData {
+		updatedEntry.Txs = append(updatedEntry.Txs, &TxRecord{
+			Data:  txData,
+			State: TxBuilt,
+		})
+	}
+
+	return fj.replace(updatedEntry)
+}
+
+// SetFailed marks the journaled entry as failed with the provided error, since its txs can never be built, so that it
+// is not resumed anymore
+func (fj *fileJournal) SetFailed(hash []byte, reason string) error {
+	fj.mut.Lock()
+	defer fj.mut.Unlock()
+
+	entry, err := fj.getEntry(hash)
+	if err != nil {
+		return err
+	}
+
+	updatedEntry := entry.clone()
+	updatedEntry.Failed = true
+	updatedEntry.Error = reason
+
+	return fj.replace(updatedEntry)
+}
+
+// UpdateTx updates the sender, nonce, gas, hashes, state and error of a journaled tx. The tx data is never changed.
+func (fj *fileJournal) UpdateTx(hash []byte, txIndex int, tx *TxRecord) error {
+	if tx == nil {
+		return errNilTxRecord
+	}
+
+	fj.mut.Lock()
+	defer fj.mut.Unlock()
+
+	entry, err := fj.getEntry(hash)
+	if err != nil {
+		return err
+	}
+	if txIndex < 0 || txIndex >= len(entry.Txs) {
+		return fmt.Errorf("%w, index = %d, num txs = %d", errInvalidTxIndex, txIndex, len(entry.Txs))
+	}
+
+	updatedEntry := entry.clone()
+	updatedEntry.Txs[txIndex] = &TxRecord{
+		Data:           entry.Txs[txIndex].Data,
+		Sender:         tx.Sender,
+		Nonce:          tx.Nonce,
+		GasLimit:       tx.GasLimit,
+		GasPrice:       tx.GasPrice,
+		Hash:           tx.Hash,
+		State:          tx.State,
+		Error:          tx.Error,
+		ReplacedHashes: tx.ReplacedHashes,
+	}
+
+	return fj.replace(updatedEntry)
+}
+
+// SetTxState updates the state of the journaled tx with the provided hash. If the hash is of a stuck tx which was
+// replaced, the journaled tx hash is set back to it. Once the tx outcome is known, the replaced hashes are dropped.
+func (fj *fileJournal) SetTxState(hash []byte, txHash string, state TxState) error {
+	fj.mut.Lock()
+	defer fj.mut.Unlock()
+
+	entry, err := fj.getEntry(hash)
+	if err != nil {
+		return err
+	}
+
+	updatedEntry := entry.clone()
+	for _, tx := range updatedEntry.Txs {
+		if !tx.HasHash(txHash) {
+			continue
+		}
+
+		tx.Hash = txHash
+		tx.State = state
+		if tx.IsFinal() {
+			tx.ReplacedHashes = nil
+		}
+
+		return fj.replace(updatedEntry)
+	}
+
+	return fmt.Errorf("%w, tx hash = %s", errTxNotFound, txHash)
+}
+
+// ResetFailedTxs marks all txs of the entry which failed on the network as built, so that they can be sent again
+func (fj *fileJournal) ResetFailedTxs(hash []byte) error {
+	fj.mut.Lock()
+	defer fj.mut.Unlock()
+
+	entry, err := fj.getEntry(hash)
+	if err != nil {
+		return err
+	}
+
+	updatedEntry := entry.clone()
+	for idx, tx := range updatedEntry.Txs {
+		if tx.State != TxFailed {
+			continue
+		}
+
+		updatedEntry.Txs[idx] = &TxRecord{
+			Data:  tx.Data,
+			State: TxBuilt,
+		}
+	}
+
+	return fj.replace(updatedEntry)
+}
+
+// Get returns a copy of the journaled entry for the provided bridge outgoing data hash. Pruned entries are returned
+// without their bridge outgoing data and txs data.
+func (fj *fileJournal) Get(hash []byte) (*Entry, bool) {
+	fj.mut.RLock()
+	defer fj.mut.RUnlock()
+
+	return fj.getByKey(hex.EncodeToString(hash))
+}
+
+// GetByOperation returns a copy of the journaled entry which contains the outgoing operation with the provided hash.
+// Pruned entries are returned without their bridge outgoing data and txs data.
+func (fj *fileJournal) GetByOperation(operationHash []byte) (*Entry, bool) {
+	fj.mut.RLock()
+	defer fj.mut.RUnlock()
+
+	entryKey, found := fj.operations[hex.EncodeToString(operationHash)]
+	if !found {
+		return nil, false
+	}
+
+	return fj.getByKey(entryKey)
+}
+
+func (fj *fileJournal) getByKey(key string) (*Entry, bool) {
+	entry, found := fj.entries[key]
+	if found {
+		return entry.clone(), true
+	}
+
+	entry, found = fj.history[key]
+	if found {
+		return entry.clone(), true
+	}
+
+	return nil, false
+}
+
+// Unfinished returns a copy of all journaled entries which still have txs that were not broadcast
+func (fj *fileJournal) Unfinished() []*Entry {
+	fj.mut.RLock()
+	defer fj.mut.RUnlock()
+
+	unfinished := make([]*Entry, 0)
+	for _, entry := range fj.entries {
+		if !entry.IsFinished() {
+			unfinished = append(unfinished, entry.clone())
+		}
+	}
+
+	return unfinished
+}
+
+// Entries returns a copy of all journaled entries
+func (fj *fileJournal) Entries() []*Entry {
+	fj.mut.RLock()
+	defer fj.mut.RUnlock()
+
+	entries := make([]*Entry, 0, len(fj.entries))
+	for _, entry := range fj.entries {
+		entries = append(entries, entry.clone())
+	}
+
+	return entries
+}
+
+// Unconfirmed returns a copy of all journaled entries which have broadcast txs with an unknown outcome
+func (fj *fileJournal) Unconfirmed() []*Entry {
+	fj.mut.RLock()
+	defer fj.mut.RUnlock()
+
+	unconfirmed := make([]*Entry, 0)
+	for _, entry := range fj.entries {
+		if entry.hasTxsInState(TxBroadcast) {
+			unconfirmed = append(unconfirmed, entry.clone())
+		}
+	}
+
+	return unconfirmed
+}
+
+// Prune removes the entries completed before the provided time, either confirmed or failed, unless kept by the provided
+// filter. Compact copies of the pruned entries, holding only their hashes and outcome, are kept in a history bounded to
+// the provided size, so that already completed bridge outgoing data received again is still found. The oldest pruned
+// entries are dropped first.
+func (fj *fileJournal) Prune(completedBefore time.Time, historySize int, keep func(entry *Entry) bool) (int, error) {
+	fj.mut.Lock()
+	defer fj.mut.Unlock()
+
+	pruned := make([]*Entry, 0)
+	for _, entry := range fj.entries {
+		if entry.CompletedAt == 0 || entry.CompletedAt >= completedBefore.UnixMilli() {
+			continue
+		}
+		if keep != nil && keep(entry.clone()) {
+			continue
+		}
+
+		pruned = append(pruned, entry)
+	}
+	if len(pruned) == 0 {
+		return 0, nil
+	}
+
+	sort.SliceStable(pruned, func(i, j int) bool {
+		return pruned[i].CompletedAt < pruned[j].CompletedAt
+	})
+	for _, entry := range pruned {
+		key := hex.EncodeToString(entry.Hash)
+		if _, exists := fj.history[key]; !exists {
+			fj.historyOrder = append(fj.historyOrder, key)
+		}
+		fj.history[key] = entry.compact()
+	}
+	fj.trimHistory(historySize)
+
+	// the history is persisted first, so that a crash never loses track of the pruned entries
+	err := fj.persistHistory()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range pruned {
+		key := hex.EncodeToString(entry.Hash)
+		err = os.Remove(filepath.Join(fj.dir, key+entryFileExtension))
+		if err != nil && !os.IsNotExist(err) {
+			return 0, err
+		}
+
+		delete(fj.entries, key)
+	}
+
+	return len(pruned), nil
+}
+
+func (fj *fileJournal) trimHistory(historySize int) {
+	if historySize < 0 {
+		historySize = 0
+	}
+
+	numDropped := len(fj.historyOrder) - historySize
+	if numDropped <= 0 {
+		return
+	}
+
+	for _, key := range fj.historyOrder[:numDropped] {
+		entry := fj.history[key]
+		delete(fj.history, key)
+
+		for _, operationHash := range entry.OperationHashes {
+			operationKey := hex.EncodeToString(operationHash)
+			if fj.operations[operationKey] == key {
+				delete(fj.operations, operationKey)
+			}
+		}
+	}
+
+	fj.historyOrder = append([]string{}, fj.historyOrder[numDropped:]...)
+}
+
+func (fj *fileJournal) persistHistory() error {
+	history := make([]*Entry, 0, len(fj.historyOrder))
+	for _, key := range fj.historyOrder {
+		history = append(history, fj.history[key])
+	}
+
+	buff, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(filepath.Join(fj.dir, historyFileName), buff)
+}
+
+func (fj *fileJournal) getEntry(hash []byte) (*Entry, error) {
+	entry, found := fj.entries[hex.EncodeToString(hash)]
+	if !found {
+		return nil, fmt.Errorf("%w, hash = %s", errEntryNotFound, hex.EncodeToString(hash))
+	}
+
+	return entry, nil
+}
+
+// replace persists the updated entry. Its completion time is set once it is completed, and cleared if its failed txs
+// are reset, so that it is not pruned while they are sent again.
+func (fj *fileJournal) replace(entry *Entry) error {
+	switch {
+	case !entry.IsCompleted():
+		entry.CompletedAt = 0
+	case entry.CompletedAt == 0:
+		entry.CompletedAt = time.Now().UnixMilli()
+	}
+
+	err := fj.persist(entry)
+	if err != nil {
+		return err
+	}
+
+	fj.entries[hex.EncodeToString(entry.Hash)] = entry
+	return nil
+}
+
+// persist writes the entry in a temporary file which is then renamed, so that a crash never leaves a partially written entry
+func (fj *fileJournal) persist(entry *Entry) error {
+	buff, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(filepath.Join(fj.dir, hex.EncodeToString(entry.Hash)+entryFileExtension), buff)
+}
+
+func writeFile(path string, buff []byte) error {
+	tmpPath := path + tmpFileExtension
+
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePermissions)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff)
+	if err == nil {
+		err = file.Sync()
+	}
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (fj *fileJournal) IsInterfaceNil() bool {
+	return fj == nil
+}
@@ -0,0 +1,459 @@
+package journal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/stretchr/testify/require"
+)
+
+func createBridgeData(hash string) *sovereign.BridgeOutGoingData {
+	return &sovereign.BridgeOutGoingData{
+		Hash: []byte(hash),
+		OutGoingOperations: []*sovereign.OutGoingOperation{
+			{
+				Hash: []byte("opHash"),
+				Data: []byte("opData"),
+			},
+		},
+		AggregatedSignature: []byte("aggregatedSig"),
+		PubKeysBitmap:       []byte("pubKeysBitmap"),
+		Epoch:               4,
+	}
+}
+
+func TestNewFileJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty dir", func(t *testing.T) {
+		fj, err := NewFileJournal("")
+		require.Equal(t, errEmptyJournalDir, err)
+		require.Nil(t, fj)
+	})
+	t.Run("should work", func(t *testing.T) {
+		fj, err := NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+		require.False(t, fj.IsInterfaceNil())
+		require.Empty(t, fj.Unfinished())
+	})
+}
+
+func TestFileJournal_Add(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil bridge data", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		require.Equal(t, errNilBridgeData, fj.Add(nil))
+	})
+	t.Run("should add only once", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+
+		bridgeData := createBridgeData("hash")
+		require.Nil(t, fj.Add(bridgeData))
+		require.Nil(t, fj.SetTxsData(bridgeData.Hash, [][]byte{[]byte("txData")}))
+		require.Nil(t, fj.Add(bridgeData))
+
+		entry, found := fj.Get(bridgeData.Hash)
+		require.True(t, found)
+		require.True(t, entry.TxsBuilt)
+		require.Len(t, entry.Txs, 1)
+
+		journaledBridgeData, err := entry.BridgeOutGoingData()
+		require.Nil(t, err)
+		require.Equal(t, bridgeData.Hash, journaledBridgeData.Hash)
+		require.Equal(t, bridgeData.AggregatedSignature, journaledBridgeData.AggregatedSignature)
+		require.Equal(t, bridgeData.Epoch, journaledBridgeData.Epoch)
+	})
+}
+
+func TestFileJournal_UpdateTx(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown entry", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		err := fj.UpdateTx([]byte("hash"), 0, &TxRecord{Nonce: 1, Hash: "txHash", State: TxBroadcast})
+		require.ErrorIs(t, err, errEntryNotFound)
+	})
+	t.Run("nil tx record", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		bridgeData := createBridgeData("hash")
+		_ = fj.Add(bridgeData)
+
+		err := fj.UpdateTx(bridgeData.Hash, 0, nil)
+		require.Equal(t, errNilTxRecord, err)
+	})
+	t.Run("invalid tx index", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		bridgeData := createBridgeData("hash")
+		_ = fj.Add(bridgeData)
+		_ = fj.SetTxsData(bridgeData.Hash, [][]byte{[]byte("txData")})
+
+		err := fj.UpdateTx(bridgeData.Hash, 1, &TxRecord{Nonce: 1, Hash: "txHash", State: TxBroadcast})
+		require.ErrorIs(t, err, errInvalidTxIndex)
+	})
+	t.Run("should work", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		bridgeData := createBridgeData("hash")
+		_ = fj.Add(bridgeData)
+		_ = fj.SetTxsData(bridgeData.Hash, [][]byte{[]byte("txData1"), []byte("txData2")})
+
+		err := fj.UpdateTx(bridgeData.Hash, 1, &TxRecord{
+			Data:     []byte("should be ignored"),
+			Sender:   "erd1sender",
+			Nonce:    7,
+			GasLimit: 100,
+			GasPrice: 10,
+			Hash:     "txHash2",
+			State:    TxBroadcast,
+		})
+		require.Nil(t, err)
+
+		entry, _ := fj.Get(bridgeData.Hash)
+		require.Equal(t, &TxRecord{Data: []byte("txData1"), State: TxBuilt}, entry.Txs[0])
+		require.Equal(t, "erd1sender", entry.Sender())
+		require.Equal(t, &TxRecord{Data: []byte("txData2"), Sender: "erd1sender", Nonce: 7, GasLimit: 100, GasPrice: 10, Hash: "txHash2", State: TxBroadcast}, entry.Txs[1])
+	})
+}
+
+func TestFileJournal_Unfinished(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fj, _ := NewFileJournal(dir)
+
+	notBuilt := createBridgeData("notBuilt")
+	partiallySent := createBridgeData("partiallySent")
+	sent := createBridgeData("sent")
+
+	_ = fj.Add(notBuilt)
+	_ = fj.Add(partiallySent)
+	_ = fj.Add(sent)
+
+	_ = fj.SetTxsData(partiallySent.Hash, [][]byte{[]byte("txData1"), []byte("txData2")})
+	_ = fj.UpdateTx(partiallySent.Hash, 0, &TxRecord{Nonce: 1, Hash: "txHash1", State: TxBroadcast})
+	_ = fj.UpdateTx(partiallySent.Hash, 1, &TxRecord{Nonce: 2, State: TxSigned})
+
+	_ = fj.SetTxsData(sent.Hash, [][]byte{[]byte("txData3")})
+	_ = fj.UpdateTx(sent.Hash, 0, &TxRecord{Nonce: 3, Hash: "txHash3", State: TxBroadcast})
+
+	requireUnfinishedHashes := func(fj *fileJournal) {
+		unfinished := fj.Unfinished()
+		require.Len(t, unfinished, 2)
+
+		hashes := make(map[string]struct{})
+		for _, entry := range unfinished {
+			hashes[string(entry.Hash)] = struct{}{}
+		}
+		require.Contains(t, hashes, string(notBuilt.Hash))
+		require.Contains(t, hashes, string(partiallySent.Hash))
+	}
+
+	requireUnfinishedHashes(fj)
+
+	// simulate a restart, entries should be reloaded from disk
+	reloadedJournal, err := NewFileJournal(dir)
+	require.Nil(t, err)
+	requireUnfinishedHashes(reloadedJournal)
+
+	entry, found := reloadedJournal.Get(partiallySent.Hash)
+	require.True(t, found)
+	require.Equal(t, &TxRecord{Data: []byte("txData1"), Nonce: 1, Hash: "txHash1", State: TxBroadcast}, entry.Txs[0])
+	require.Len(t, reloadedJournal.Entries(), 3)
+	require.Equal(t, &TxRecord{Data: []byte("txData2"), Nonce: 2, State: TxSigned}, entry.Txs[1])
+}
+
+func TestFileJournal_SetTxState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown entry", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		err := fj.SetTxState([]byte("hash"), "txHash", TxConfirmed)
+		require.ErrorIs(t, err, errEntryNotFound)
+	})
+	t.Run("unknown tx", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		bridgeData := createBridgeData("hash")
+		_ = fj.Add(bridgeData)
+		_ = fj.SetTxsData(bridgeData.Hash, [][]byte{[]byte("txData")})
+		_ = fj.UpdateTx(bridgeData.Hash, 0, &TxRecord{Nonce: 1, Hash: "txHash", State: TxBroadcast})
+
+		err := fj.SetTxState(bridgeData.Hash, "otherTxHash", TxConfirmed)
+		require.ErrorIs(t, err, errTxNotFound)
+	})
+	t.Run("should work", func(t *testing.T) {
+		dir := t.TempDir()
+		fj, _ := NewFileJournal(dir)
+
+		confirmed := createBridgeData("confirmed")
+		unconfirmed := createBridgeData("unconfirmed")
+		_ = fj.Add(confirmed)
+		_ = fj.Add(unconfirmed)
+
+		_ = fj.SetTxsData(confirmed.Hash, [][]byte{[]byte("txData1"), []byte("txData2")})
+		_ = fj.UpdateTx(confirmed.Hash, 0, &TxRecord{Nonce: 1, Hash: "txHash1", State: TxBroadcast})
+		_ = fj.UpdateTx(confirmed.Hash, 1, &TxRecord{Nonce: 2, Hash: "txHash2", State: TxBroadcast})
+		_ = fj.SetTxsData(unconfirmed.Hash, [][]byte{[]byte("txData3")})
+		_ = fj.UpdateTx(unconfirmed.Hash, 0, &TxRecord{Nonce: 3, Hash: "txHash3", State: TxBroadcast})
+
+		require.Len(t, fj.Unconfirmed(), 2)
+
+		err := fj.SetTxState(confirmed.Hash, "txHash1", TxConfirmed)
+		require.Nil(t, err)
+		err = fj.SetTxState(confirmed.Hash, "txHash2", TxFailed)
+		require.Nil(t, err)
+
+		entry, _ := fj.Get(confirmed.Hash)
+		require.True(t, entry.IsFinished())
+		require.True(t, entry.Txs[1].IsBroadcast())
+
+		reloadedJournal, _ := NewFileJournal(dir)
+		unconfirmedEntries := reloadedJournal.Unconfirmed()
+		require.Len(t, unconfirmedEntries, 1)
+		require.Equal(t, unconfirmed.Hash, unconfirmedEntries[0].Hash)
+	})
+	t.Run("replaced tx executed first, should set its hash back", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		bridgeData := createBridgeData("hash")
+		_ = fj.Add(bridgeData)
+		_ = fj.SetTxsData(bridgeData.Hash, [][]byte{[]byte("txData")})
+		_ = fj.UpdateTx(bridgeData.Hash, 0, &TxRecord{
+			Nonce:          1,
+			Hash:           "txHash3",
+			State:          TxBroadcast,
+			ReplacedHashes: []string{"txHash1", "txHash2"},
+		})
+
+		entry, _ := fj.Get(bridgeData.Hash)
+		require.True(t, entry.Txs[0].HasHash("txHash1"))
+		require.False(t, entry.Txs[0].HasHash("otherTxHash"))
+
+		err := fj.SetTxState(bridgeData.Hash, "txHash1", TxConfirmed)
+		require.Nil(t, err)
+
+		entry, _ = fj.Get(bridgeData.Hash)
+		require.True(t, entry.IsConfirmed())
+		require.Equal(t, "txHash1", entry.Txs[0].Hash)
+		require.Empty(t, entry.Txs[0].ReplacedHashes)
+	})
+}
+
+func TestFileJournal_GetByOperation(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fj, _ := NewFileJournal(dir)
+
+	bridgeData := createBridgeData("hash")
+	_ = fj.Add(bridgeData)
+
+	entry, found := fj.GetByOperation(bridgeData.OutGoingOperations[0].Hash)
+	require.True(t, found)
+	require.Equal(t, bridgeData.Hash, entry.Hash)
+	require.Equal(t, [][]byte{bridgeData.OutGoingOperations[0].Hash}, entry.OperationHashes)
+
+	_, found = fj.GetByOperation([]byte("unknownOpHash"))
+	require.False(t, found)
+
+	// operations should be indexed again after a restart
+	reloadedJournal, _ := NewFileJournal(dir)
+	entry, found = reloadedJournal.GetByOperation(bridgeData.OutGoingOperations[0].Hash)
+	require.True(t, found)
+	require.Equal(t, bridgeData.Hash, entry.Hash)
+}
+
+func TestFileJournal_ResetFailedTxs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown entry", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		err := fj.ResetFailedTxs([]byte("hash"))
+		require.ErrorIs(t, err, errEntryNotFound)
+	})
+	t.Run("should work", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		bridgeData := createBridgeData("hash")
+		_ = fj.Add(bridgeData)
+		_ = fj.SetTxsData(bridgeData.Hash, [][]byte{[]byte("txData1"), []byte("txData2")})
+		_ = fj.UpdateTx(bridgeData.Hash, 0, &TxRecord{Nonce: 1, Hash: "txHash1", State: TxBroadcast})
+		_ = fj.UpdateTx(bridgeData.Hash, 1, &TxRecord{Nonce: 2, Hash: "txHash2", State: TxBroadcast})
+		_ = fj.SetTxState(bridgeData.Hash, "txHash1", TxConfirmed)
+		_ = fj.SetTxState(bridgeData.Hash, "txHash2", TxFailed)
+
+		entry, _ := fj.Get(bridgeData.Hash)
+		require.True(t, entry.HasFailedTxs())
+		require.NotZero(t, entry.CompletedAt)
+		require.Equal(t, []string{"txHash1", "txHash2"}, entry.TxHashes())
+
+		err := fj.ResetFailedTxs(bridgeData.Hash)
+		require.Nil(t, err)
+
+		entry, _ = fj.Get(bridgeData.Hash)
+		require.False(t, entry.HasFailedTxs())
+		require.False(t, entry.IsFinished())
+		require.Zero(t, entry.CompletedAt)
+		require.Equal(t, []string{"txHash1"}, entry.TxHashes())
+		require.Equal(t, &TxRecord{Data: []byte("txData2"), State: TxBuilt}, entry.Txs[1])
+	})
+}
+
+func TestFileJournal_Prune(t *testing.T) {
+	t.Parallel()
+
+	confirmBridgeData := func(fj *fileJournal, hash string) *sovereign.BridgeOutGoingData {
+		bridgeData := createBridgeData(hash)
+		bridgeData.OutGoingOperations[0].Hash = []byte("opHash-" + hash)
+		_ = fj.Add(bridgeData)
+		_ = fj.SetTxsData(bridgeData.Hash, [][]byte{[]byte("txData-" + hash)})
+		_ = fj.UpdateTx(bridgeData.Hash, 0, &TxRecord{Nonce: 1, Hash: "txHash-" + hash, State: TxBroadcast})
+		_ = fj.SetTxState(bridgeData.Hash, "txHash-"+hash, TxConfirmed)
+
+		return bridgeData
+	}
+
+	t.Run("should prune only confirmed entries and keep their hashes", func(t *testing.T) {
+		dir := t.TempDir()
+		fj, _ := NewFileJournal(dir)
+
+		confirmed := confirmBridgeData(fj, "confirmed")
+		kept := confirmBridgeData(fj, "kept")
+		unconfirmed := createBridgeData("unconfirmed")
+		_ = fj.Add(unconfirmed)
+
+		entry, _ := fj.Get(confirmed.Hash)
+		require.True(t, entry.IsConfirmed())
+		require.NotZero(t, entry.CompletedAt)
+
+		numPruned, err := fj.Prune(time.Now().Add(time.Second), 10, func(entry *Entry) bool {
+			return string(entry.Hash) == string(kept.Hash)
+		})
+		require.Nil(t, err)
+		require.Equal(t, 1, numPruned)
+		require.Len(t, fj.Entries(), 2)
+
+		requirePruned := func(fj *fileJournal) {
+			entry, found := fj.Get(confirmed.Hash)
+			require.True(t, found)
+			require.True(t, entry.IsFinished())
+			require.Empty(t, entry.BridgeData)
+			require.Equal(t, []string{"txHash-confirmed"}, entry.TxHashes())
+
+			entry, found = fj.GetByOperation(confirmed.OutGoingOperations[0].Hash)
+			require.True(t, found)
+			require.Equal(t, confirmed.Hash, entry.Hash)
+
+			// already confirmed bridge data should not be journaled again
+			require.Nil(t, fj.Add(confirmed))
+			require.Len(t, fj.Entries(), 2)
+		}
+		requirePruned(fj)
+
+		// simulate a restart, pruned entries should be loaded from the history
+		reloadedJournal, err := NewFileJournal(dir)
+		require.Nil(t, err)
+		requirePruned(reloadedJournal)
+		require.Len(t, reloadedJournal.Unfinished(), 1)
+	})
+	t.Run("should prune failed entries and keep their outcome", func(t *testing.T) {
+		dir := t.TempDir()
+		fj, _ := NewFileJournal(dir)
+
+		failedTx := createBridgeData("failedTx")
+		_ = fj.Add(failedTx)
+		_ = fj.SetTxsData(failedTx.Hash, [][]byte{[]byte("txData1"), []byte("txData2")})
+		_ = fj.UpdateTx(failedTx.Hash, 0, &TxRecord{Nonce: 1, Hash: "txHash1", State: TxBroadcast})
+		_ = fj.UpdateTx(failedTx.Hash, 1, &TxRecord{State: TxFailed, Error: "dependency failed"})
+		_ = fj.SetTxState(failedTx.Hash, "txHash1", TxFailed)
+		failedEntry := createBridgeData("failedEntry")
+		_ = fj.Add(failedEntry)
+		_ = fj.SetFailed(failedEntry.Hash, "format error")
+
+		for _, bridgeData := range []*sovereign.BridgeOutGoingData{failedTx, failedEntry} {
+			entry, _ := fj.Get(bridgeData.Hash)
+			require.True(t, entry.IsCompleted())
+			require.NotZero(t, entry.CompletedAt)
+		}
+
+		numPruned, err := fj.Prune(time.Now().Add(time.Second), 10, nil)
+		require.Nil(t, err)
+		require.Equal(t, 2, numPruned)
+		require.Empty(t, fj.Entries())
+
+		reloadedJournal, _ := NewFileJournal(dir)
+		entry, found := reloadedJournal.Get(failedTx.Hash)
+		require.True(t, found)
+		require.True(t, entry.HasFailedTxs())
+		require.Empty(t, entry.BridgeData)
+		entry, found = reloadedJournal.Get(failedEntry.Hash)
+		require.True(t, found)
+		require.True(t, entry.Failed)
+		require.Equal(t, "format error", entry.Error)
+	})
+	t.Run("entries completed after the provided time should not be pruned", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		_ = confirmBridgeData(fj, "confirmed")
+
+		numPruned, err := fj.Prune(time.Now().Add(-time.Hour), 10, nil)
+		require.Nil(t, err)
+		require.Zero(t, numPruned)
+		require.Len(t, fj.Entries(), 1)
+	})
+	t.Run("history should be bounded", func(t *testing.T) {
+		dir := t.TempDir()
+		fj, _ := NewFileJournal(dir)
+		first := confirmBridgeData(fj, "first")
+		second := confirmBridgeData(fj, "second")
+		third := confirmBridgeData(fj, "third")
+
+		numPruned, err := fj.Prune(time.Now().Add(time.Second), 2, nil)
+		require.Nil(t, err)
+		require.Equal(t, 3, numPruned)
+		require.Empty(t, fj.Entries())
+
+		numFound := 0
+		for _, bridgeData := range []*sovereign.BridgeOutGoingData{first, second, third} {
+			if _, found := fj.Get(bridgeData.Hash); found {
+				numFound++
+			}
+		}
+		require.Equal(t, 2, numFound)
+
+		reloadedJournal, _ := NewFileJournal(dir)
+		numFound = 0
+		for _, bridgeData := range []*sovereign.BridgeOutGoingData{first, second, third} {
+			if _, found := reloadedJournal.GetByOperation(bridgeData.OutGoingOperations[0].Hash); found {
+				numFound++
+			}
+		}
+		require.Equal(t, 2, numFound)
+	})
+}
+
+func TestFileJournal_SetFailed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown entry", func(t *testing.T) {
+		fj, _ := NewFileJournal(t.TempDir())
+		err := fj.SetFailed([]byte("hash"), "format error")
+		require.ErrorIs(t, err, errEntryNotFound)
+	})
+	t.Run("should work", func(t *testing.T) {
+		dir := t.TempDir()
+		fj, _ := NewFileJournal(dir)
+		bridgeData := createBridgeData("hash")
+		_ = fj.Add(bridgeData)
+		require.Len(t, fj.Unfinished(), 1)
+
+		err := fj.SetFailed(bridgeData.Hash, "format error")
+		require.Nil(t, err)
+		require.Empty(t, fj.Unfinished())
+
+		reloadedJournal, _ := NewFileJournal(dir)
+		entry, found := reloadedJournal.Get(bridgeData.Hash)
+		require.True(t, found)
+		require.True(t, entry.Failed)
+		require.True(t, entry.IsFinished())
+		require.Equal(t, "format error", entry.Error)
+		require.Empty(t, reloadedJournal.Unfinished())
+	})
+}
@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+)
+
+const namespace = "sovereign_bridge"
+
+type bridgeMetrics struct {
+	registry        *prometheus.Registry
+	receivedOps     *prometheus.CounterVec
+	generatedTxs    *prometheus.CounterVec
+	sendFailures    *prometheus.CounterVec
+	formatterErrors *prometheus.CounterVec
+	sendDuration    prometheus.Histogram
+}
+
+// NewBridgeMetrics creates the prometheus metrics of the bridge, registered on a dedicated registry together with
+// the go runtime and process metrics
+func NewBridgeMetrics() *bridgeMetrics {
+	bm := &bridgeMetrics{
+		registry: prometheus.NewRegistry(),
+		receivedOps: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "received_bridge_operations_total",
+			Help:      "Number of received bridge outgoing data, by outgoing mini block type",
+		}, []string{"type"}),
+		generatedTxs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "generated_txs_total",
+			Help:      "Number of bridge txs created from valid tx data, by called endpoint",
+		}, []string{"endpoint"}),
+		sendFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "send_failures_total",
+			Help:      "Number of bridge outgoing data and txs which could not be sent, by the stage at which they failed",
+		}, []string{"stage"}),
+		formatterErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "formatter_errors_total",
+			Help:      "Number of bridge outgoing data and txs data which could not be formatted, by outgoing mini block type",
+		}, []string{"type"}),
+		sendDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "send_duration_seconds",
+			Help:      "Duration of handling the bridge operations received in a Send request",
+			Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+		}),
+	}
+
+	bm.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		bm.receivedOps,
+		bm.generatedTxs,
+		bm.sendFailures,
+		bm.formatterErrors,
+		bm.sendDuration,
+	)
+
+	return bm
+}
+
+// RegisterWalletsProvider exports the balance, nonce and in-flight txs of the wallets provided at scrape time
+func (bm *bridgeMetrics) RegisterWalletsProvider(provider WalletsStatusProvider) error {
+	if check.IfNil(provider) {
+		return errNilWalletsProvider
+	}
+
+	return bm.registry.Register(newWalletsCollector(provider))
+}
+
+// ObserveSend records the received bridge operations, together with the outcome and duration of sending them.
+// Results are expected in the same order as the received bridge outgoing data.
+func (bm *bridgeMetrics) ObserveSend(data *sovereign.BridgeOperations, result *bridge.OperationsResult, duration time.Duration) {
+	bm.sendDuration.Observe(duration.Seconds())
+
+	results := result.GetResults()
+	for idx, bridgeData := range data.GetData() {
+		mbType := block.OutGoingMBType(bridgeData.GetType()).String()
+		bm.receivedOps.WithLabelValues(mbType).Inc()
+
+		if idx < len(results) {
+			bm.observeBridgeDataResult(mbType, results[idx])
+		}
+	}
+}
+
+func (bm *bridgeMetrics) observeBridgeDataResult(mbType string, result *bridge.OutGoingDataResult) {
+	bm.observeFailure(mbType, result.GetStage())
+
+	for _, tx := range result.GetTxs() {
+		bm.observeFailure(mbType, tx.GetStage())
+		if tx.GetStage() != bridge.ErrorStage_Formatting {
+			bm.generatedTxs.WithLabelValues(getEndpoint(tx.GetData())).Inc()
+		}
+	}
+}
+
+func (bm *bridgeMetrics) observeFailure(mbType string, stage bridge.ErrorStage) {
+	if stage == bridge.ErrorStage_None {
+		return
+	}
+
+	bm.sendFailures.WithLabelValues(stage.String()).Inc()
+	if stage == bridge.ErrorStage_Formatting {
+		bm.formatterErrors.WithLabelValues(mbType).Inc()
+	}
+}
+
+// HTTPHandler returns the handler exposing the metrics in the prometheus format
+func (bm *bridgeMetrics) HTTPHandler() http.Handler {
+	return promhttp.HandlerFor(bm.registry, promhttp.HandlerOpts{})
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (bm *bridgeMetrics) IsInterfaceNil() bool {
+	return bm == nil
+}
+
+func getEndpoint(txData []byte) string {
+	return strings.Split(string(txData), "@")[0]
+}
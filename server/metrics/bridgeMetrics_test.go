@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+func TestBridgeMetrics_ObserveSend(t *testing.T) {
+	t.Parallel()
+
+	bm := NewBridgeMetrics()
+	require.False(t, bm.IsInterfaceNil())
+
+	data := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{Hash: []byte("hash1"), Type: int32(block.OutGoingMbDeposit)},
+			{Hash: []byte("hash2"), Type: int32(block.OutGoingMbChangeValidatorSet)},
+			{Hash: []byte("hash3"), Type: int32(block.OutGoingMbDeposit)},
+		},
+	}
+	result := &bridge.OperationsResult{
+		Results: []*bridge.OutGoingDataResult{
+			{
+				Hash: []byte("hash1"),
+				Txs: []*bridge.TxResult{
+					{Data: []byte("registerBridgeOps@01"), Hash: "txHash1"},
+					{Data: []byte("executeBridgeOps@01"), Hash: "txHash2"},
+					{Data: []byte("unknownEndpoint@01"), Stage: bridge.ErrorStage_Formatting, Error: "formatting error"},
+					{Data: []byte("executeBridgeOps@02"), Stage: bridge.ErrorStage_Broadcast, Error: "broadcast error"},
+				},
+			},
+			{
+				Hash:  []byte("hash2"),
+				Stage: bridge.ErrorStage_Formatting,
+				Error: "formatting error",
+			},
+			{
+				Hash:  []byte("hash3"),
+				Stage: bridge.ErrorStage_Journal,
+				Error: "journal error",
+			},
+		},
+	}
+
+	bm.ObserveSend(data, result, time.Second)
+
+	deposit := block.OutGoingMbDeposit.String()
+	changeValidatorSet := block.OutGoingMbChangeValidatorSet.String()
+	require.Equal(t, float64(2), testutil.ToFloat64(bm.receivedOps.WithLabelValues(deposit)))
+	require.Equal(t, float64(1), testutil.ToFloat64(bm.receivedOps.WithLabelValues(changeValidatorSet)))
+
+	require.Equal(t, float64(1), testutil.ToFloat64(bm.generatedTxs.WithLabelValues("registerBridgeOps")))
+	require.Equal(t, float64(2), testutil.ToFloat64(bm.generatedTxs.WithLabelValues("executeBridgeOps")))
+	require.Equal(t, 2, testutil.CollectAndCount(bm.generatedTxs))
+
+	require.Equal(t, float64(2), testutil.ToFloat64(bm.sendFailures.WithLabelValues(bridge.ErrorStage_Formatting.String())))
+	require.Equal(t, float64(1), testutil.ToFloat64(bm.sendFailures.WithLabelValues(bridge.ErrorStage_Broadcast.String())))
+	require.Equal(t, float64(1), testutil.ToFloat64(bm.sendFailures.WithLabelValues(bridge.ErrorStage_Journal.String())))
+
+	require.Equal(t, float64(1), testutil.ToFloat64(bm.formatterErrors.WithLabelValues(deposit)))
+	require.Equal(t, float64(1), testutil.ToFloat64(bm.formatterErrors.WithLabelValues(changeValidatorSet)))
+
+	require.Equal(t, 1, testutil.CollectAndCount(bm.sendDuration))
+}
+
+func TestBridgeMetrics_HTTPHandler(t *testing.T) {
+	t.Parallel()
+
+	bm := NewBridgeMetrics()
+
+	err := bm.RegisterWalletsProvider(nil)
+	require.Equal(t, errNilWalletsProvider, err)
+
+	err = bm.RegisterWalletsProvider(&testscommon.TxSenderMock{
+		GetWalletsStatusCalled: func() []*results.WalletStatus {
+			return []*results.WalletStatus{
+				{Address: "erd1a", Nonce: 7, Balance: "1000000000000000000", InFlight: 2},
+				{Address: "erd1b", Nonce: 3},
+			}
+		},
+	})
+	require.Nil(t, err)
+
+	bm.ObserveSend(&sovereign.BridgeOperations{}, &bridge.OperationsResult{}, time.Millisecond)
+
+	recorder := httptest.NewRecorder()
+	bm.HTTPHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	body, err := io.ReadAll(recorder.Body)
+	require.Nil(t, err)
+
+	expectedLines := []string{
+		`sovereign_bridge_wallet_balance{address="erd1a"} 1e+18`,
+		`sovereign_bridge_wallet_balance{address="erd1b"} 0`,
+		`sovereign_bridge_wallet_nonce{address="erd1a"} 7`,
+		`sovereign_bridge_wallet_in_flight_txs{address="erd1a"} 2`,
+		`sovereign_bridge_send_duration_seconds_count 1`,
+		`go_goroutines`,
+	}
+	for _, line := range expectedLines {
+		require.True(t, strings.Contains(string(body), line), line)
+	}
+}
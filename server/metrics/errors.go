@@ -0,0 +1,5 @@
+package metrics
+
+import "errors"
+
+var errNilWalletsProvider = errors.New("nil wallets status provider provided")
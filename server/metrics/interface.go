@@ -0,0 +1,9 @@
+package metrics
+
+import "github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+
+// WalletsStatusProvider defines a provider of the status of wallets used to send bridge txs
+type WalletsStatusProvider interface {
+	GetWalletsStatus() []*results.WalletStatus
+	IsInterfaceNil() bool
+}
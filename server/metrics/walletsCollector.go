@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// walletsCollector exports the status of the wallets at scrape time, so that it is as fresh as the wallets refresh
+type walletsCollector struct {
+	provider    WalletsStatusProvider
+	balanceDesc *prometheus.Desc
+	nonceDesc   *prometheus.Desc
+	inFlight    *prometheus.Desc
+}
+
+func newWalletsCollector(provider WalletsStatusProvider) *walletsCollector {
+	return &walletsCollector{
+		provider: provider,
+		balanceDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "wallet_balance"),
+			"Balance of the wallet, in the smallest denomination",
+			[]string{"address"}, nil,
+		),
+		nonceDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "wallet_nonce"),
+			"Current account nonce of the wallet on the network",
+			[]string{"address"}, nil,
+		),
+		inFlight: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "wallet_in_flight_txs"),
+			"Number of txs sent from the wallet which were not yet executed",
+			[]string{"address"}, nil,
+		),
+	}
+}
+
+// Describe sends the descriptors of the wallet metrics
+func (wc *walletsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- wc.balanceDesc
+	ch <- wc.nonceDesc
+	ch <- wc.inFlight
+}
+
+// Collect sends the current status of every wallet
+func (wc *walletsCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, status := range wc.provider.GetWalletsStatus() {
+		ch <- prometheus.MustNewConstMetric(wc.balanceDesc, prometheus.GaugeValue, parseBalance(status.Balance), status.Address)
+		ch <- prometheus.MustNewConstMetric(wc.nonceDesc, prometheus.GaugeValue, float64(status.Nonce), status.Address)
+		ch <- prometheus.MustNewConstMetric(wc.inFlight, prometheus.GaugeValue, float64(status.InFlight), status.Address)
+	}
+}
+
+// parseBalance returns the balance as float, 0 if it was not yet fetched from the network
+func parseBalance(balance string) float64 {
+	value, ok := big.NewFloat(0).SetString(balance)
+	if !ok {
+		return 0
+	}
+
+	result, _ := value.Float64()
+	return result
+}
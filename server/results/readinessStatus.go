@@ -0,0 +1,7 @@
+package results
+
+// ReadinessStatus holds whether the server is ready to send bridge txs, together with the outcome of each check
+type ReadinessStatus struct {
+	Ready  bool              `json:"ready"`
+	Checks map[string]string `json:"checks"`
+}
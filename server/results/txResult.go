@@ -0,0 +1,198 @@
+package results
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	unknownErrorMessage        = "unknown error"
+	signalErrorIdentifier      = "signalError"
+	internalVMErrorsIdentifier = "internalVMErrors"
+)
+
+// Event holds a decoded log event of a bridge tx
+type Event struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics"`
+	Data       string   `json:"data"`
+}
+
+// SCResult holds a decoded smart contract result of a bridge tx
+type SCResult struct {
+	Hash          string `json:"hash"`
+	Receiver      string `json:"receiver"`
+	Data          string `json:"data"`
+	ReturnMessage string `json:"returnMessage,omitempty"`
+}
+
+// TxResult holds the outcome of a bridge tx
+type TxResult struct {
+	TxHash    string      `json:"txHash"`
+	Status    string      `json:"status"`
+	Error     string      `json:"error,omitempty"`
+	SCResults []*SCResult `json:"scResults,omitempty"`
+	Events    []*Event    `json:"events,omitempty"`
+}
+
+// IsFinal returns true if the tx outcome is known
+func (tr *TxResult) IsFinal() bool {
+	return tr.Status != string(transaction.TxStatusPending)
+}
+
+// Failed returns true if the tx was executed, but failed
+func (tr *TxResult) Failed() bool {
+	return tr.IsFinal() && tr.Status != string(transaction.TxStatusSuccess)
+}
+
+// PendingTx identifies a sent bridge tx whose outcome is not known yet
+type PendingTx struct {
+	BridgeDataHash []byte
+	TxHash         string
+}
+
+// OperationResult holds the outcome of all txs sent for a bridge outgoing data
+type OperationResult struct {
+	Hash string      `json:"hash"`
+	Txs  []*TxResult `json:"txs"`
+}
+
+// SetTxResult adds or replaces the provided tx outcome
+func (or *OperationResult) SetTxResult(txResult *TxResult) {
+	for idx, tx := range or.Txs {
+		if tx.TxHash == txResult.TxHash {
+			or.Txs[idx] = txResult
+			return
+		}
+	}
+
+	or.Txs = append(or.Txs, txResult)
+}
+
+// ReplaceTxResult replaces the outcome of the tx with the provided old hash, or adds it if not found
+func (or *OperationResult) ReplaceTxResult(oldTxHash string, txResult *TxResult) {
+	for idx, tx := range or.Txs {
+		if tx.TxHash == oldTxHash {
+			or.Txs[idx] = txResult
+			return
+		}
+	}
+
+	or.Txs = append(or.Txs, txResult)
+}
+
+// IsFinal returns true if the outcome of all txs is known
+func (or *OperationResult) IsFinal() bool {
+	for _, tx := range or.Txs {
+		if !tx.IsFinal() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of the operation result
+func (or *OperationResult) Clone() *OperationResult {
+	txs := make([]*TxResult, 0, len(or.Txs))
+	for _, tx := range or.Txs {
+		txCopy := *tx
+		txs = append(txs, &txCopy)
+	}
+
+	return &OperationResult{
+		Hash: or.Hash,
+		Txs:  txs,
+	}
+}
+
+// DecodeTxResult builds the outcome of an executed tx from its network info
+func DecodeTxResult(txHash string, status transaction.TxStatus, tx *data.TransactionOnNetwork) *TxResult {
+	txResult := &TxResult{
+		TxHash:    txHash,
+		Status:    string(status),
+		SCResults: make([]*SCResult, 0, len(tx.ScResults)),
+		Events:    decodeLogs(tx.Logs),
+	}
+
+	for _, scr := range tx.ScResults {
+		if scr == nil {
+			continue
+		}
+
+		txResult.SCResults = append(txResult.SCResults, &SCResult{
+			Hash:          scr.Hash,
+			Receiver:      scr.RcvAddr,
+			Data:          scr.Data,
+			ReturnMessage: scr.ReturnMessage,
+		})
+		txResult.Events = append(txResult.Events, decodeLogs(scr.Logs)...)
+	}
+
+	if txResult.Failed() {
+		txResult.Error = extractErrorMessage(txResult)
+	}
+
+	return txResult
+}
+
+func decodeLogs(logs *transaction.ApiLogs) []*Event {
+	if logs == nil {
+		return nil
+	}
+
+	events := make([]*Event, 0, len(logs.Events))
+	for _, event := range logs.Events {
+		if event == nil {
+			continue
+		}
+
+		topics := make([]string, 0, len(event.Topics))
+		for _, topic := range event.Topics {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		events = append(events, &Event{
+			Address:    event.Address,
+			Identifier: event.Identifier,
+			Topics:     topics,
+			Data:       string(event.Data),
+		})
+	}
+
+	return events
+}
+
+// extractErrorMessage returns the contract's error message, which is either found as the return message of a smart
+// contract result or as the last topic of a signalError event
+func extractErrorMessage(txResult *TxResult) string {
+	for _, scr := range txResult.SCResults {
+		if len(scr.ReturnMessage) != 0 {
+			return scr.ReturnMessage
+		}
+	}
+
+	for _, event := range txResult.Events {
+		switch event.Identifier {
+		case signalErrorIdentifier:
+			if len(event.Topics) == 0 {
+				continue
+			}
+
+			message, err := hex.DecodeString(event.Topics[len(event.Topics)-1])
+			if err == nil && len(message) != 0 {
+				return string(message)
+			}
+		case internalVMErrorsIdentifier:
+			if len(event.Data) != 0 {
+				return strings.TrimSpace(event.Data)
+			}
+		}
+	}
+
+	return unknownErrorMessage
+}
@@ -0,0 +1,86 @@
+package results
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeTxResult(t *testing.T) {
+	t.Parallel()
+
+	t.Run("return message from sc result", func(t *testing.T) {
+		txResult := DecodeTxResult("txHash", transaction.TxStatusFail, &data.TransactionOnNetwork{
+			ScResults: []*transaction.ApiSmartContractResult{
+				{
+					Hash:          "scrHash",
+					RcvAddr:       "erd1qqq",
+					Data:          "@04@696e76616c6964207369676e6174757265",
+					ReturnMessage: "invalid signature",
+					Logs: &transaction.ApiLogs{
+						Events: []*transaction.Events{
+							{Identifier: "writeLog", Topics: [][]byte{{0x1}}, Data: []byte("data")},
+						},
+					},
+				},
+			},
+		})
+
+		require.Equal(t, &TxResult{
+			TxHash: "txHash",
+			Status: string(transaction.TxStatusFail),
+			Error:  "invalid signature",
+			SCResults: []*SCResult{
+				{
+					Hash:          "scrHash",
+					Receiver:      "erd1qqq",
+					Data:          "@04@696e76616c6964207369676e6174757265",
+					ReturnMessage: "invalid signature",
+				},
+			},
+			Events: []*Event{
+				{Identifier: "writeLog", Topics: []string{"01"}, Data: "data"},
+			},
+		}, txResult)
+	})
+	t.Run("internal vm error", func(t *testing.T) {
+		txResult := DecodeTxResult("txHash", transaction.TxStatusInvalid, &data.TransactionOnNetwork{
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{Identifier: internalVMErrorsIdentifier, Data: []byte(" out of gas ")},
+				},
+			},
+		})
+
+		require.True(t, txResult.Failed())
+		require.Equal(t, "out of gas", txResult.Error)
+	})
+	t.Run(unknownErrorMessage, func(t *testing.T) {
+		txResult := DecodeTxResult("txHash", transaction.TxStatusFail, &data.TransactionOnNetwork{})
+		require.Equal(t, unknownErrorMessage, txResult.Error)
+	})
+}
+
+func TestOperationResult_SetTxResult(t *testing.T) {
+	t.Parallel()
+
+	opResult := &OperationResult{Hash: "hash"}
+	opResult.SetTxResult(&TxResult{TxHash: "txHash1", Status: string(transaction.TxStatusPending)})
+	opResult.SetTxResult(&TxResult{TxHash: "txHash2", Status: string(transaction.TxStatusPending)})
+	opResult.SetTxResult(&TxResult{TxHash: "txHash1", Status: string(transaction.TxStatusSuccess)})
+
+	require.Equal(t, []*TxResult{
+		{TxHash: "txHash1", Status: string(transaction.TxStatusSuccess)},
+		{TxHash: "txHash2", Status: string(transaction.TxStatusPending)},
+	}, opResult.Txs)
+	require.False(t, opResult.IsFinal())
+
+	opResult.SetTxResult(&TxResult{TxHash: "txHash2", Status: string(transaction.TxStatusFail)})
+	require.True(t, opResult.IsFinal())
+
+	clonedResult := opResult.Clone()
+	clonedResult.Txs[0].Status = string(transaction.TxStatusFail)
+	require.Equal(t, string(transaction.TxStatusSuccess), opResult.Txs[0].Status)
+}
@@ -0,0 +1,9 @@
+package results
+
+// WalletStatus holds the state of a wallet used to send bridge txs
+type WalletStatus struct {
+	Address  string `json:"address"`
+	Nonce    uint64 `json:"nonce"`
+	Balance  string `json:"balance"`
+	InFlight uint64 `json:"inFlight"`
+}
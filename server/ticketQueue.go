@@ -0,0 +1,216 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+)
+
+// maxFinishedTickets is the number of finished tickets kept in memory, after which the oldest ones are dropped
+const maxFinishedTickets = 10_000
+
+// maxSendAttempts is the number of times the txs of a queued bridge outgoing data are sent before its ticket is failed
+const maxSendAttempts = 3
+
+// ticketQueue holds the bridge operations accepted in asynchronous mode until they are submitted, together with the
+// ticket of each bridge outgoing data
+type ticketQueue struct {
+	mut      sync.Mutex
+	queue    []*sovereign.BridgeOperations
+	tickets  map[string]*bridge.Ticket
+	attempts map[string]int
+	finished []string
+	notify   chan struct{}
+}
+
+func newTicketQueue() *ticketQueue {
+	return &ticketQueue{
+		queue:    make([]*sovereign.BridgeOperations, 0),
+		tickets:  make(map[string]*bridge.Ticket),
+		attempts: make(map[string]int),
+		finished: make([]string, 0),
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+// push queues the bridge operations and returns the queued ticket of each bridge outgoing data
+func (tq *ticketQueue) push(data *sovereign.BridgeOperations) *bridge.Tickets {
+	tq.mut.Lock()
+	defer tq.mut.Unlock()
+
+	tickets := &bridge.Tickets{
+		Tickets: make([]*bridge.Ticket, 0, len(data.Data)),
+	}
+	for _, bridgeData := range data.Data {
+		ticket := &bridge.Ticket{
+			Hash:   bridgeData.Hash,
+			Status: bridge.TicketStatus_Queued,
+		}
+		key := hex.EncodeToString(bridgeData.Hash)
+		tq.tickets[key] = ticket
+		delete(tq.attempts, key)
+		tickets.Tickets = append(tickets.Tickets, proto.Clone(ticket).(*bridge.Ticket))
+	}
+	tq.queue = append(tq.queue, data)
+	tq.notifyQueued()
+
+	return tickets
+}
+
+// requeue queues again the bridge operations whose txs were not all sent, keeping their tickets as submitting
+func (tq *ticketQueue) requeue(data *sovereign.BridgeOperations) {
+	tq.mut.Lock()
+	defer tq.mut.Unlock()
+
+	tq.queue = append(tq.queue, data)
+	tq.notifyQueued()
+}
+
+func (tq *ticketQueue) notifyQueued() {
+	select {
+	case tq.notify <- struct{}{}:
+	default:
+	}
+}
+
+// popAll waits for queued bridge operations, then returns all of them, in queued order, and marks their tickets as
+// submitting. It returns false if the context is done first.
+func (tq *ticketQueue) popAll(ctx context.Context) ([]*sovereign.BridgeOperations, bool) {
+	for {
+		queued := tq.tryPopAll()
+		if len(queued) != 0 {
+			return queued, true
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-tq.notify:
+		}
+	}
+}
+
+func (tq *ticketQueue) tryPopAll() []*sovereign.BridgeOperations {
+	tq.mut.Lock()
+	defer tq.mut.Unlock()
+
+	queued := tq.queue
+	tq.queue = make([]*sovereign.BridgeOperations, 0)
+	for _, data := range queued {
+		for _, bridgeData := range data.Data {
+			ticket, found := tq.tickets[hex.EncodeToString(bridgeData.Hash)]
+			if found && ticket.Status == bridge.TicketStatus_Queued {
+				ticket.Status = bridge.TicketStatus_Submitting
+			}
+		}
+	}
+
+	return queued
+}
+
+// finish sets the tickets of the submitted bridge outgoing data from the outcome of sending their txs. It returns the
+// failed bridge outgoing data which should be sent again, until maxSendAttempts is reached, whose tickets remain
+// submitting with the outcome of the last attempt. Bridge outgoing data rejected for its epoch is not sent again. The
+// held bridge outgoing data is not sent again either: its ticket remains submitting until the tx sender sends it in
+// background, once the validator set of its epoch is rotated.
+func (tq *ticketQueue) finish(data *sovereign.BridgeOperations, result *bridge.OperationsResult) *sovereign.BridgeOperations {
+	tq.mut.Lock()
+	defer tq.mut.Unlock()
+
+	return tq.setResults(data, result)
+}
+
+// finishHeld sets the ticket of the held bridge outgoing data from the outcome of sending its txs in background, the
+// same way as finish. Bridge outgoing data whose ticket is no longer held, since it was queued again or finished in the
+// meantime, is ignored.
+func (tq *ticketQueue) finishHeld(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult) *sovereign.BridgeOperations {
+	tq.mut.Lock()
+	defer tq.mut.Unlock()
+
+	ticket, found := tq.tickets[hex.EncodeToString(bridgeData.Hash)]
+	if !found || ticket.Status != bridge.TicketStatus_Submitting || !ticket.GetResult().GetHeld() {
+		return &sovereign.BridgeOperations{
+			Data: make([]*sovereign.BridgeOutGoingData, 0),
+		}
+	}
+
+	data := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{bridgeData},
+	}
+	return tq.setResults(data, &bridge.OperationsResult{Results: []*bridge.OutGoingDataResult{result}})
+}
+
+func (tq *ticketQueue) setResults(data *sovereign.BridgeOperations, result *bridge.OperationsResult) *sovereign.BridgeOperations {
+	unfinished := &sovereign.BridgeOperations{
+		Data: make([]*sovereign.BridgeOutGoingData, 0),
+	}
+	for idx, bridgeDataResult := range result.GetResults() {
+		key := hex.EncodeToString(bridgeDataResult.GetHash())
+		if bridgeDataResult.GetHeld() {
+			tq.setSubmitting(key, bridgeDataResult)
+			continue
+		}
+		if idx < len(data.Data) && tq.shouldSendAgain(key, bridgeDataResult) {
+			tq.setSubmitting(key, bridgeDataResult)
+			unfinished.Data = append(unfinished.Data, data.Data[idx])
+			continue
+		}
+
+		delete(tq.attempts, key)
+		tq.tickets[key] = bridge.NewFinishedTicket(bridgeDataResult)
+		tq.finished = append(tq.finished, key)
+	}
+
+	tq.pruneFinished()
+
+	return unfinished
+}
+
+func (tq *ticketQueue) setSubmitting(key string, result *bridge.OutGoingDataResult) {
+	tq.tickets[key] = &bridge.Ticket{
+		Hash:   result.GetHash(),
+		Status: bridge.TicketStatus_Submitting,
+		Result: result,
+	}
+}
+
+func (tq *ticketQueue) shouldSendAgain(key string, result *bridge.OutGoingDataResult) bool {
+	if !result.Failed() || result.GetStage() == bridge.ErrorStage_Epoch {
+		return false
+	}
+
+	tq.attempts[key]++
+	return tq.attempts[key] < maxSendAttempts
+}
+
+// pruneFinished drops the oldest finished tickets above maxFinishedTickets. Tickets queued again since they were
+// finished are kept.
+func (tq *ticketQueue) pruneFinished() {
+	for len(tq.finished) > maxFinishedTickets {
+		key := tq.finished[0]
+		tq.finished = tq.finished[1:]
+
+		ticket, found := tq.tickets[key]
+		if found && ticket.IsFinished() {
+			delete(tq.tickets, key)
+		}
+	}
+}
+
+// get returns a copy of the ticket of the bridge outgoing data with the provided hash
+func (tq *ticketQueue) get(hash []byte) (*bridge.Ticket, bool) {
+	tq.mut.Lock()
+	defer tq.mut.Unlock()
+
+	ticket, found := tq.tickets[hex.EncodeToString(hash)]
+	if !found {
+		return nil, false
+	}
+
+	return proto.Clone(ticket).(*bridge.Ticket), true
+}
@@ -0,0 +1,9 @@
+package tracker
+
+import "errors"
+
+var errNilProxy = errors.New("nil proxy provided")
+
+var errNilJournal = errors.New("nil journal provided")
+
+var errInvalidPollInterval = errors.New("invalid poll interval provided")
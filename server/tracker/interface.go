@@ -0,0 +1,24 @@
+package tracker
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+)
+
+// Proxy defines the proxy used to query the status and results of sent txs
+type Proxy interface {
+	ProcessTransactionStatus(ctx context.Context, hexTxHash string) (transaction.TxStatus, error)
+	GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	IsInterfaceNil() bool
+}
+
+// Journal defines the outbox journal in which the outcome of sent txs is stored
+type Journal interface {
+	SetTxState(hash []byte, txHash string, state journal.TxState) error
+	Unconfirmed() []*journal.Entry
+	IsInterfaceNil() bool
+}
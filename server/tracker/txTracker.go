@@ -0,0 +1,371 @@
+package tracker
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+)
+
+var log = logger.GetOrCreate("tracker")
+
+const minPollInterval = time.Millisecond * 100
+
+// maxFinalResults is the number of final operation results kept in memory, after which the oldest ones are dropped
+const maxFinalResults = 10_000
+
+// ArgsTxTracker holds args to create a new tx tracker
+type ArgsTxTracker struct {
+	Proxy        Proxy
+	Journal      Journal
+	PollInterval time.Duration
+}
+
+// sameNonceTxs holds the hashes of a stuck tx and of the txs which replaced it, in the order they were sent
+type sameNonceTxs struct {
+	hashes []string
+}
+
+func (snt *sameNonceTxs) latest() string {
+	return snt.hashes[len(snt.hashes)-1]
+}
+
+type trackedTx struct {
+	bridgeDataHash []byte
+	txHash         string
+	trackedSince   time.Time
+	sameNonce      *sameNonceTxs
+}
+
+type txTracker struct {
+	proxy        Proxy
+	journal      Journal
+	pollInterval time.Duration
+
+	mut        sync.RWMutex
+	pending    map[string]*trackedTx
+	opResults  map[string]*results.OperationResult
+	final      []string
+	handlers   []func(bridgeDataHash []byte, txHash string, state journal.TxState)
+	cancelFunc func()
+}
+
+// NewTxTracker creates a tracker which polls the network for each sent bridge tx until its outcome is final. Broadcast
+// txs from the journal, whose outcome was not known before the last shutdown, are tracked from the start.
+func NewTxTracker(args ArgsTxTracker) (*txTracker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tt := &txTracker{
+		proxy:        args.Proxy,
+		journal:      args.Journal,
+		pollInterval: args.PollInterval,
+		pending:      make(map[string]*trackedTx),
+		opResults:    make(map[string]*results.OperationResult),
+		final:        make([]string, 0),
+		cancelFunc:   cancel,
+	}
+
+	tt.trackUnconfirmed()
+
+	go tt.processLoop(ctx)
+
+	return tt, nil
+}
+
+func checkArgs(args ArgsTxTracker) error {
+	if check.IfNil(args.Proxy) {
+		return errNilProxy
+	}
+	if check.IfNil(args.Journal) {
+		return errNilJournal
+	}
+	if args.PollInterval < minPollInterval {
+		return errInvalidPollInterval
+	}
+
+	return nil
+}
+
+// trackUnconfirmed tracks the broadcast txs from the journal, together with the stuck txs they replaced, any of which
+// may still be executed instead
+func (tt *txTracker) trackUnconfirmed() {
+	for _, entry := range tt.journal.Unconfirmed() {
+		for _, tx := range entry.Txs {
+			if tx.State != journal.TxBroadcast {
+				continue
+			}
+			if len(tx.ReplacedHashes) == 0 {
+				tt.Track(entry.Hash, tx.Hash)
+				continue
+			}
+
+			hashes := append(append([]string{}, tx.ReplacedHashes...), tx.Hash)
+			tt.Track(entry.Hash, hashes[0])
+			for idx := 1; idx < len(hashes); idx++ {
+				tt.Replace(entry.Hash, hashes[idx-1], hashes[idx])
+			}
+		}
+	}
+}
+
+// RegisterHandler registers a handler notified of the final state of each tracked tx, once it is journaled. Handlers are
+// called from the tracking loop, so they should not block.
+func (tt *txTracker) RegisterHandler(handler func(bridgeDataHash []byte, txHash string, state journal.TxState)) {
+	if handler == nil {
+		return
+	}
+
+	tt.mut.Lock()
+	tt.handlers = append(tt.handlers, handler)
+	tt.mut.Unlock()
+}
+
+// Track starts tracking the provided tx, sent for the bridge outgoing data with the provided hash
+func (tt *txTracker) Track(bridgeDataHash []byte, txHash string) {
+	tt.mut.Lock()
+	defer tt.mut.Unlock()
+
+	tt.pending[txHash] = &trackedTx{
+		bridgeDataHash: bridgeDataHash,
+		txHash:         txHash,
+		trackedSince:   time.Now(),
+		sameNonce:      &sameNonceTxs{hashes: []string{txHash}},
+	}
+
+	opResult := tt.getOrCreateOperationResult(bridgeDataHash)
+	opResult.SetTxResult(&results.TxResult{
+		TxHash: txHash,
+		Status: string(transaction.TxStatusPending),
+	})
+}
+
+// Replace starts tracking the tx which replaced a stuck tx, sent with the same nonce. The stuck tx is still tracked,
+// since it may be executed first, until the outcome of any of them is known. Only the latest tx is reported as stuck.
+func (tt *txTracker) Replace(bridgeDataHash []byte, oldTxHash string, newTxHash string) {
+	tt.mut.Lock()
+	defer tt.mut.Unlock()
+
+	sameNonce := &sameNonceTxs{hashes: []string{oldTxHash}}
+	oldTx, found := tt.pending[oldTxHash]
+	if found {
+		sameNonce = oldTx.sameNonce
+	}
+	sameNonce.hashes = append(sameNonce.hashes, newTxHash)
+
+	tt.pending[newTxHash] = &trackedTx{
+		bridgeDataHash: bridgeDataHash,
+		txHash:         newTxHash,
+		trackedSince:   time.Now(),
+		sameNonce:      sameNonce,
+	}
+
+	opResult := tt.getOrCreateOperationResult(bridgeDataHash)
+	opResult.ReplaceTxResult(oldTxHash, &results.TxResult{
+		TxHash: newTxHash,
+		Status: string(transaction.TxStatusPending),
+	})
+}
+
+// SetCancelled records the outcome of a tx of the bridge outgoing data with the provided hash which was cancelled,
+// without being sent, since the tx it depends on failed
+func (tt *txTracker) SetCancelled(bridgeDataHash []byte, reason string) {
+	tt.mut.Lock()
+	defer tt.mut.Unlock()
+
+	opResult := tt.getOrCreateOperationResult(bridgeDataHash)
+	opResult.Txs = append(opResult.Txs, &results.TxResult{
+		Status: string(transaction.TxStatusFail),
+		Error:  reason,
+	})
+	tt.setFinalIfDone(opResult)
+}
+
+// GetStuckTxs returns all txs which are pending for longer than the provided duration. Stuck txs which were already
+// replaced are not returned.
+func (tt *txTracker) GetStuckTxs(pendingFor time.Duration) []*results.PendingTx {
+	tt.mut.RLock()
+	defer tt.mut.RUnlock()
+
+	stuckTxs := make([]*results.PendingTx, 0)
+	for _, tx := range tt.pending {
+		if time.Since(tx.trackedSince) < pendingFor || tx.sameNonce.latest() != tx.txHash {
+			continue
+		}
+
+		stuckTxs = append(stuckTxs, &results.PendingTx{
+			BridgeDataHash: tx.bridgeDataHash,
+			TxHash:         tx.txHash,
+		})
+	}
+
+	return stuckTxs
+}
+
+func (tt *txTracker) getOrCreateOperationResult(bridgeDataHash []byte) *results.OperationResult {
+	key := hex.EncodeToString(bridgeDataHash)
+	opResult, found := tt.opResults[key]
+	if !found {
+		opResult = &results.OperationResult{
+			Hash: key,
+			Txs:  make([]*results.TxResult, 0),
+		}
+		tt.opResults[key] = opResult
+	}
+
+	return opResult
+}
+
+// setFinalIfDone records the operation result as final once the outcome of all its txs is known, then drops the oldest
+// final operation results above maxFinalResults. Operation results with txs tracked again since they were final are
+// kept.
+func (tt *txTracker) setFinalIfDone(opResult *results.OperationResult) {
+	if !opResult.IsFinal() {
+		return
+	}
+
+	tt.final = append(tt.final, opResult.Hash)
+	for len(tt.final) > maxFinalResults {
+		key := tt.final[0]
+		tt.final = tt.final[1:]
+
+		oldResult, found := tt.opResults[key]
+		if found && oldResult.IsFinal() {
+			delete(tt.opResults, key)
+		}
+	}
+}
+
+func (tt *txTracker) processLoop(ctx context.Context) {
+	ticker := time.NewTicker(tt.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing tx tracker")
+			return
+		case <-ticker.C:
+			tt.checkPendingTxs(ctx)
+		}
+	}
+}
+
+func (tt *txTracker) checkPendingTxs(ctx context.Context) {
+	for _, tx := range tt.getPendingTxs() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		tt.checkTx(ctx, tx)
+	}
+}
+
+func (tt *txTracker) getPendingTxs() []*trackedTx {
+	tt.mut.RLock()
+	defer tt.mut.RUnlock()
+
+	pendingTxs := make([]*trackedTx, 0, len(tt.pending))
+	for _, tx := range tt.pending {
+		pendingTxs = append(pendingTxs, tx)
+	}
+
+	return pendingTxs
+}
+
+func (tt *txTracker) checkTx(ctx context.Context, tx *trackedTx) {
+	status, err := tt.proxy.ProcessTransactionStatus(ctx, tx.txHash)
+	if err != nil {
+		log.Debug("could not get tx status", "tx hash", tx.txHash, "error", err)
+		return
+	}
+	if status == transaction.TxStatusPending {
+		return
+	}
+
+	txInfo, err := tt.proxy.GetTransactionInfoWithResults(ctx, tx.txHash)
+	if err != nil {
+		log.Debug("could not get tx info", "tx hash", tx.txHash, "error", err)
+		return
+	}
+	if !tt.isPending(tx.txHash) {
+		// the outcome of a tx sent with the same nonce is already known
+		return
+	}
+
+	txResult := results.DecodeTxResult(tx.txHash, status, &txInfo.Data.Transaction)
+	journalState := journal.TxConfirmed
+	if txResult.Failed() {
+		journalState = journal.TxFailed
+		log.Error("bridge tx failed",
+			"bridge op hash", tx.bridgeDataHash,
+			"tx hash", tx.txHash,
+			"status", txResult.Status,
+			"error", txResult.Error,
+		)
+	} else {
+		log.Info("bridge tx executed", "bridge op hash", tx.bridgeDataHash, "tx hash", tx.txHash)
+	}
+
+	err = tt.journal.SetTxState(tx.bridgeDataHash, tx.txHash, journalState)
+	if err != nil {
+		log.Error("could not update tx state in journal", "tx hash", tx.txHash, "error", err)
+	}
+
+	tt.mut.Lock()
+	for _, txHash := range tx.sameNonce.hashes {
+		delete(tt.pending, txHash)
+	}
+	opResult := tt.getOrCreateOperationResult(tx.bridgeDataHash)
+	opResult.ReplaceTxResult(tx.sameNonce.latest(), txResult)
+	tt.setFinalIfDone(opResult)
+	handlers := tt.handlers
+	tt.mut.Unlock()
+
+	for _, handler := range handlers {
+		handler(tx.bridgeDataHash, tx.txHash, journalState)
+	}
+}
+
+func (tt *txTracker) isPending(txHash string) bool {
+	tt.mut.RLock()
+	defer tt.mut.RUnlock()
+
+	_, found := tt.pending[txHash]
+	return found
+}
+
+// GetOperationResult returns a copy of the tracked txs outcome for the bridge outgoing data with the provided hash. Only
+// the latest maxFinalResults final outcomes are kept.
+func (tt *txTracker) GetOperationResult(bridgeDataHash []byte) (*results.OperationResult, bool) {
+	tt.mut.RLock()
+	defer tt.mut.RUnlock()
+
+	opResult, found := tt.opResults[hex.EncodeToString(bridgeDataHash)]
+	if !found {
+		return nil, false
+	}
+
+	return opResult.Clone(), true
+}
+
+// Close stops tracking txs
+func (tt *txTracker) Close() error {
+	tt.cancelFunc()
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (tt *txTracker) IsInterfaceNil() bool {
+	return tt == nil
+}
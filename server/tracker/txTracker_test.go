@@ -0,0 +1,393 @@
+package tracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+const pollInterval = minPollInterval
+
+func createArgs() ArgsTxTracker {
+	return ArgsTxTracker{
+		Proxy:        &testscommon.ProxyMock{},
+		Journal:      &testscommon.JournalMock{},
+		PollInterval: pollInterval,
+	}
+}
+
+func createTxInfo(tx data.TransactionOnNetwork) *data.TransactionInfo {
+	txInfo := &data.TransactionInfo{}
+	txInfo.Data.Transaction = tx
+	return txInfo
+}
+
+func TestNewTxTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy", func(t *testing.T) {
+		args := createArgs()
+		args.Proxy = nil
+
+		tt, err := NewTxTracker(args)
+		require.Equal(t, errNilProxy, err)
+		require.Nil(t, tt)
+	})
+	t.Run("nil journal", func(t *testing.T) {
+		args := createArgs()
+		args.Journal = nil
+
+		tt, err := NewTxTracker(args)
+		require.Equal(t, errNilJournal, err)
+		require.Nil(t, tt)
+	})
+	t.Run("invalid poll interval", func(t *testing.T) {
+		args := createArgs()
+		args.PollInterval = time.Millisecond
+
+		tt, err := NewTxTracker(args)
+		require.Equal(t, errInvalidPollInterval, err)
+		require.Nil(t, tt)
+	})
+	t.Run("should work", func(t *testing.T) {
+		tt, err := NewTxTracker(createArgs())
+		require.Nil(t, err)
+		require.False(t, tt.IsInterfaceNil())
+		require.Nil(t, tt.Close())
+	})
+}
+
+func TestTxTracker_TrackUntilFinal(t *testing.T) {
+	t.Parallel()
+
+	bridgeDataHash := []byte("bridgeDataHash")
+	successTxHash := "successTxHash"
+	failedTxHash := "failedTxHash"
+
+	mut := sync.Mutex{}
+	finalized := false
+
+	args := createArgs()
+	args.Proxy = &testscommon.ProxyMock{
+		ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+			mut.Lock()
+			defer mut.Unlock()
+
+			if !finalized {
+				return transaction.TxStatusPending, nil
+			}
+			if hexTxHash == successTxHash {
+				return transaction.TxStatusSuccess, nil
+			}
+
+			return transaction.TxStatusFail, nil
+		},
+		GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+			if hash == successTxHash {
+				return createTxInfo(data.TransactionOnNetwork{}), nil
+			}
+
+			return createTxInfo(data.TransactionOnNetwork{
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						{
+							Identifier: "signalError",
+							Topics:     [][]byte{[]byte("address"), []byte("operation already executed")},
+						},
+					},
+				},
+			}), nil
+		},
+	}
+
+	journalStates := make(map[string]journal.TxState)
+	args.Journal = &testscommon.JournalMock{
+		SetTxStateCalled: func(hash []byte, txHash string, state journal.TxState) error {
+			mut.Lock()
+			defer mut.Unlock()
+
+			require.Equal(t, bridgeDataHash, hash)
+			journalStates[txHash] = state
+			return nil
+		},
+	}
+
+	tt, _ := NewTxTracker(args)
+	defer func() {
+		_ = tt.Close()
+	}()
+
+	notifiedStates := make(map[string]journal.TxState)
+	tt.RegisterHandler(nil)
+	tt.RegisterHandler(func(hash []byte, txHash string, state journal.TxState) {
+		mut.Lock()
+		defer mut.Unlock()
+
+		require.Equal(t, bridgeDataHash, hash)
+		notifiedStates[txHash] = state
+	})
+
+	tt.Track(bridgeDataHash, successTxHash)
+	tt.Track(bridgeDataHash, failedTxHash)
+
+	opResult, found := tt.GetOperationResult(bridgeDataHash)
+	require.True(t, found)
+	require.Len(t, opResult.Txs, 2)
+	require.False(t, opResult.Txs[0].IsFinal())
+	require.False(t, opResult.Txs[1].IsFinal())
+
+	mut.Lock()
+	finalized = true
+	mut.Unlock()
+
+	require.Eventually(t, func() bool {
+		mut.Lock()
+		defer mut.Unlock()
+
+		return len(journalStates) == 2
+	}, time.Second, pollInterval)
+
+	require.Equal(t, map[string]journal.TxState{
+		successTxHash: journal.TxConfirmed,
+		failedTxHash:  journal.TxFailed,
+	}, journalStates)
+	require.Eventually(t, func() bool {
+		mut.Lock()
+		defer mut.Unlock()
+
+		return len(notifiedStates) == 2
+	}, time.Second, pollInterval)
+	mut.Lock()
+	require.Equal(t, journalStates, notifiedStates)
+	mut.Unlock()
+
+	opResult, _ = tt.GetOperationResult(bridgeDataHash)
+	require.Equal(t, &results.TxResult{
+		TxHash:    successTxHash,
+		Status:    string(transaction.TxStatusSuccess),
+		SCResults: make([]*results.SCResult, 0),
+	}, opResult.Txs[0])
+	require.True(t, opResult.Txs[1].Failed())
+	require.Equal(t, "operation already executed", opResult.Txs[1].Error)
+}
+
+func TestTxTracker_TrackUnconfirmedFromJournal(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.Journal = &testscommon.JournalMock{
+		UnconfirmedCalled: func() []*journal.Entry {
+			return []*journal.Entry{
+				{
+					Hash: []byte("bridgeDataHash"),
+					Txs: []*journal.TxRecord{
+						{Hash: "txHash1", State: journal.TxConfirmed},
+						{Hash: "txHash2", State: journal.TxBroadcast},
+					},
+				},
+			}
+		},
+	}
+	args.Proxy = &testscommon.ProxyMock{
+		ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+			return "", errors.New("proxy error")
+		},
+	}
+
+	tt, _ := NewTxTracker(args)
+	defer func() {
+		_ = tt.Close()
+	}()
+
+	opResult, found := tt.GetOperationResult([]byte("bridgeDataHash"))
+	require.True(t, found)
+	require.Equal(t, []*results.TxResult{{TxHash: "txHash2", Status: string(transaction.TxStatusPending)}}, opResult.Txs)
+
+	_, found = tt.GetOperationResult([]byte("unknownHash"))
+	require.False(t, found)
+}
+
+func TestTxTracker_ReplaceStuckTx(t *testing.T) {
+	t.Parallel()
+
+	bridgeDataHash := []byte("bridgeDataHash")
+
+	args := createArgs()
+	args.Proxy = &testscommon.ProxyMock{
+		ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+			return transaction.TxStatusPending, nil
+		},
+	}
+
+	tt, _ := NewTxTracker(args)
+	defer func() {
+		_ = tt.Close()
+	}()
+
+	tt.Track(bridgeDataHash, "txHash1")
+	tt.Track(bridgeDataHash, "txHash2")
+	require.Empty(t, tt.GetStuckTxs(time.Hour))
+
+	stuckTxs := tt.GetStuckTxs(0)
+	require.Len(t, stuckTxs, 2)
+	require.ElementsMatch(t, []*results.PendingTx{
+		{BridgeDataHash: bridgeDataHash, TxHash: "txHash1"},
+		{BridgeDataHash: bridgeDataHash, TxHash: "txHash2"},
+	}, stuckTxs)
+
+	tt.Replace(bridgeDataHash, "txHash1", "txHash3")
+	require.ElementsMatch(t, []*results.PendingTx{
+		{BridgeDataHash: bridgeDataHash, TxHash: "txHash2"},
+		{BridgeDataHash: bridgeDataHash, TxHash: "txHash3"},
+	}, tt.GetStuckTxs(0))
+
+	opResult, _ := tt.GetOperationResult(bridgeDataHash)
+	require.Equal(t, []*results.TxResult{
+		{TxHash: "txHash3", Status: string(transaction.TxStatusPending)},
+		{TxHash: "txHash2", Status: string(transaction.TxStatusPending)},
+	}, opResult.Txs)
+}
+
+func TestTxTracker_ReplacedTxExecutedFirst(t *testing.T) {
+	t.Parallel()
+
+	bridgeDataHash := []byte("bridgeDataHash")
+
+	mut := sync.Mutex{}
+	executed := false
+	args := createArgs()
+	args.Proxy = &testscommon.ProxyMock{
+		ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+			mut.Lock()
+			defer mut.Unlock()
+
+			if executed && hexTxHash == "txHash1" {
+				return transaction.TxStatusSuccess, nil
+			}
+
+			return transaction.TxStatusPending, nil
+		},
+		GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+			return createTxInfo(data.TransactionOnNetwork{}), nil
+		},
+	}
+	journalStates := make(map[string]journal.TxState)
+	args.Journal = &testscommon.JournalMock{
+		UnconfirmedCalled: func() []*journal.Entry {
+			return []*journal.Entry{
+				{
+					Hash: bridgeDataHash,
+					Txs: []*journal.TxRecord{
+						{Hash: "txHash2", State: journal.TxBroadcast, ReplacedHashes: []string{"txHash1"}},
+					},
+				},
+			}
+		},
+		SetTxStateCalled: func(hash []byte, txHash string, state journal.TxState) error {
+			mut.Lock()
+			defer mut.Unlock()
+
+			journalStates[txHash] = state
+			return nil
+		},
+	}
+
+	tt, _ := NewTxTracker(args)
+	defer func() {
+		_ = tt.Close()
+	}()
+
+	// the replaced tx is still tracked, but only the latest tx is reported as stuck
+	require.Len(t, tt.getPendingTxs(), 2)
+	require.Equal(t, []*results.PendingTx{{BridgeDataHash: bridgeDataHash, TxHash: "txHash2"}}, tt.GetStuckTxs(0))
+
+	tt.Replace(bridgeDataHash, "txHash2", "txHash3")
+	require.Len(t, tt.getPendingTxs(), 3)
+	require.Equal(t, []*results.PendingTx{{BridgeDataHash: bridgeDataHash, TxHash: "txHash3"}}, tt.GetStuckTxs(0))
+
+	mut.Lock()
+	executed = true
+	mut.Unlock()
+
+	require.Eventually(t, func() bool {
+		return len(tt.getPendingTxs()) == 0
+	}, time.Second, pollInterval)
+
+	mut.Lock()
+	require.Equal(t, map[string]journal.TxState{"txHash1": journal.TxConfirmed}, journalStates)
+	mut.Unlock()
+
+	opResult, _ := tt.GetOperationResult(bridgeDataHash)
+	require.Len(t, opResult.Txs, 1)
+	require.Equal(t, "txHash1", opResult.Txs[0].TxHash)
+	require.Equal(t, string(transaction.TxStatusSuccess), opResult.Txs[0].Status)
+}
+
+func TestTxTracker_SetCancelled(t *testing.T) {
+	t.Parallel()
+
+	bridgeDataHash := []byte("bridgeDataHash")
+
+	args := createArgs()
+	args.Proxy = &testscommon.ProxyMock{
+		ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+			return transaction.TxStatusPending, nil
+		},
+	}
+
+	tt, _ := NewTxTracker(args)
+	defer func() {
+		_ = tt.Close()
+	}()
+
+	tt.Track(bridgeDataHash, "txHash1")
+	tt.SetCancelled(bridgeDataHash, "dependency failed")
+	tt.SetCancelled(bridgeDataHash, "dependency failed")
+	require.Len(t, tt.GetStuckTxs(0), 1)
+
+	opResult, _ := tt.GetOperationResult(bridgeDataHash)
+	require.Equal(t, []*results.TxResult{
+		{TxHash: "txHash1", Status: string(transaction.TxStatusPending)},
+		{Status: string(transaction.TxStatusFail), Error: "dependency failed"},
+		{Status: string(transaction.TxStatusFail), Error: "dependency failed"},
+	}, opResult.Txs)
+}
+
+func TestTxTracker_PruneFinalResults(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.Proxy = &testscommon.ProxyMock{
+		ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+			return transaction.TxStatusPending, nil
+		},
+	}
+
+	tt, _ := NewTxTracker(args)
+	defer func() {
+		_ = tt.Close()
+	}()
+
+	tt.Track([]byte("pendingHash"), "txHash")
+	for idx := 0; idx <= maxFinalResults; idx++ {
+		tt.SetCancelled([]byte(fmt.Sprintf("hash%d", idx)), "dependency failed")
+	}
+
+	_, found := tt.GetOperationResult([]byte("hash0"))
+	require.False(t, found)
+	_, found = tt.GetOperationResult([]byte(fmt.Sprintf("hash%d", maxFinalResults)))
+	require.True(t, found)
+	_, found = tt.GetOperationResult([]byte("pendingHash"))
+	require.True(t, found)
+}
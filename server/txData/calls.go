@@ -0,0 +1,179 @@
+package txData
+
+import "fmt"
+
+// RegisterBridgeOpsEndpoint is the header verifier contract endpoint which registers confirmed bridge operations
+const RegisterBridgeOpsEndpoint = "registerBridgeOps"
+
+// ChangeValidatorSetEndpoint is the header verifier contract endpoint which changes the validator set
+const ChangeValidatorSetEndpoint = "changeValidatorSet"
+
+// Endpoints which execute one registered bridge operation
+const (
+	ExecuteBridgeOpsEndpoint    = "executeBridgeOps"
+	RegisterTokenEndpoint       = "registerToken"
+	RegisterValidatorEndpoint   = "registerValidator"
+	UnRegisterValidatorEndpoint = "unRegisterValidator"
+)
+
+// RegisterBridgeOpsCall holds the arguments of a registerBridgeOps call:
+//
+// registerBridgeOps@AggregatedSignature@HashOfHashes@PubKeysBitmap@Epoch@list<OperationHashes>
+type RegisterBridgeOpsCall struct {
+	AggregatedSignature []byte
+	HashOfHashes        []byte
+	PubKeysBitmap       []byte
+	Epoch               uint32
+	OperationHashes     [][]byte
+}
+
+// Encode returns the tx data of the call
+func (call *RegisterBridgeOpsCall) Encode() []byte {
+	return NewEncoder(RegisterBridgeOpsEndpoint).
+		Bytes(call.AggregatedSignature).
+		Bytes(call.HashOfHashes).
+		Bytes(call.PubKeysBitmap).
+		Uint32(call.Epoch).
+		BytesList(call.OperationHashes).
+		Build()
+}
+
+// ExecuteOperationCall holds the arguments of a call which executes one bridge operation, such as executeBridgeOps:
+//
+// Endpoint@HashOfHashes@OperationData
+type ExecuteOperationCall struct {
+	Endpoint      string
+	HashOfHashes  []byte
+	OperationData []byte
+}
+
+// Encode returns the tx data of the call
+func (call *ExecuteOperationCall) Encode() []byte {
+	return NewEncoder(call.Endpoint).
+		Bytes(call.HashOfHashes).
+		Bytes(call.OperationData).
+		Build()
+}
+
+// ChangeValidatorSetCall holds the arguments of a changeValidatorSet call:
+//
+// changeValidatorSet@AggregatedSignature@HashOfHashes@HashOfOperation@PubKeysBitmap@Epoch@list<PubKeyIDs>
+type ChangeValidatorSetCall struct {
+	AggregatedSignature []byte
+	HashOfHashes        []byte
+	HashOfOperation     []byte
+	PubKeysBitmap       []byte
+	Epoch               uint32
+	PubKeyIDs           [][]byte
+}
+
+// Encode returns the tx data of the call
+func (call *ChangeValidatorSetCall) Encode() []byte {
+	return NewEncoder(ChangeValidatorSetEndpoint).
+		Bytes(call.AggregatedSignature).
+		Bytes(call.HashOfHashes).
+		Bytes(call.HashOfOperation).
+		Bytes(call.PubKeysBitmap).
+		Uint32(call.Epoch).
+		BytesList(call.PubKeyIDs).
+		Build()
+}
+
+// DecodeRegisterBridgeOps decodes the tx data of a registerBridgeOps call
+func DecodeRegisterBridgeOps(txData []byte) (*RegisterBridgeOpsCall, error) {
+	decoder, err := newEndpointDecoder(txData, RegisterBridgeOpsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	call := &RegisterBridgeOpsCall{
+		AggregatedSignature: decoder.Bytes("aggregated signature"),
+		HashOfHashes:        decoder.Bytes("hash of hashes"),
+		PubKeysBitmap:       decoder.Bytes("pub keys bitmap"),
+		Epoch:               decoder.Uint32("epoch"),
+		OperationHashes:     decoder.BytesList("operation hash"),
+	}
+
+	err = decoder.Finish()
+	if err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// DecodeExecuteOperation decodes the tx data of a call which executes one bridge operation. Any endpoint is accepted,
+// since formatters registered for new bridge data types can execute operations on their own endpoints.
+func DecodeExecuteOperation(txData []byte) (*ExecuteOperationCall, error) {
+	decoder, err := NewDecoder(txData)
+	if err != nil {
+		return nil, err
+	}
+
+	call := &ExecuteOperationCall{
+		Endpoint:      decoder.Endpoint(),
+		HashOfHashes:  decoder.Bytes("hash of hashes"),
+		OperationData: decoder.Bytes("operation data"),
+	}
+
+	err = decoder.Finish()
+	if err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// DecodeChangeValidatorSet decodes the tx data of a changeValidatorSet call
+func DecodeChangeValidatorSet(txData []byte) (*ChangeValidatorSetCall, error) {
+	decoder, err := newEndpointDecoder(txData, ChangeValidatorSetEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	call := &ChangeValidatorSetCall{
+		AggregatedSignature: decoder.Bytes("aggregated signature"),
+		HashOfHashes:        decoder.Bytes("hash of hashes"),
+		HashOfOperation:     decoder.Bytes("hash of operation"),
+		PubKeysBitmap:       decoder.Bytes("pub keys bitmap"),
+		Epoch:               decoder.Uint32("epoch"),
+		PubKeyIDs:           decoder.BytesList("pub key id"),
+	}
+
+	err = decoder.Finish()
+	if err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// Decode decodes the tx data of any of the bridge contracts calls built by the data formatters. It returns a
+// *RegisterBridgeOpsCall, a *ChangeValidatorSetCall or, for any other endpoint, an *ExecuteOperationCall.
+func Decode(txData []byte) (interface{}, error) {
+	decoder, err := NewDecoder(txData)
+	if err != nil {
+		return nil, err
+	}
+
+	switch decoder.Endpoint() {
+	case RegisterBridgeOpsEndpoint:
+		return DecodeRegisterBridgeOps(txData)
+	case ChangeValidatorSetEndpoint:
+		return DecodeChangeValidatorSet(txData)
+	default:
+		return DecodeExecuteOperation(txData)
+	}
+}
+
+func newEndpointDecoder(txData []byte, endpoint string) (*Decoder, error) {
+	decoder, err := NewDecoder(txData)
+	if err != nil {
+		return nil, err
+	}
+	if decoder.Endpoint() != endpoint {
+		return nil, fmt.Errorf("%w, expected %s, got %s", errInvalidEndpoint, endpoint, decoder.Endpoint())
+	}
+
+	return decoder, nil
+}
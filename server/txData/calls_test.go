@@ -0,0 +1,173 @@
+package txData
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterBridgeOpsCall_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	call := &RegisterBridgeOpsCall{
+		AggregatedSignature: []byte("aggregatedSig"),
+		HashOfHashes:        []byte("hashOfHashes"),
+		PubKeysBitmap:       []byte{0b0111},
+		Epoch:               4,
+		OperationHashes:     [][]byte{[]byte("opHash1"), []byte("opHash2")},
+	}
+
+	txData := call.Encode()
+	expectedTxData := RegisterBridgeOpsEndpoint +
+		"@" + hex.EncodeToString([]byte("aggregatedSig")) +
+		"@" + hex.EncodeToString([]byte("hashOfHashes")) +
+		"@07" +
+		"@00000004" +
+		"@" + hex.EncodeToString([]byte("opHash1")) +
+		"@" + hex.EncodeToString([]byte("opHash2"))
+	require.Equal(t, expectedTxData, string(txData))
+
+	decodedCall, err := DecodeRegisterBridgeOps(txData)
+	require.Nil(t, err)
+	require.Equal(t, call, decodedCall)
+
+	decoded, err := Decode(txData)
+	require.Nil(t, err)
+	require.Equal(t, call, decoded)
+}
+
+func TestExecuteOperationCall_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	call := &ExecuteOperationCall{
+		Endpoint:      RegisterTokenEndpoint,
+		HashOfHashes:  []byte("hashOfHashes"),
+		OperationData: []byte("operationData"),
+	}
+
+	txData := call.Encode()
+	expectedTxData := RegisterTokenEndpoint +
+		"@" + hex.EncodeToString([]byte("hashOfHashes")) +
+		"@" + hex.EncodeToString([]byte("operationData"))
+	require.Equal(t, expectedTxData, string(txData))
+
+	decodedCall, err := DecodeExecuteOperation(txData)
+	require.Nil(t, err)
+	require.Equal(t, call, decodedCall)
+
+	decoded, err := Decode(txData)
+	require.Nil(t, err)
+	require.Equal(t, call, decoded)
+}
+
+func TestChangeValidatorSetCall_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with pub keys", func(t *testing.T) {
+		call := &ChangeValidatorSetCall{
+			AggregatedSignature: []byte("aggregatedSig"),
+			HashOfHashes:        []byte("hashOfHashes"),
+			HashOfOperation:     []byte("operationHash"),
+			PubKeysBitmap:       []byte{0b1111},
+			Epoch:               0x01020304,
+			PubKeyIDs:           [][]byte{[]byte("pk1"), []byte("pk2")},
+		}
+
+		txData := call.Encode()
+		expectedTxData := ChangeValidatorSetEndpoint +
+			"@" + hex.EncodeToString([]byte("aggregatedSig")) +
+			"@" + hex.EncodeToString([]byte("hashOfHashes")) +
+			"@" + hex.EncodeToString([]byte("operationHash")) +
+			"@0f" +
+			"@01020304" +
+			"@" + hex.EncodeToString([]byte("pk1")) +
+			"@" + hex.EncodeToString([]byte("pk2"))
+		require.Equal(t, expectedTxData, string(txData))
+
+		decoded, err := Decode(txData)
+		require.Nil(t, err)
+		require.Equal(t, call, decoded)
+	})
+	t.Run("empty arguments", func(t *testing.T) {
+		call := &ChangeValidatorSetCall{
+			AggregatedSignature: []byte{},
+			HashOfHashes:        []byte{},
+			HashOfOperation:     []byte{},
+			PubKeysBitmap:       []byte{},
+			PubKeyIDs:           [][]byte{},
+		}
+
+		txData := call.Encode()
+		require.Equal(t, ChangeValidatorSetEndpoint+"@@@@@00000000", string(txData))
+
+		decodedCall, err := DecodeChangeValidatorSet(txData)
+		require.Nil(t, err)
+		require.Equal(t, call, decodedCall)
+	})
+}
+
+func TestDecode_ErrorCases(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty tx data", func(t *testing.T) {
+		decoded, err := Decode(nil)
+		require.Equal(t, errEmptyTxData, err)
+		require.Nil(t, decoded)
+	})
+	t.Run("empty endpoint", func(t *testing.T) {
+		decoded, err := Decode([]byte("@01"))
+		require.Equal(t, errInvalidEndpoint, err)
+		require.Nil(t, decoded)
+	})
+	t.Run("other endpoint", func(t *testing.T) {
+		call, err := DecodeRegisterBridgeOps([]byte(ChangeValidatorSetEndpoint + "@01"))
+		require.ErrorIs(t, err, errInvalidEndpoint)
+		require.Nil(t, call)
+
+		_, err = DecodeChangeValidatorSet([]byte(RegisterBridgeOpsEndpoint + "@01"))
+		require.ErrorIs(t, err, errInvalidEndpoint)
+	})
+	t.Run("invalid hex argument", func(t *testing.T) {
+		decoded, err := Decode([]byte(ExecuteBridgeOpsEndpoint + "@01@zz"))
+		require.ErrorIs(t, err, errInvalidArgument)
+		require.ErrorContains(t, err, "operation data")
+		require.Nil(t, decoded)
+	})
+	t.Run("missing argument", func(t *testing.T) {
+		decoded, err := Decode([]byte(RegisterBridgeOpsEndpoint + "@01@02@03"))
+		require.ErrorIs(t, err, errMissingArgument)
+		require.ErrorContains(t, err, "epoch")
+		require.Nil(t, decoded)
+	})
+	t.Run("too many arguments", func(t *testing.T) {
+		decoded, err := Decode([]byte(ExecuteBridgeOpsEndpoint + "@01@02@03"))
+		require.ErrorIs(t, err, errUnexpectedArguments)
+		require.Nil(t, decoded)
+	})
+	t.Run("invalid epoch", func(t *testing.T) {
+		decoded, err := Decode([]byte(ChangeValidatorSetEndpoint + "@01@02@03@04@0102030405"))
+		require.ErrorIs(t, err, errInvalidArgument)
+		require.ErrorContains(t, err, "epoch")
+		require.Nil(t, decoded)
+	})
+	t.Run("invalid operation hash", func(t *testing.T) {
+		decoded, err := Decode([]byte(RegisterBridgeOpsEndpoint + "@01@02@03@04@05@x"))
+		require.ErrorIs(t, err, errInvalidArgument)
+		require.ErrorContains(t, err, "operation hash")
+		require.Nil(t, decoded)
+	})
+}
+
+func TestDecoder_Uint32(t *testing.T) {
+	t.Parallel()
+
+	decoder, err := NewDecoder([]byte("endpoint@@05@0100@00000007"))
+	require.Nil(t, err)
+	require.Equal(t, "endpoint", decoder.Endpoint())
+	require.Equal(t, uint32(0), decoder.Uint32("empty"))
+	require.Equal(t, uint32(5), decoder.Uint32("one byte"))
+	require.Equal(t, uint32(256), decoder.Uint32("two bytes"))
+	require.Equal(t, uint32(7), decoder.Uint32("four bytes"))
+	require.Nil(t, decoder.Finish())
+}
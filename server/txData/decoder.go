@@ -0,0 +1,104 @@
+package txData
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Decoder parses the arguments of tx data encoded by the Encoder, in the order they were encoded. The first error is
+// kept and returned by Finish, after which all arguments are returned empty.
+type Decoder struct {
+	endpoint string
+	args     []string
+	index    int
+	err      error
+}
+
+// NewDecoder creates a tx data decoder. The endpoint is split from the arguments, which are decoded when read.
+func NewDecoder(txData []byte) (*Decoder, error) {
+	if len(txData) == 0 {
+		return nil, errEmptyTxData
+	}
+
+	tokens := strings.Split(string(txData), argsSeparator)
+	if len(tokens[0]) == 0 {
+		return nil, errInvalidEndpoint
+	}
+
+	return &Decoder{
+		endpoint: tokens[0],
+		args:     tokens[1:],
+	}, nil
+}
+
+// Endpoint returns the called endpoint
+func (d *Decoder) Endpoint() string {
+	return d.endpoint
+}
+
+// Bytes reads the next argument as bytes
+func (d *Decoder) Bytes(name string) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if d.index >= len(d.args) {
+		d.err = fmt.Errorf("%w, endpoint = %s, argument = %s", errMissingArgument, d.endpoint, name)
+		return nil
+	}
+
+	arg, err := hex.DecodeString(d.args[d.index])
+	if err != nil {
+		d.err = fmt.Errorf("%w, endpoint = %s, argument = %s: %v", errInvalidArgument, d.endpoint, name, err)
+		return nil
+	}
+
+	d.index++
+	return arg
+}
+
+// Uint32 reads the next argument as a big endian uint32, of at most 4 bytes
+func (d *Decoder) Uint32(name string) uint32 {
+	arg := d.Bytes(name)
+	if d.err != nil {
+		return 0
+	}
+	if len(arg) > uint32Size {
+		d.err = fmt.Errorf("%w, endpoint = %s, argument = %s: expected at most %d bytes, got %d",
+			errInvalidArgument, d.endpoint, name, uint32Size, len(arg))
+		return 0
+	}
+
+	value := uint32(0)
+	for _, b := range arg {
+		value = value<<8 | uint32(b)
+	}
+
+	return value
+}
+
+// BytesList reads all the remaining arguments as bytes
+func (d *Decoder) BytesList(name string) [][]byte {
+	args := make([][]byte, 0)
+	for d.err == nil && d.index < len(d.args) {
+		args = append(args, d.Bytes(name))
+	}
+	if d.err != nil {
+		return nil
+	}
+
+	return args
+}
+
+// Finish returns the first decoding error, or an error if not all arguments were read
+func (d *Decoder) Finish() error {
+	if d.err != nil {
+		return d.err
+	}
+	if d.index != len(d.args) {
+		return fmt.Errorf("%w, endpoint = %s, expected %d arguments, got %d",
+			errUnexpectedArguments, d.endpoint, d.index, len(d.args))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package txData
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+)
+
+const argsSeparator = "@"
+
+const uint32Size = 4
+
+// Encoder builds the tx data of an endpoint call, as endpoint@hex(arg1)@hex(arg2)...
+type Encoder struct {
+	txData []byte
+}
+
+// NewEncoder creates a tx data encoder for the provided endpoint
+func NewEncoder(endpoint string) *Encoder {
+	return &Encoder{
+		txData: []byte(endpoint),
+	}
+}
+
+// Bytes appends a bytes argument
+func (e *Encoder) Bytes(arg []byte) *Encoder {
+	e.txData = append(e.txData, argsSeparator+hex.EncodeToString(arg)...)
+	return e
+}
+
+// Uint32 appends a uint32 argument, encoded on 4 big endian bytes
+func (e *Encoder) Uint32(arg uint32) *Encoder {
+	buff := make([]byte, uint32Size)
+	binary.BigEndian.PutUint32(buff, arg)
+
+	return e.Bytes(buff)
+}
+
+// BytesList appends each of the provided bytes as a separate argument, as expected by variadic endpoint arguments
+func (e *Encoder) BytesList(args [][]byte) *Encoder {
+	for _, arg := range args {
+		e.Bytes(arg)
+	}
+
+	return e
+}
+
+// Build returns the encoded tx data
+func (e *Encoder) Build() []byte {
+	return e.txData
+}
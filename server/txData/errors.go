@@ -0,0 +1,13 @@
+package txData
+
+import "errors"
+
+var errEmptyTxData = errors.New("empty tx data")
+
+var errInvalidEndpoint = errors.New("invalid endpoint in tx data")
+
+var errInvalidArgument = errors.New("invalid argument in tx data")
+
+var errMissingArgument = errors.New("missing argument in tx data")
+
+var errUnexpectedArguments = errors.New("unexpected arguments in tx data")
@@ -8,6 +8,7 @@ type WalletConfig struct {
 
 // TxSenderConfig holds tx sender config
 type TxSenderConfig struct {
+	RoutingTableFile          string
 	HeaderVerifierSCAddress   string
 	EsdtSafeSCAddress         string
 	ChangeValidatorsSCAddress string
@@ -15,4 +16,17 @@ type TxSenderConfig struct {
 	Proxy                     string
 	IntervalToSend            int
 	Hasher                    string
+	JournalDir                string
+	StatusPollInterval        int
+	GasEstimationMultiplier   float64
+	MaxRetryAttempts          int
+	RetryBackoff              int
+	GasPriceBumpPercentage    uint64
+	MaxGasPrice               uint64
+	StuckTxTimeout            int
+	MaxBatchSize              int
+	JournalRetention          int
+	JournalHistorySize        int
+	DryRun                    bool
+	TypePriorities            []TypePriorityConfig
 }
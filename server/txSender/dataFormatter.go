@@ -1,6 +1,8 @@
 package txSender
 
 import (
+	"fmt"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/block"
@@ -8,18 +10,28 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 )
 
+// DataFormatterRegistration holds the formatter of a bridge outgoing data type, which is not formatted by default, and
+// the endpoints called by the txs it creates. Endpoints already routed, such as registerBridgeOps, should not be
+// provided again.
+type DataFormatterRegistration struct {
+	Type      int32
+	Formatter TxDataFormatter
+	Endpoints []EndpointConfig
+}
+
 type dataFormatter struct {
-	dataFormatterHandlers map[int32]txDataFormatter
+	dataFormatterHandlers map[int32]TxDataFormatter
 }
 
-// NewDataFormatter creates a sovereign bridge tx data formatter
-func NewDataFormatter(hasher hashing.Hasher) (*dataFormatter, error) {
+// NewDataFormatter creates a sovereign bridge tx data formatter. Besides the default bridge outgoing data types, the
+// registered ones are formatted by their own formatters. Registering an already formatted type is an error.
+func NewDataFormatter(hasher hashing.Hasher, registrations ...DataFormatterRegistration) (*dataFormatter, error) {
 	if check.IfNil(hasher) {
 		return nil, core.ErrNilHasher
 	}
 
-	return &dataFormatter{
-		dataFormatterHandlers: map[int32]txDataFormatter{
+	df := &dataFormatter{
+		dataFormatterHandlers: map[int32]TxDataFormatter{
 			int32(block.OutGoingMbChangeValidatorSet): &dataFormatterValidatorSetChange{},
 			int32(block.OutGoingMbDeposit): &dataFormatterExecuteOperation{
 				hasher:          hasher,
@@ -38,7 +50,30 @@ func NewDataFormatter(hasher hashing.Hasher) (*dataFormatter, error) {
 				executeOpPrefix: executeUnRegisterValidatorPrefix,
 			},
 		},
-	}, nil
+	}
+
+	for _, registration := range registrations {
+		err := df.register(registration)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return df, nil
+}
+
+func (df *dataFormatter) register(registration DataFormatterRegistration) error {
+	if check.IfNilReflect(registration.Formatter) {
+		return fmt.Errorf("%w, type = %d", errNilTxDataFormatter, registration.Type)
+	}
+	if _, exists := df.dataFormatterHandlers[registration.Type]; exists {
+		return fmt.Errorf("%w, type = %d", errDuplicatedBridgeDataType, registration.Type)
+	}
+
+	df.dataFormatterHandlers[registration.Type] = registration.Formatter
+	log.Debug("registered data formatter", "type", registration.Type, "endpoints", len(registration.Endpoints))
+
+	return nil
 }
 
 // CreateTxsData creates txs data for bridge operations
@@ -49,19 +84,7 @@ func (df *dataFormatter) CreateTxsData(data *sovereign.BridgeOperations) [][]byt
 	}
 
 	for _, bridgeData := range data.Data {
-		log.Debug("creating tx data",
-			"type", block.OutGoingMBType(bridgeData.Type).String(),
-			"bridge op hash", bridgeData.Hash,
-			"no. of operations", len(bridgeData.OutGoingOperations),
-		)
-
-		handler, found := df.dataFormatterHandlers[bridgeData.Type]
-		if !found {
-			log.Error("received unknown bridge data", "type", bridgeData.Type)
-			continue
-		}
-
-		newTxsData, err := handler.createTxsData(bridgeData)
+		newTxsData, err := df.CreateBridgeDataTxsData(bridgeData)
 		if err != nil {
 			log.Error("could not create txs data",
 				"error", err,
@@ -76,6 +99,22 @@ func (df *dataFormatter) CreateTxsData(data *sovereign.BridgeOperations) [][]byt
 	return txsData
 }
 
+// CreateBridgeDataTxsData creates txs data for a single bridge outgoing data
+func (df *dataFormatter) CreateBridgeDataTxsData(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+	log.Debug("creating tx data",
+		"type", block.OutGoingMBType(bridgeData.Type).String(),
+		"bridge op hash", bridgeData.Hash,
+		"no. of operations", len(bridgeData.OutGoingOperations),
+	)
+
+	handler, found := df.dataFormatterHandlers[bridgeData.Type]
+	if !found {
+		return nil, fmt.Errorf("%w, type = %d", errUnknownBridgeDataType, bridgeData.Type)
+	}
+
+	return handler.CreateTxsData(bridgeData)
+}
+
 // IsInterfaceNil checks if the underlying pointer is nil
 func (df *dataFormatter) IsInterfaceNil() bool {
 	return df == nil
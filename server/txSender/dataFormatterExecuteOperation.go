@@ -2,20 +2,22 @@ package txSender
 
 import (
 	"bytes"
-	"encoding/binary"
-	"encoding/hex"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-core-go/hashing"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txData"
 )
 
-const registerBridgeOpsPrefix = "registerBridgeOps"
+const registerBridgeOpsPrefix = txData.RegisterBridgeOpsEndpoint
 
 const (
-	executeDepositBridgeOpsPrefix    = "executeBridgeOps"
-	executeRegisterTokenPrefix       = "registerToken"
-	executeRegisterValidatorPrefix   = "registerValidator"
-	executeUnRegisterValidatorPrefix = "unRegisterValidator"
+	executeDepositBridgeOpsPrefix    = txData.ExecuteBridgeOpsEndpoint
+	executeRegisterTokenPrefix       = txData.RegisterTokenEndpoint
+	executeRegisterValidatorPrefix   = txData.RegisterValidatorEndpoint
+	executeUnRegisterValidatorPrefix = txData.UnRegisterValidatorEndpoint
 )
 
 type dataFormatterExecuteOperation struct {
@@ -23,7 +25,26 @@ type dataFormatterExecuteOperation struct {
 	executeOpPrefix string
 }
 
-func (df *dataFormatterExecuteOperation) createTxsData(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+// NewDataFormatterExecuteOperation creates a formatter which registers the bridge operations in the header verifier
+// contract, then calls the provided execute endpoint for each operation. It can be registered for new bridge outgoing
+// data types which are executed the same way as deposits.
+func NewDataFormatterExecuteOperation(hasher hashing.Hasher, executeOpPrefix string) (*dataFormatterExecuteOperation, error) {
+	if check.IfNil(hasher) {
+		return nil, core.ErrNilHasher
+	}
+	if len(executeOpPrefix) == 0 {
+		return nil, errEmptyEndpointPrefix
+	}
+
+	return &dataFormatterExecuteOperation{
+		hasher:          hasher,
+		executeOpPrefix: executeOpPrefix,
+	}, nil
+}
+
+// CreateTxsData will format the data as a registerBridgeOps tx, if the bridge operations are confirmed, followed by
+// one execute tx for each operation
+func (df *dataFormatterExecuteOperation) CreateTxsData(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
 	txsData := make([][]byte, 0)
 	registerBridgeOpData := df.createRegisterBridgeOperationsData(bridgeData)
 	if len(registerBridgeOpData) != 0 {
@@ -35,9 +56,9 @@ func (df *dataFormatterExecuteOperation) createTxsData(bridgeData *sovereign.Bri
 
 func (df *dataFormatterExecuteOperation) createRegisterBridgeOperationsData(bridgeData *sovereign.BridgeOutGoingData) []byte {
 	hashes := make([]byte, 0)
-	hashesHexEncodedArgs := make([]byte, 0)
+	operationHashes := make([][]byte, 0, len(bridgeData.OutGoingOperations))
 	for _, operation := range bridgeData.OutGoingOperations {
-		hashesHexEncodedArgs = append(hashesHexEncodedArgs, "@"+hex.EncodeToString(operation.Hash)...)
+		operationHashes = append(operationHashes, operation.Hash)
 		hashes = append(hashes, operation.Hash...)
 	}
 
@@ -47,30 +68,27 @@ func (df *dataFormatterExecuteOperation) createRegisterBridgeOperationsData(brid
 		return nil
 	}
 
-	registerBridgeOpData := []byte(registerBridgeOpsPrefix +
-		"@" + hex.EncodeToString(bridgeData.AggregatedSignature) +
-		"@" + hex.EncodeToString(bridgeData.Hash) +
-		"@" + hex.EncodeToString(bridgeData.PubKeysBitmap) +
-		"@" + hex.EncodeToString(uint32ToBytes(bridgeData.Epoch)))
-
-	return append(registerBridgeOpData, hashesHexEncodedArgs...)
-}
+	registerBridgeOps := &txData.RegisterBridgeOpsCall{
+		AggregatedSignature: bridgeData.AggregatedSignature,
+		HashOfHashes:        bridgeData.Hash,
+		PubKeysBitmap:       bridgeData.PubKeysBitmap,
+		Epoch:               bridgeData.Epoch,
+		OperationHashes:     operationHashes,
+	}
 
-func uint32ToBytes(value uint32) []byte {
-	buff := make([]byte, 4)
-	binary.BigEndian.PutUint32(buff, value)
-	return buff
+	return registerBridgeOps.Encode()
 }
 
 func (df *dataFormatterExecuteOperation) createExecuteDepositTokensBridgeOperationsData(hashOfHashes []byte, outGoingOperations []*sovereign.OutGoingOperation) [][]byte {
 	executeBridgeOpsTxData := make([][]byte, 0)
 	for _, operation := range outGoingOperations {
-		bridgeOpTxData := []byte(
-			df.executeOpPrefix +
-				"@" + hex.EncodeToString(hashOfHashes) +
-				"@" + hex.EncodeToString(operation.Data))
+		executeOperation := &txData.ExecuteOperationCall{
+			Endpoint:      df.executeOpPrefix,
+			HashOfHashes:  hashOfHashes,
+			OperationData: operation.Data,
+		}
 
-		executeBridgeOpsTxData = append(executeBridgeOpsTxData, bridgeOpTxData)
+		executeBridgeOpsTxData = append(executeBridgeOpsTxData, executeOperation.Encode())
 	}
 
 	return executeBridgeOpsTxData
@@ -1,14 +1,15 @@
 package txSender
 
 import (
-	"encoding/hex"
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"google.golang.org/protobuf/proto"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txData"
 )
 
-const changeValidatorSetPrefix = "changeValidatorSet"
+const changeValidatorSetPrefix = txData.ChangeValidatorSetEndpoint
 
 type dataFormatterValidatorSetChange struct {
 }
@@ -17,31 +18,33 @@ func newDataFormatterValidatorSetChange() *dataFormatterValidatorSetChange {
 	return &dataFormatterValidatorSetChange{}
 }
 
-// createTxsData will format the data to the following format:
+// CreateTxsData will format the data to the following format:
 //
-// changeValidatorSet@HashOfHashes@HashOfOperation@AggregatedBLSMultiSig@PubKeysBitMap@Epoch@list<allKeyIDsInNewEpoch>
-func (df *dataFormatterValidatorSetChange) createTxsData(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+// changeValidatorSet@AggregatedBLSMultiSig@HashOfHashes@HashOfOperation@PubKeysBitMap@Epoch@list<allKeyIDsInNewEpoch>
+func (df *dataFormatterValidatorSetChange) CreateTxsData(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
 	numOutGoingOperations := len(bridgeData.OutGoingOperations)
 	if numOutGoingOperations != 1 {
 		return nil, fmt.Errorf("%w, expected 1, got %d", errInvalidBridgeDataSetValidatorChange, numOutGoingOperations)
 	}
 
-	pubKeys, err := formatPubKeys(bridgeData.OutGoingOperations[0].Data)
+	pubKeys, err := getPubKeys(bridgeData.OutGoingOperations[0].Data)
 	if err != nil {
 		return nil, err
 	}
 
-	txData := []byte(changeValidatorSetPrefix +
-		"@" + hex.EncodeToString(bridgeData.AggregatedSignature) +
-		"@" + hex.EncodeToString(bridgeData.Hash) +
-		"@" + hex.EncodeToString(bridgeData.OutGoingOperations[0].Hash) +
-		"@" + hex.EncodeToString(bridgeData.PubKeysBitmap) +
-		"@" + hex.EncodeToString(uint32ToBytes(bridgeData.Epoch)))
+	changeValidatorSet := &txData.ChangeValidatorSetCall{
+		AggregatedSignature: bridgeData.AggregatedSignature,
+		HashOfHashes:        bridgeData.Hash,
+		HashOfOperation:     bridgeData.OutGoingOperations[0].Hash,
+		PubKeysBitmap:       bridgeData.PubKeysBitmap,
+		Epoch:               bridgeData.Epoch,
+		PubKeyIDs:           pubKeys,
+	}
 
-	return [][]byte{append(txData, pubKeys...)}, nil
+	return [][]byte{changeValidatorSet.Encode()}, nil
 }
 
-func formatPubKeys(data []byte) ([]byte, error) {
+func getPubKeys(data []byte) ([][]byte, error) {
 	pubKeysBridgeData := &sovereign.BridgeOutGoingDataValidatorSetChange{
 		PubKeyIDs: make([][]byte, 0),
 	}
@@ -50,10 +53,5 @@ func formatPubKeys(data []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	pubKeysHex := make([]byte, 0, len(pubKeysBridgeData.PubKeyIDs))
-	for _, pubKey := range pubKeysBridgeData.PubKeyIDs {
-		pubKeysHex = append(pubKeysHex, "@"+hex.EncodeToString(pubKey)...)
-	}
-
-	return pubKeysHex, nil
+	return pubKeysBridgeData.PubKeyIDs, nil
 }
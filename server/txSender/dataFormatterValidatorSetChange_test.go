@@ -9,9 +9,11 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txData"
 )
 
-func TestDataFormatterValidatorSetChange_createTxsData(t *testing.T) {
+func TestDataFormatterValidatorSetChange_CreateTxsData(t *testing.T) {
 	t.Parallel()
 
 	dataFormatterValidators := newDataFormatterValidatorSetChange()
@@ -47,12 +49,23 @@ func TestDataFormatterValidatorSetChange_createTxsData(t *testing.T) {
 		"@" + hex.EncodeToString(pubKey1) +
 		"@" + hex.EncodeToString(pubKey2))
 
-	txData, err := dataFormatterValidators.createTxsData(bridgeData)
+	txsData, err := dataFormatterValidators.CreateTxsData(bridgeData)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{expectedTxData}, txsData)
+
+	changeValidatorSet, err := txData.DecodeChangeValidatorSet(txsData[0])
 	require.Nil(t, err)
-	require.Equal(t, [][]byte{expectedTxData}, txData)
+	require.Equal(t, &txData.ChangeValidatorSetCall{
+		AggregatedSignature: []byte("aggregatedSig"),
+		HashOfHashes:        []byte("hashOfHashes"),
+		HashOfOperation:     []byte("operationHash"),
+		PubKeysBitmap:       []byte("pubKeysBitmap"),
+		Epoch:               4,
+		PubKeyIDs:           [][]byte{pubKey1, pubKey2},
+	}, changeValidatorSet)
 }
 
-func TestDataFormatterValidatorSetChange_createTxsDataErrorCases(t *testing.T) {
+func TestDataFormatterValidatorSetChange_CreateTxsDataErrorCases(t *testing.T) {
 	t.Parallel()
 
 	dataFormatterValidators := newDataFormatterValidatorSetChange()
@@ -69,7 +82,7 @@ func TestDataFormatterValidatorSetChange_createTxsDataErrorCases(t *testing.T) {
 			},
 		}
 
-		txData, err := dataFormatterValidators.createTxsData(bridgeData)
+		txData, err := dataFormatterValidators.CreateTxsData(bridgeData)
 		require.ErrorIs(t, err, errInvalidBridgeDataSetValidatorChange)
 		require.Nil(t, txData)
 	})
@@ -83,7 +96,7 @@ func TestDataFormatterValidatorSetChange_createTxsDataErrorCases(t *testing.T) {
 			},
 		}
 
-		txData, err := dataFormatterValidators.createTxsData(bridgeData)
+		txData, err := dataFormatterValidators.CreateTxsData(bridgeData)
 		require.NotNil(t, err)
 		require.Nil(t, txData)
 	})
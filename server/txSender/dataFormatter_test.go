@@ -5,9 +5,11 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/stretchr/testify/require"
 
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txData"
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
 )
 
@@ -20,6 +22,27 @@ func TestNewDataFormatter(t *testing.T) {
 		require.Nil(t, df)
 	})
 
+	t.Run("nil registered formatter, should fail", func(t *testing.T) {
+		df, err := NewDataFormatter(&testscommon.HasherMock{}, DataFormatterRegistration{Type: 999})
+		require.ErrorIs(t, err, errNilTxDataFormatter)
+		require.Nil(t, df)
+	})
+
+	t.Run("duplicated registered type, should fail", func(t *testing.T) {
+		registration := DataFormatterRegistration{
+			Type:      int32(block.OutGoingMbDeposit),
+			Formatter: newDataFormatterValidatorSetChange(),
+		}
+		df, err := NewDataFormatter(&testscommon.HasherMock{}, registration)
+		require.ErrorIs(t, err, errDuplicatedBridgeDataType)
+		require.Nil(t, df)
+
+		registration.Type = 999
+		df, err = NewDataFormatter(&testscommon.HasherMock{}, registration, registration)
+		require.ErrorIs(t, err, errDuplicatedBridgeDataType)
+		require.Nil(t, df)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		df, err := NewDataFormatter(&testscommon.HasherMock{})
 		require.Nil(t, err)
@@ -156,6 +179,24 @@ func TestDataFormatter_CreateTxsData(t *testing.T) {
 		txsData := df.CreateTxsData(bridgeOps)
 		require.Equal(t, expectedTxsData, txsData)
 		require.Equal(t, computeHashCt, 2)
+
+		registerBridgeOps, err := txData.DecodeRegisterBridgeOps(registerOp1)
+		require.Nil(t, err)
+		require.Equal(t, &txData.RegisterBridgeOpsCall{
+			AggregatedSignature: aggregatedSig1,
+			HashOfHashes:        bridgeDataHash1,
+			PubKeysBitmap:       pubKeysBitmap1,
+			Epoch:               1,
+			OperationHashes:     [][]byte{opHash1, opHash2},
+		}, registerBridgeOps)
+
+		executeOperation, err := txData.DecodeExecuteOperation(execOp3)
+		require.Nil(t, err)
+		require.Equal(t, &txData.ExecuteOperationCall{
+			Endpoint:      executeDepositBridgeOpsPrefix,
+			HashOfHashes:  bridgeDataHash2,
+			OperationData: bridgeDataOp3,
+		}, executeOperation)
 	})
 
 	t.Run("computed hash != received hash, should only format execute operations, without register", func(t *testing.T) {
@@ -226,3 +267,58 @@ func TestDataFormatter_CreateTxsData(t *testing.T) {
 		require.Equal(t, computeHashCt, 1)
 	})
 }
+
+func TestDataFormatter_CreateBridgeDataTxsData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown bridge data type, should fail", func(t *testing.T) {
+		df, _ := NewDataFormatter(&testscommon.HasherMock{})
+		txsData, err := df.CreateBridgeDataTxsData(&sovereign.BridgeOutGoingData{Type: 999})
+		require.ErrorIs(t, err, errUnknownBridgeDataType)
+		require.Nil(t, txsData)
+	})
+
+	t.Run("registered bridge data type, should work", func(t *testing.T) {
+		executeFormatter, err := NewDataFormatterExecuteOperation(&testscommon.HasherMock{}, "executeNewOperation")
+		require.Nil(t, err)
+
+		df, _ := NewDataFormatter(&testscommon.HasherMock{}, DataFormatterRegistration{
+			Type:      999,
+			Formatter: executeFormatter,
+		})
+		txsData, err := df.CreateBridgeDataTxsData(&sovereign.BridgeOutGoingData{
+			Type:               999,
+			Hash:               []byte("hash"),
+			OutGoingOperations: []*sovereign.OutGoingOperation{{Hash: []byte("opHash"), Data: []byte("opData")}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{
+			[]byte("executeNewOperation@" + hex.EncodeToString([]byte("hash")) + "@" + hex.EncodeToString([]byte("opData"))),
+		}, txsData)
+	})
+
+	t.Run("invalid validator set change, should fail", func(t *testing.T) {
+		df, _ := NewDataFormatter(&testscommon.HasherMock{})
+		txsData, err := df.CreateBridgeDataTxsData(&sovereign.BridgeOutGoingData{
+			Type: int32(block.OutGoingMbChangeValidatorSet),
+		})
+		require.ErrorIs(t, err, errInvalidBridgeDataSetValidatorChange)
+		require.Nil(t, txsData)
+	})
+}
+
+func TestNewDataFormatterExecuteOperation(t *testing.T) {
+	t.Parallel()
+
+	df, err := NewDataFormatterExecuteOperation(nil, "executeNewOperation")
+	require.Equal(t, core.ErrNilHasher, err)
+	require.Nil(t, df)
+
+	df, err = NewDataFormatterExecuteOperation(&testscommon.HasherMock{}, "")
+	require.Equal(t, errEmptyEndpointPrefix, err)
+	require.Nil(t, df)
+
+	df, err = NewDataFormatterExecuteOperation(&testscommon.HasherMock{}, "executeNewOperation")
+	require.Nil(t, err)
+	require.NotNil(t, df)
+}
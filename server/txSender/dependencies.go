@@ -0,0 +1,232 @@
+package txSender
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+)
+
+const noDependency = -1
+
+// isRegisterTx returns true if the tx registers bridge operations, which the following txs of the same bridge data
+// execute. Executing the operations fails on-chain while they are not registered.
+func isRegisterTx(txData []byte) bool {
+	return getTxDataPrefix(txData) == registerBridgeOpsPrefix
+}
+
+// getDependencies returns, for each tx, the index of the register tx on which it depends, or noDependency. Txs depend
+// on the closest register tx before them.
+func getDependencies(txs []*journal.TxRecord) []int {
+	dependencies := make([]int, 0, len(txs))
+	registerTxIndex := noDependency
+	for txIndex, txRecord := range txs {
+		if isRegisterTx(txRecord.Data) {
+			registerTxIndex = txIndex
+			dependencies = append(dependencies, noDependency)
+			continue
+		}
+
+		dependencies = append(dependencies, registerTxIndex)
+	}
+
+	return dependencies
+}
+
+// getJournaledDependency returns the index of the tx on which a not yet sent tx depends, or noDependency if it was
+// already confirmed. It returns an error if the dependency failed on the network.
+func getJournaledDependency(txs []*journal.TxRecord, dependsOn int) (int, error) {
+	if dependsOn == noDependency {
+		return noDependency, nil
+	}
+
+	dependency := txs[dependsOn]
+	switch dependency.State {
+	case journal.TxConfirmed:
+		return noDependency, nil
+	case journal.TxFailed:
+		return noDependency, fmt.Errorf("%w, tx hash = %s", errDependencyFailed, dependency.Hash)
+	default:
+		return dependsOn, nil
+	}
+}
+
+// splitDependentTxs returns the txs which can be sent right away, separately from the txs which are held until their
+// dependency is confirmed
+func splitDependentTxs(pending []*pendingTx) ([]*pendingTx, []*pendingTx) {
+	ready := make([]*pendingTx, 0, len(pending))
+	held := make([]*pendingTx, 0)
+	for _, ptx := range pending {
+		if ptx.dependsOn == noDependency {
+			ready = append(ready, ptx)
+			continue
+		}
+
+		held = append(held, ptx)
+	}
+
+	return ready, held
+}
+
+// onTxOutcome is notified by the tracker of the final state of each tracked tx. Once a tx is confirmed, the txs of its
+// bridge data held until it was confirmed are released in background, while once it failed, they are cancelled. The
+// bridge outgoing data held until the validator set of its epoch is rotated is checked again on every tx outcome, so
+// that it is either released or rejected once the validator set change of its epoch is confirmed or failed, as well as
+// the validator set change handlers are notified. No request waits for a confirmation.
+func (ts *txSender) onTxOutcome(bridgeDataHash []byte, _ string, state journal.TxState) {
+	ts.mutHeld.Lock()
+	ts.finished[string(bridgeDataHash)] = struct{}{}
+	switch state {
+	case journal.TxConfirmed:
+		ts.released[string(bridgeDataHash)] = struct{}{}
+	case journal.TxFailed:
+		ts.cancelled[string(bridgeDataHash)] = struct{}{}
+	}
+	ts.mutHeld.Unlock()
+
+	ts.signalReleased()
+}
+
+// signalReleased wakes up the release loop, unless it was already signaled
+func (ts *txSender) signalReleased() {
+	select {
+	case ts.chReleased <- struct{}{}:
+	default:
+	}
+}
+
+func (ts *txSender) releaseHeldLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing held txs release loop")
+			return
+		case <-ts.chReleased:
+			ts.notifyValidatorSetChanges()
+			ts.cancelHeldTxs()
+			ts.releaseHeldTxs(ctx)
+			ts.releaseHeldByEpoch(ctx)
+		}
+	}
+}
+
+// releaseHeldTxs sends the journaled txs of the bridge data whose dependencies were confirmed. Txs still depending on
+// a not yet confirmed tx remain held, while txs which could not be sent remain journaled as built, so that they are
+// resumed when the same bridge data is received again.
+func (ts *txSender) releaseHeldTxs(ctx context.Context) {
+	ts.mutHeld.Lock()
+	released := ts.released
+	ts.released = make(map[string]struct{})
+	ts.mutHeld.Unlock()
+
+	ts.submissions.acquire(maxSubmissionPriority)
+	defer ts.submissions.release()
+
+	entries := make([]*journal.Entry, 0, len(released))
+	for hash := range released {
+		entry, found := ts.journal.Get([]byte(hash))
+		if found && entry.TxsBuilt && !entry.IsFinished() {
+			entries = append(entries, entry)
+		}
+	}
+
+	result := &bridge.OperationsResult{
+		Results: make([]*bridge.OutGoingDataResult, len(entries)),
+	}
+	tasks := make([]*sendTask, 0, len(entries))
+	for idx, entry := range entries {
+		tasks = append(tasks, &sendTask{
+			wallet: ts.acquireWallet(entry.Sender()),
+			prepare: func(wallet TxSigner) *preparedTxs {
+				prepared := newPreparedTxs(entry.Hash)
+				ts.addJournaledTxs(prepared, wallet, entry.Hash, entry.Txs)
+				return prepared
+			},
+			setResult: func(entryResult *bridge.OutGoingDataResult) {
+				result.Results[idx] = entryResult
+			},
+		})
+	}
+
+	ts.sendTasks(ctx, tasks)
+
+	err := result.Err()
+	if err != nil {
+		log.Error("could not send all released bridge txs", "error", err)
+	}
+	if len(result.TxHashes()) != 0 {
+		log.Debug("sent released bridge txs", "tx hashes", result.TxHashes())
+	}
+}
+
+// cancelHeldTxs cancels the journaled txs of the bridge data whose dependencies failed, so that the bridge data is no
+// longer resumed. The cancelled txs are sent again, together with their failed dependency, once the same bridge data is
+// received again.
+func (ts *txSender) cancelHeldTxs() {
+	ts.mutHeld.Lock()
+	cancelled := ts.cancelled
+	ts.cancelled = make(map[string]struct{})
+	ts.mutHeld.Unlock()
+
+	ts.submissions.acquire(maxSubmissionPriority)
+	defer ts.submissions.release()
+
+	for hash := range cancelled {
+		entry, found := ts.journal.Get([]byte(hash))
+		if !found || !entry.TxsBuilt {
+			continue
+		}
+
+		dependencies := getDependencies(entry.Txs)
+		for txIndex, txRecord := range entry.Txs {
+			if txRecord.IsBroadcast() {
+				continue
+			}
+
+			_, err := getJournaledDependency(entry.Txs, dependencies[txIndex])
+			if err != nil {
+				ts.cancelTx(entry.Hash, txIndex, txRecord, err)
+			}
+		}
+	}
+}
+
+// cancelTx journals the tx as failed with the error of its dependency, without sending it, and reports it through the
+// tracker
+func (ts *txSender) cancelTx(bridgeDataHash []byte, txIndex int, txRecord *journal.TxRecord, err error) {
+	log.Error("dependency of bridge tx failed, tx is cancelled", "hash", bridgeDataHash, "data", string(txRecord.Data), "error", err)
+
+	errUpdate := ts.journal.UpdateTx(bridgeDataHash, txIndex, &journal.TxRecord{
+		State: journal.TxFailed,
+		Error: err.Error(),
+	})
+	if errUpdate != nil {
+		log.Error("could not journal cancelled tx", "hash", bridgeDataHash, "tx index", txIndex, "error", errUpdate)
+	}
+
+	ts.txTracker.SetCancelled(bridgeDataHash, err.Error())
+}
+
+// setPendingTxsHeld reports the txs which remain held until the tx they depend on is confirmed. Txs whose dependency
+// was sent in the same batch, but could not be sent, are reported as not sent.
+func setPendingTxsHeld(sent []*pendingTx, held []*pendingTx) {
+	notSent := make(map[string]struct{})
+	for _, ptx := range sent {
+		if ptx.result != nil && len(ptx.result.Hash) == 0 {
+			notSent[fmt.Sprintf("%s-%d", ptx.bridgeDataHash, ptx.txIndex)] = struct{}{}
+		}
+	}
+
+	for _, ptx := range held {
+		if _, found := notSent[fmt.Sprintf("%s-%d", ptx.bridgeDataHash, ptx.dependsOn)]; found {
+			ptx.result = createTxErrorResult(ptx.tx.Data, bridge.ErrorStage_Dependency, errDependencyNotSent)
+			continue
+		}
+
+		ptx.result = &bridge.TxResult{
+			Data: ptx.tx.Data,
+			Held: true,
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package txSender
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+func createDependencyArgs() (TxSenderArgs, *[][]string) {
+	args := createArgs()
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			return [][]byte{
+				[]byte(registerBridgeOpsPrefix + "@" + string(bridgeData.Hash)),
+				[]byte(executeDepositBridgeOpsPrefix + "@" + string(bridgeData.Hash) + "@op1"),
+				[]byte(executeDepositBridgeOpsPrefix + "@" + string(bridgeData.Hash) + "@op2"),
+			}, nil
+		},
+	}
+
+	mut := sync.Mutex{}
+	sentBatches := make([][]string, 0)
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			mut.Lock()
+			defer mut.Unlock()
+
+			batch := make([]string, 0, len(txs))
+			hashes := make([]string, 0, len(txs))
+			for _, tx := range txs {
+				batch = append(batch, string(tx.Data))
+				hashes = append(hashes, "hash-"+string(tx.Data))
+			}
+			sentBatches = append(sentBatches, batch)
+
+			return hashes, nil
+		},
+	}
+
+	return args, &sentBatches
+}
+
+func TestTxSender_SendTxsDependencies(t *testing.T) {
+	t.Parallel()
+
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("hash")}},
+	}
+	registerTxData := registerBridgeOpsPrefix + "@hash"
+	executeTxsData := []string{
+		executeDepositBridgeOpsPrefix + "@hash@op1",
+		executeDepositBridgeOpsPrefix + "@hash@op2",
+	}
+
+	t.Run("execute txs should be held and sent in background once the register tx is confirmed", func(t *testing.T) {
+		fileJournal, err := journal.NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+
+		args, sentBatches := createDependencyArgs()
+		args.Journal = fileJournal
+		var onTxOutcome func(bridgeDataHash []byte, txHash string, state journal.TxState)
+		args.TxTracker = &testscommon.TxTrackerMock{
+			RegisterHandlerCalled: func(handler func(bridgeDataHash []byte, txHash string, state journal.TxState)) {
+				onTxOutcome = handler
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		defer func() {
+			_ = ts.Close()
+		}()
+
+		result := ts.SendTxs(context.Background(), bridgeOps)
+		require.Nil(t, result.Err())
+		require.Equal(t, []string{"hash-" + registerTxData}, result.TxHashes())
+		require.Equal(t, [][]string{{registerTxData}}, *sentBatches)
+
+		txResults := result.Results[0].Txs
+		require.Len(t, txResults, 3)
+		for idx, executeTxData := range executeTxsData {
+			require.Equal(t, executeTxData, string(txResults[idx+1].Data))
+			require.True(t, txResults[idx+1].Held)
+		}
+
+		entry, _ := fileJournal.Get(bridgeOps.Data[0].Hash)
+		require.Equal(t, journal.TxBroadcast, entry.Txs[0].State)
+		require.Equal(t, journal.TxBuilt, entry.Txs[1].State)
+		require.Equal(t, journal.TxBuilt, entry.Txs[2].State)
+
+		// the tracker confirms the register tx, which releases the execute txs
+		_ = fileJournal.SetTxState(bridgeOps.Data[0].Hash, entry.Txs[0].Hash, journal.TxConfirmed)
+		onTxOutcome(bridgeOps.Data[0].Hash, entry.Txs[0].Hash, journal.TxConfirmed)
+
+		require.Eventually(t, func() bool {
+			entry, _ = fileJournal.Get(bridgeOps.Data[0].Hash)
+			return entry.IsFinished()
+		}, time.Second, time.Millisecond)
+		require.Equal(t, [][]string{{registerTxData}, executeTxsData}, *sentBatches)
+	})
+	t.Run("register tx failed, execute txs should be cancelled until it is sent again", func(t *testing.T) {
+		fileJournal, err := journal.NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+
+		args, sentBatches := createDependencyArgs()
+		args.Journal = fileJournal
+		trackerMock := createTrackerSettingTxState(fileJournal.SetTxState, journal.TxFailed)
+		mut := sync.Mutex{}
+		cancelReasons := make([]string, 0)
+		trackerMock.SetCancelledCalled = func(bridgeDataHash []byte, reason string) {
+			mut.Lock()
+			cancelReasons = append(cancelReasons, reason)
+			mut.Unlock()
+		}
+		args.TxTracker = trackerMock
+
+		ts, _ := NewTxSender(args)
+		defer func() {
+			_ = ts.Close()
+		}()
+
+		result := ts.SendTxs(context.Background(), bridgeOps)
+		require.Nil(t, result.Err())
+
+		require.Eventually(t, func() bool {
+			entry, _ := fileJournal.Get(bridgeOps.Data[0].Hash)
+			return entry.IsFinished()
+		}, time.Second, time.Millisecond)
+		require.Empty(t, fileJournal.Unfinished())
+
+		entry, _ := fileJournal.Get(bridgeOps.Data[0].Hash)
+		require.Equal(t, journal.TxFailed, entry.Txs[0].State)
+		for _, txRecord := range entry.Txs[1:] {
+			require.Equal(t, journal.TxFailed, txRecord.State)
+			require.Contains(t, txRecord.Error, errDependencyFailed.Error())
+		}
+
+		mut.Lock()
+		require.Len(t, cancelReasons, 2)
+		require.Contains(t, cancelReasons[0], errDependencyFailed.Error())
+		mut.Unlock()
+
+		// once the same bridge data is received again, only the failed register tx is sent again
+		result = ts.SendTxs(context.Background(), bridgeOps)
+		require.Nil(t, result.Err())
+		require.Equal(t, [][]string{{registerTxData}, {registerTxData}}, *sentBatches)
+		for _, txResult := range result.Results[0].Txs[1:] {
+			require.True(t, txResult.Held)
+		}
+	})
+	t.Run("register tx not sent, execute txs should not be sent", func(t *testing.T) {
+		fileJournal, err := journal.NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+
+		args, _ := createDependencyArgs()
+		args.Journal = fileJournal
+		args.RetryPolicy.MaxAttempts = 1
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				require.Len(t, txs, 1)
+				return []string{""}, errTxsNotAccepted
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		result := ts.SendTxs(context.Background(), bridgeOps)
+		require.NotNil(t, result.Err())
+
+		txResults := result.Results[0].Txs
+		require.Equal(t, bridge.ErrorStage_Broadcast, txResults[0].Stage)
+		for _, txResult := range txResults[1:] {
+			require.Equal(t, bridge.ErrorStage_Dependency, txResult.Stage)
+			require.Equal(t, errDependencyNotSent.Error(), txResult.Error)
+		}
+	})
+	t.Run("resumed execute txs whose register tx failed should be cancelled", func(t *testing.T) {
+		args, sentBatches := createDependencyArgs()
+		cancelledTxs := make(map[int]*journal.TxRecord)
+		args.Journal = &testscommon.JournalMock{
+			UpdateTxCalled: func(hash []byte, txIndex int, tx *journal.TxRecord) error {
+				cancelledTxs[txIndex] = tx
+				return nil
+			},
+			UnfinishedCalled: func() []*journal.Entry {
+				return []*journal.Entry{
+					{
+						Hash:     []byte("hash"),
+						TxsBuilt: true,
+						Txs: []*journal.TxRecord{
+							{Data: []byte(registerTxData), Nonce: 1, Hash: "txHash1", State: journal.TxFailed},
+							{Data: []byte(executeTxsData[0]), State: journal.TxBuilt},
+						},
+					},
+				}
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		result := ts.ResumeUnfinished(context.Background())
+		require.NotNil(t, result.Err())
+		require.Empty(t, *sentBatches)
+		require.Len(t, result.Results[0].Txs, 1)
+		require.Equal(t, bridge.ErrorStage_Dependency, result.Results[0].Txs[0].Stage)
+		require.Contains(t, result.Results[0].Txs[0].Error, errDependencyFailed.Error())
+		require.Len(t, cancelledTxs, 1)
+		require.Equal(t, journal.TxFailed, cancelledTxs[1].State)
+		require.Contains(t, cancelledTxs[1].Error, errDependencyFailed.Error())
+	})
+}
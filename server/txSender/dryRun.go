@@ -0,0 +1,153 @@
+package txSender
+
+import (
+	"context"
+	jsonEncoding "encoding/json"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	coreTx "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+)
+
+type dryRunCtxKey struct{}
+
+// WithDryRun returns a context under which bridge txs are built and signed, but not sent
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunCtxKey{}, true)
+}
+
+// IsDryRun returns true if a dry-run is requested through the context
+func IsDryRun(ctx context.Context) bool {
+	isDryRun, _ := ctx.Value(dryRunCtxKey{}).(bool)
+	return isDryRun
+}
+
+func (ts *txSender) isDryRun(ctx context.Context) bool {
+	return ts.dryRun || IsDryRun(ctx)
+}
+
+// dryRunNonces assigns consecutive nonces to the dry-run txs of each wallet, starting with the wallet's next nonce, so
+// that the nonce handler used for sending is not advanced
+type dryRunNonces struct {
+	nextNonces map[string]uint64
+}
+
+func (ts *txSender) newDryRunNonces() *dryRunNonces {
+	nextNonces := make(map[string]uint64)
+	for _, status := range ts.walletPool.GetStatus() {
+		nextNonces[status.Address] = status.Nonce + status.InFlight
+	}
+
+	return &dryRunNonces{
+		nextNonces: nextNonces,
+	}
+}
+
+func (ts *txSender) applyDryRunNonce(ctx context.Context, nonces *dryRunNonces, wallet TxSigner, tx *coreTx.FrontendTransaction) error {
+	address := wallet.GetBech32()
+	nonce, found := nonces.nextNonces[address]
+	if !found {
+		account, err := ts.proxy.GetAccount(ctx, wallet.GetAddressHandler())
+		if err != nil {
+			return err
+		}
+
+		nonce = account.Nonce
+	}
+
+	tx.Nonce = nonce
+	nonces.nextNonces[address] = nonce + 1
+	return nil
+}
+
+// dryRunTxs formats, routes, assigns nonces to and signs the txs of all bridge outgoing data, same as when sending them,
+// but returns the signed txs instead of broadcasting them. Nothing is journaled or tracked, so all txs are built from
+// the received bridge data, even if it was already received before.
+func (ts *txSender) dryRunTxs(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+	// the received bridge data is never empty, so there is at least one priority group
+	highestPriority := groupByPriority(data.Data, ts.priorities)[0].priority
+	ts.submissions.acquire(highestPriority)
+	defer ts.submissions.release()
+
+	result := &bridge.OperationsResult{
+		Results: make([]*bridge.OutGoingDataResult, 0, len(data.Data)),
+		DryRun:  true,
+	}
+	nonces := ts.newDryRunNonces()
+	for _, bridgeData := range data.Data {
+		wallet := ts.walletPool.Select()
+		result.Results = append(result.Results, ts.dryRunBridgeDataTxs(ctx, nonces, wallet, bridgeData))
+		ts.walletPool.Release(wallet.GetBech32())
+	}
+
+	return result
+}
+
+func (ts *txSender) dryRunBridgeDataTxs(
+	ctx context.Context,
+	nonces *dryRunNonces,
+	wallet TxSigner,
+	bridgeData *sovereign.BridgeOutGoingData,
+) *bridge.OutGoingDataResult {
+	txsData, err := ts.dataFormatter.CreateBridgeDataTxsData(bridgeData)
+	if err != nil {
+		log.Error("could not create txs data", "hash", bridgeData.Hash, "error", err)
+		return createBridgeDataErrorResult(bridgeData.Hash, bridge.ErrorStage_Formatting, err)
+	}
+
+	result := &bridge.OutGoingDataResult{
+		Hash: bridgeData.Hash,
+		Txs:  make([]*bridge.TxResult, 0, len(txsData)),
+	}
+	for _, txData := range txsData {
+		txResult := ts.dryRunTx(ctx, nonces, wallet, txData)
+		result.Txs = append(result.Txs, txResult)
+		if txResult.Failed() && txResult.Stage != bridge.ErrorStage_Formatting {
+			break
+		}
+	}
+
+	return result
+}
+
+func (ts *txSender) dryRunTx(ctx context.Context, nonces *dryRunNonces, wallet TxSigner, txData []byte) *bridge.TxResult {
+	tx := &coreTx.FrontendTransaction{
+		Sender:   wallet.GetBech32(),
+		GasPrice: ts.netConfigs.MinGasPrice,
+		Data:     txData,
+		ChainID:  ts.netConfigs.ChainID,
+		Version:  ts.netConfigs.MinTransactionVersion,
+	}
+
+	txCfg, err := ts.setTxFields(txData, tx)
+	if err != nil {
+		log.Error("invalid tx data received", "data", string(txData), "error", err)
+		return createTxErrorResult(txData, bridge.ErrorStage_Formatting, err)
+	}
+
+	err = ts.applyDryRunNonce(ctx, nonces, wallet, tx)
+	if err != nil {
+		log.Error("failed to apply nonce", "error", err)
+		return createTxErrorResult(txData, bridge.ErrorStage_Nonce, err)
+	}
+
+	ts.estimateGasLimit(ctx, tx, txCfg)
+
+	err = wallet.SignTx(ctx, tx)
+	if err != nil {
+		log.Error("failed to sign tx", "error", err, "nonce", tx.Nonce)
+		return createTxErrorResult(txData, bridge.ErrorStage_Signing, err)
+	}
+
+	txJson, err := jsonEncoding.Marshal(tx)
+	if err != nil {
+		return createTxErrorResult(txData, bridge.ErrorStage_Signing, err)
+	}
+
+	log.Info("dry-run tx", "sender", tx.Sender, "receiver", tx.Receiver, "nonce", tx.Nonce, "endpoint", getTxDataPrefix(txData))
+	return &bridge.TxResult{
+		Data: txData,
+		Tx:   string(txJson),
+	}
+}
@@ -0,0 +1,132 @@
+package txSender
+
+import (
+	"context"
+	jsonEncoding "encoding/json"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+func createDryRunArgs(t *testing.T) TxSenderArgs {
+	wallet := createWallet("erd1sender")
+	wallet.SignTxCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) error {
+		tx.Signature = "signature"
+		return nil
+	}
+	walletPool := createWalletPool(wallet)
+	walletPool.TxSent("erd1sender", 4)
+
+	args := createArgs()
+	args.WalletPool = walletPool
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			return [][]byte{
+				[]byte(registerBridgeOpsPrefix + "@" + string(bridgeData.Hash)),
+				[]byte(executeDepositBridgeOpsPrefix + "@" + string(bridgeData.Hash)),
+			}, nil
+		},
+	}
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+			require.Fail(t, "should not apply nonce")
+			return nil
+		},
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			require.Fail(t, "should not send txs")
+			return nil, nil
+		},
+	}
+	args.Journal = &testscommon.JournalMock{
+		AddCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+			require.Fail(t, "should not journal bridge data")
+			return nil
+		},
+		SetTxsDataCalled: func(hash []byte, txsData [][]byte) error {
+			require.Fail(t, "should not journal txs data")
+			return nil
+		},
+		UpdateTxCalled: func(hash []byte, txIndex int, tx *journal.TxRecord) error {
+			require.Fail(t, "should not journal txs")
+			return nil
+		},
+	}
+	args.TxTracker = &testscommon.TxTrackerMock{
+		TrackCalled: func(bridgeDataHash []byte, txHash string) {
+			require.Fail(t, "should not track txs")
+		},
+	}
+
+	return args
+}
+
+func requireDryRunTxs(t *testing.T, result *bridge.OperationsResult) {
+	require.True(t, result.GetDryRun())
+	require.Nil(t, result.Err())
+	require.Empty(t, result.TxHashes())
+	require.Len(t, result.Results, 2)
+
+	expectedNonce := uint64(5)
+	expectedReceivers := []string{scHeaderVerifierAddress, scEsdtSafeAddress}
+	for _, bridgeDataResult := range result.Results {
+		require.Len(t, bridgeDataResult.Txs, 2)
+		for idx, txResult := range bridgeDataResult.Txs {
+			tx := &transaction.FrontendTransaction{}
+			err := jsonEncoding.Unmarshal([]byte(txResult.GetTx()), tx)
+			require.Nil(t, err)
+
+			require.Equal(t, txResult.Data, tx.Data)
+			require.Equal(t, expectedNonce, tx.Nonce)
+			require.Equal(t, "erd1sender", tx.Sender)
+			require.Equal(t, expectedReceivers[idx], tx.Receiver)
+			require.Equal(t, "signature", tx.Signature)
+			expectedNonce++
+		}
+	}
+}
+
+func TestTxSender_SendTxsDryRun(t *testing.T) {
+	t.Parallel()
+
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{Hash: []byte("hash1")},
+			{Hash: []byte("hash2")},
+		},
+	}
+
+	t.Run("configured dry-run", func(t *testing.T) {
+		args := createDryRunArgs(t)
+		args.DryRun = true
+		ts, _ := NewTxSender(args)
+
+		requireDryRunTxs(t, ts.SendTxs(context.Background(), bridgeOps))
+		require.True(t, ts.ResumeUnfinished(context.Background()).GetDryRun())
+	})
+	t.Run("requested dry-run", func(t *testing.T) {
+		ts, _ := NewTxSender(createDryRunArgs(t))
+
+		requireDryRunTxs(t, ts.SendTxs(WithDryRun(context.Background()), bridgeOps))
+	})
+	t.Run("unrouted tx data", func(t *testing.T) {
+		args := createDryRunArgs(t)
+		args.DataFormatter = &testscommon.DataFormatterMock{
+			CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+				return [][]byte{[]byte("unknownEndpoint@01")}, nil
+			},
+		}
+		ts, _ := NewTxSender(args)
+
+		result := ts.SendTxs(WithDryRun(context.Background()), bridgeOps)
+		require.True(t, result.GetDryRun())
+		require.NotNil(t, result.Err())
+		require.Equal(t, bridge.ErrorStage_Formatting, result.Results[0].Txs[0].Stage)
+		require.Empty(t, result.Results[0].Txs[0].Tx)
+	})
+}
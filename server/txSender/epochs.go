@@ -0,0 +1,472 @@
+package txSender
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+)
+
+// epochTracker tracks the latest epoch whose validator set was rotated on-chain, from the journaled validator set
+// changes. The validator set change of epoch N rotates the validator set of epoch N+1, once its tx is confirmed.
+// Since an epoch's validator set stays active until the next change, bridge operations are only held while a known
+// validator set change of an epoch up to theirs is not confirmed yet.
+type epochTracker struct {
+	mut          sync.Mutex
+	journal      Journal
+	isRotated    bool
+	rotatedEpoch uint32
+	// rotated epoch of each validator set change, by hex encoded hash
+	pending map[string]uint32
+	failed  map[string]uint32
+}
+
+// newEpochTracker creates an epoch tracker, loading the validator set changes already journaled
+func newEpochTracker(journal Journal) *epochTracker {
+	et := &epochTracker{
+		journal: journal,
+		pending: make(map[string]uint32),
+		failed:  make(map[string]uint32),
+	}
+
+	for _, entry := range journal.Entries() {
+		bridgeData, err := entry.BridgeOutGoingData()
+		if err != nil {
+			log.Warn("could not decode journaled bridge data", "hash", entry.Hash, "error", err)
+			continue
+		}
+		if !isValidatorSetChange(bridgeData) {
+			continue
+		}
+
+		et.pending[hex.EncodeToString(entry.Hash)] = bridgeData.Epoch + 1
+	}
+	et.refresh()
+
+	if et.isRotated {
+		log.Info("loaded latest rotated epoch", "epoch", et.rotatedEpoch)
+	}
+
+	return et
+}
+
+func isValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) bool {
+	return bridgeData.Type == int32(block.OutGoingMbChangeValidatorSet)
+}
+
+// addValidatorSetChange tracks the received validator set change until its tx outcome is known
+func (et *epochTracker) addValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	key := hex.EncodeToString(bridgeData.Hash)
+	delete(et.failed, key)
+	et.pending[key] = bridgeData.Epoch + 1
+}
+
+// checkEpoch returns true if the bridge operations of the provided epoch should be held until a pending validator set
+// change is confirmed. An error is returned if the epoch is stale, since a later validator set was already rotated,
+// or if the validator set change of an epoch up to the provided one failed.
+func (et *epochTracker) checkEpoch(epoch uint32) (bool, error) {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	et.refresh()
+
+	if et.isRotated && epoch < et.rotatedEpoch {
+		return false, fmt.Errorf("%w, epoch = %d, rotated epoch = %d", errStaleEpoch, epoch, et.rotatedEpoch)
+	}
+	for hash, rotatedEpoch := range et.failed {
+		if et.isAhead(rotatedEpoch) && rotatedEpoch <= epoch {
+			return false, fmt.Errorf("%w, epoch = %d, validator set change hash = %s", errValidatorSetChangeFailed, epoch, hash)
+		}
+	}
+	for _, rotatedEpoch := range et.pending {
+		if et.isAhead(rotatedEpoch) && rotatedEpoch <= epoch {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isAhead returns true if the provided epoch is after the latest rotated one
+func (et *epochTracker) isAhead(epoch uint32) bool {
+	return !et.isRotated || epoch > et.rotatedEpoch
+}
+
+// refresh updates the rotated epoch from the journaled state of the pending validator set changes. Validator set
+// changes which are not journaled or built yet remain pending.
+func (et *epochTracker) refresh() {
+	for key, rotatedEpoch := range et.pending {
+		hash, _ := hex.DecodeString(key)
+		entry, found := et.journal.Get(hash)
+		if !found || !(entry.TxsBuilt || entry.Failed) {
+			continue
+		}
+
+		switch {
+		case entry.Failed || entry.HasFailedTxs():
+			log.Error("validator set change failed, bridge operations of its epoch are rejected", "hash", key, "epoch", rotatedEpoch)
+			delete(et.pending, key)
+			et.failed[key] = rotatedEpoch
+		case isConfirmed(entry):
+			delete(et.pending, key)
+			et.setRotatedEpoch(rotatedEpoch)
+		}
+	}
+}
+
+// isLatestRotation returns true if the journaled entry is the validator set change which rotated the latest epoch
+func (et *epochTracker) isLatestRotation(entry *journal.Entry) bool {
+	bridgeData, err := entry.BridgeOutGoingData()
+	if err != nil || !isValidatorSetChange(bridgeData) {
+		return false
+	}
+
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	return et.isRotated && bridgeData.Epoch+1 >= et.rotatedEpoch
+}
+
+func (et *epochTracker) setRotatedEpoch(epoch uint32) {
+	if !et.isAhead(epoch) {
+		return
+	}
+
+	et.isRotated = true
+	et.rotatedEpoch = epoch
+	log.Info("validator set rotated on-chain", "epoch", epoch)
+}
+
+func isConfirmed(entry *journal.Entry) bool {
+	for _, tx := range entry.Txs {
+		if tx.State != journal.TxConfirmed {
+			return false
+		}
+	}
+
+	return len(entry.Txs) != 0
+}
+
+// splitByEpoch returns the indexes of the bridge outgoing data which can be sent right away, separately from the ones
+// held until the validator set of their epoch is rotated. Bridge outgoing data of stale epochs is rejected, unless
+// already sent, so that retried requests still get the hashes of its txs. Validator set changes are journaled and
+// tracked first, so that bridge operations of the new epoch are held even if received before them. Journaled bridge
+// outgoing data of a stale epoch, whose txs were not built yet, is marked as failed.
+func (ts *txSender) splitByEpoch(data []*sovereign.BridgeOutGoingData, result *bridge.OperationsResult) ([]int, []int) {
+	journalFailed := ts.journalValidatorSetChanges(data, result)
+
+	ready := make([]int, 0, len(data))
+	held := make([]int, 0)
+	for idx, bridgeData := range data {
+		if _, failed := journalFailed[idx]; failed {
+			continue
+		}
+		if ts.isAlreadySent(bridgeData) {
+			ready = append(ready, idx)
+			continue
+		}
+
+		isHeld, err := ts.epochs.checkEpoch(bridgeData.Epoch)
+		if err != nil {
+			log.Error("rejected bridge operation", "hash", bridgeData.Hash, "error", err)
+			result.Results[idx] = createBridgeDataErrorResult(bridgeData.Hash, bridge.ErrorStage_Epoch, err)
+			if errors.Is(err, errStaleEpoch) {
+				ts.setStaleEntryFailed(bridgeData.Hash, err)
+			}
+			continue
+		}
+		if isHeld {
+			held = append(held, idx)
+			continue
+		}
+
+		ready = append(ready, idx)
+	}
+
+	return ready, held
+}
+
+// isAlreadySent returns true if all txs of the bridge outgoing data were already broadcast
+// journalValidatorSetChanges journals the validator set changes which are not of a stale epoch, then tracks them until
+// their tx outcome is known. Validator set changes which could not be journaled are reported, without being tracked,
+// since their txs are never sent, so they would hold the bridge operations of their new epoch forever. It returns the
+// indexes of the validator set changes which could not be journaled.
+func (ts *txSender) journalValidatorSetChanges(data []*sovereign.BridgeOutGoingData, result *bridge.OperationsResult) map[int]struct{} {
+	indexes := make([]int, 0)
+	changes := make([]*sovereign.BridgeOutGoingData, 0)
+	for idx, bridgeData := range data {
+		if !isValidatorSetChange(bridgeData) {
+			continue
+		}
+
+		_, err := ts.epochs.checkEpoch(bridgeData.Epoch)
+		if err == nil {
+			indexes = append(indexes, idx)
+			changes = append(changes, bridgeData)
+		}
+	}
+
+	journalFailed := make(map[int]struct{})
+	_, journalErrors := ts.journalBridgeData(changes)
+	for changeIdx, idx := range indexes {
+		err, failed := journalErrors[changeIdx]
+		if failed {
+			result.Results[idx] = createBridgeDataErrorResult(data[idx].Hash, bridge.ErrorStage_Journal, err)
+			journalFailed[idx] = struct{}{}
+			continue
+		}
+
+		ts.epochs.addValidatorSetChange(data[idx])
+	}
+
+	return journalFailed
+}
+
+func (ts *txSender) isAlreadySent(bridgeData *sovereign.BridgeOutGoingData) bool {
+	entries, isSubmitted := ts.getSubmittedEntries(bridgeData)
+	if !isSubmitted {
+		return false
+	}
+
+	for _, entry := range entries {
+		if !entry.IsFinished() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RegisterValidatorSetChangeHandler registers a handler notified once the tx of a validator set change is confirmed or
+// failed on-chain. Handlers are called in background, in the order of the tx outcomes.
+func (ts *txSender) RegisterValidatorSetChangeHandler(handler func(bridgeData *sovereign.BridgeOutGoingData, confirmed bool)) {
+	if handler == nil {
+		return
+	}
+
+	ts.mutHeld.Lock()
+	ts.validatorSetHandlers = append(ts.validatorSetHandlers, handler)
+	ts.mutHeld.Unlock()
+}
+
+// RegisterHeldDataHandler registers a handler notified once the bridge outgoing data held until the validator set of
+// its epoch is rotated is sent or rejected in background, with the outcome of sending its txs. Handlers are called in
+// background, in the order the held bridge outgoing data is released.
+func (ts *txSender) RegisterHeldDataHandler(handler func(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult)) {
+	if handler == nil {
+		return
+	}
+
+	ts.mutHeld.Lock()
+	ts.heldDataHandlers = append(ts.heldDataHandlers, handler)
+	ts.mutHeld.Unlock()
+}
+
+// notifyHeldData notifies the handlers of the held bridge outgoing data which is no longer held
+func (ts *txSender) notifyHeldData(data []*sovereign.BridgeOutGoingData, result *bridge.OperationsResult) {
+	ts.mutHeld.Lock()
+	handlers := ts.heldDataHandlers
+	ts.mutHeld.Unlock()
+
+	for idx, bridgeDataResult := range result.Results {
+		if bridgeDataResult == nil || bridgeDataResult.GetHeld() {
+			continue
+		}
+
+		for _, handler := range handlers {
+			handler(data[idx], bridgeDataResult)
+		}
+	}
+}
+
+// notifyValidatorSetChanges notifies the handlers of the validator set changes whose tx outcome is known
+func (ts *txSender) notifyValidatorSetChanges() {
+	ts.mutHeld.Lock()
+	finished := ts.finished
+	ts.finished = make(map[string]struct{})
+	handlers := ts.validatorSetHandlers
+	ts.mutHeld.Unlock()
+
+	for hash := range finished {
+		entry, found := ts.journal.Get([]byte(hash))
+		if !found || !entry.TxsBuilt {
+			continue
+		}
+
+		bridgeData, err := entry.BridgeOutGoingData()
+		if err != nil || !isValidatorSetChange(bridgeData) {
+			continue
+		}
+
+		confirmed := isConfirmed(entry)
+		if !confirmed && !entry.HasFailedTxs() {
+			continue
+		}
+
+		for _, handler := range handlers {
+			handler(bridgeData, confirmed)
+		}
+	}
+}
+
+// holdByEpoch journals the bridge outgoing data held until the validator set of its epoch is rotated, without building
+// its txs, so that it is sent in background once the validator set change of its epoch is confirmed, or resumed after
+// a restart
+func (ts *txSender) holdByEpoch(data []*sovereign.BridgeOutGoingData, held []int, result *bridge.OperationsResult) {
+	if len(held) == 0 {
+		return
+	}
+
+	heldData := make([]*sovereign.BridgeOutGoingData, 0, len(held))
+	for _, idx := range held {
+		heldData = append(heldData, data[idx])
+	}
+
+	_, journalErrors := ts.journalBridgeData(heldData)
+
+	ts.mutHeld.Lock()
+	defer ts.mutHeld.Unlock()
+
+	for heldIdx, idx := range held {
+		err, journalFailed := journalErrors[heldIdx]
+		if journalFailed {
+			result.Results[idx] = createBridgeDataErrorResult(data[idx].Hash, bridge.ErrorStage_Journal, err)
+			continue
+		}
+
+		log.Debug("bridge operation held until the validator set of its epoch is rotated", "hash", data[idx].Hash, "epoch", data[idx].Epoch)
+		ts.heldByEpoch[string(data[idx].Hash)] = struct{}{}
+		result.Results[idx] = &bridge.OutGoingDataResult{
+			Hash: data[idx].Hash,
+			Txs:  make([]*bridge.TxResult, 0),
+			Held: true,
+		}
+	}
+}
+
+// recheckHeld checks the held bridge outgoing data again in background, since the validator set change of its epoch
+// may have been confirmed before it was held
+func (ts *txSender) recheckHeld(held []int) {
+	if len(held) != 0 {
+		ts.signalReleased()
+	}
+}
+
+// releaseHeldByEpoch sends the held bridge outgoing data whose epoch's validator set was rotated. Bridge outgoing data
+// rejected in the meantime is no longer held, while the rest remains held until the next confirmed tx. The held data
+// handlers are notified of the bridge outgoing data which was sent or rejected.
+func (ts *txSender) releaseHeldByEpoch(ctx context.Context) {
+	ts.mutHeld.Lock()
+	held := ts.heldByEpoch
+	ts.heldByEpoch = make(map[string]struct{})
+	ts.mutHeld.Unlock()
+
+	data := make([]*sovereign.BridgeOutGoingData, 0, len(held))
+	for hash := range held {
+		bridgeData, found := ts.getHeldBridgeData([]byte(hash))
+		if found {
+			data = append(data, bridgeData)
+		}
+	}
+	if len(data) == 0 {
+		return
+	}
+
+	result := &bridge.OperationsResult{
+		Results: make([]*bridge.OutGoingDataResult, len(data)),
+	}
+	ready, stillHeld := ts.splitByEpoch(data, result)
+	ts.sendByPriority(ctx, data, ready, result)
+	ts.holdByEpoch(data, stillHeld, result)
+	ts.notifyHeldData(data, result)
+
+	err := result.Err()
+	if err != nil {
+		log.Error("could not send all released bridge operations", "error", err)
+	}
+	if len(result.TxHashes()) != 0 {
+		log.Debug("sent released bridge operations", "tx hashes", result.TxHashes())
+	}
+}
+
+// getHeldBridgeData returns the journaled bridge outgoing data, as long as its txs were not built in the meantime
+func (ts *txSender) getHeldBridgeData(hash []byte) (*sovereign.BridgeOutGoingData, bool) {
+	entry, found := ts.journal.Get(hash)
+	if !found || entry.TxsBuilt || entry.Failed {
+		return nil, false
+	}
+
+	bridgeData, err := entry.BridgeOutGoingData()
+	if err != nil {
+		log.Error("could not decode journaled bridge data", "hash", hash, "error", err)
+		ts.setEntryFailed(hash, err)
+		return nil, false
+	}
+
+	return bridgeData, true
+}
+
+// setStaleEntryFailed marks the journaled bridge outgoing data of a stale epoch as failed, as long as its txs were not
+// built, so that it is neither resumed nor released
+func (ts *txSender) setStaleEntryFailed(hash []byte, err error) {
+	entry, found := ts.journal.Get(hash)
+	if found && !entry.TxsBuilt && !entry.Failed {
+		ts.setEntryFailed(hash, err)
+	}
+}
+
+// splitUnfinishedByEpoch returns the indexes of the unfinished journaled entries which can be resumed right away. Entries
+// whose txs were not built yet go through the same epoch checks as received bridge outgoing data, so that entries of a
+// stale epoch are rejected, while entries of a newer epoch are held until the validator set of their epoch is rotated.
+func (ts *txSender) splitUnfinishedByEpoch(entries []*journal.Entry, result *bridge.OperationsResult) []int {
+	ready := make([]int, 0, len(entries))
+	unbuiltIndexes := make([]int, 0, len(entries))
+	unbuiltData := make([]*sovereign.BridgeOutGoingData, 0, len(entries))
+	for idx, entry := range entries {
+		if entry.TxsBuilt {
+			ready = append(ready, idx)
+			continue
+		}
+
+		bridgeData, err := entry.BridgeOutGoingData()
+		if err != nil {
+			// reported and marked as failed when its txs are prepared
+			ready = append(ready, idx)
+			continue
+		}
+
+		unbuiltIndexes = append(unbuiltIndexes, idx)
+		unbuiltData = append(unbuiltData, bridgeData)
+	}
+
+	unbuiltResult := &bridge.OperationsResult{
+		Results: make([]*bridge.OutGoingDataResult, len(unbuiltData)),
+	}
+	unbuiltReady, unbuiltHeld := ts.splitByEpoch(unbuiltData, unbuiltResult)
+	ts.holdByEpoch(unbuiltData, unbuiltHeld, unbuiltResult)
+	ts.recheckHeld(unbuiltHeld)
+	for _, unbuiltIdx := range unbuiltReady {
+		ready = append(ready, unbuiltIndexes[unbuiltIdx])
+	}
+	for unbuiltIdx, idx := range unbuiltIndexes {
+		if unbuiltResult.Results[unbuiltIdx] != nil {
+			result.Results[idx] = unbuiltResult.Results[unbuiltIdx]
+		}
+	}
+
+	// entries are resumed in their journaled order
+	sort.Ints(ready)
+
+	return ready
+}
@@ -0,0 +1,491 @@
+package txSender
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+func createEpochArgs(fileJournal Journal) (TxSenderArgs, func() []string) {
+	args := createArgs()
+	args.Journal = fileJournal
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			if isValidatorSetChange(bridgeData) {
+				return [][]byte{[]byte(changeValidatorSetPrefix + "@" + string(bridgeData.Hash))}, nil
+			}
+
+			return [][]byte{[]byte(executeDepositBridgeOpsPrefix + "@" + string(bridgeData.Hash))}, nil
+		},
+	}
+
+	mut := sync.Mutex{}
+	sentTxsData := make([]string, 0)
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			mut.Lock()
+			defer mut.Unlock()
+
+			hashes := make([]string, 0, len(txs))
+			for _, tx := range txs {
+				sentTxsData = append(sentTxsData, string(tx.Data))
+				hashes = append(hashes, "hash-"+string(tx.Data))
+			}
+
+			return hashes, nil
+		},
+	}
+	getSentTxsData := func() []string {
+		mut.Lock()
+		defer mut.Unlock()
+
+		return append([]string{}, sentTxsData...)
+	}
+
+	return args, getSentTxsData
+}
+
+func requireEpochError(t *testing.T, bridgeDataResult *bridge.OutGoingDataResult, expectedErr error) {
+	require.Equal(t, bridge.ErrorStage_Epoch, bridgeDataResult.Stage)
+	require.Contains(t, bridgeDataResult.Error, expectedErr.Error())
+	require.Empty(t, bridgeDataResult.Txs)
+}
+
+func TestTxSender_SendTxsEpochs(t *testing.T) {
+	t.Parallel()
+
+	validatorSetChange := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("validators"),
+		Type:  int32(block.OutGoingMbChangeValidatorSet),
+		Epoch: 4,
+	}
+	depositOfNewEpoch := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("deposit"),
+		Type:  int32(block.OutGoingMbDeposit),
+		Epoch: 5,
+	}
+	depositOfStaleEpoch := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("staleDeposit"),
+		Type:  int32(block.OutGoingMbDeposit),
+		Epoch: 4,
+	}
+
+	t.Run("bridge operations of the new epoch should be held and sent in background once the validator set change is confirmed", func(t *testing.T) {
+		fileJournal, err := journal.NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+
+		args, getSentTxsData := createEpochArgs(fileJournal)
+		var onTxOutcome func(bridgeDataHash []byte, txHash string, state journal.TxState)
+		args.TxTracker = &testscommon.TxTrackerMock{
+			RegisterHandlerCalled: func(handler func(bridgeDataHash []byte, txHash string, state journal.TxState)) {
+				onTxOutcome = handler
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		defer func() {
+			_ = ts.Close()
+		}()
+		releasedResults := make(chan *bridge.OutGoingDataResult, 1)
+		ts.RegisterHeldDataHandler(nil)
+		ts.RegisterHeldDataHandler(func(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult) {
+			require.Equal(t, depositOfNewEpoch.Hash, bridgeData.Hash)
+			releasedResults <- result
+		})
+
+		result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{depositOfNewEpoch, validatorSetChange},
+		})
+		require.Nil(t, result.Err())
+		require.Equal(t, []string{changeValidatorSetPrefix + "@validators"}, getSentTxsData())
+		require.Equal(t, depositOfNewEpoch.Hash, result.Results[0].Hash)
+		require.True(t, result.Results[0].Held)
+		require.Empty(t, result.Results[0].Txs)
+		require.Equal(t, validatorSetChange.Hash, result.Results[1].Hash)
+		require.False(t, result.Results[1].Held)
+
+		entry, found := fileJournal.Get(depositOfNewEpoch.Hash)
+		require.True(t, found)
+		require.False(t, entry.TxsBuilt)
+
+		// the tracker confirms the validator set change, which releases the bridge operations of the new epoch
+		txHash := "hash-" + changeValidatorSetPrefix + "@validators"
+		_ = fileJournal.SetTxState(validatorSetChange.Hash, txHash, journal.TxConfirmed)
+		onTxOutcome(validatorSetChange.Hash, txHash, journal.TxConfirmed)
+
+		require.Eventually(t, func() bool {
+			return len(getSentTxsData()) == 2
+		}, time.Second, time.Millisecond)
+		require.Equal(t, []string{
+			changeValidatorSetPrefix + "@validators",
+			executeDepositBridgeOpsPrefix + "@deposit",
+		}, getSentTxsData())
+
+		releasedResult := <-releasedResults
+		require.False(t, releasedResult.Held)
+		require.Equal(t, []string{"hash-" + executeDepositBridgeOpsPrefix + "@deposit"}, releasedResult.TxHashes())
+	})
+	t.Run("bridge operations of a stale epoch should be rejected", func(t *testing.T) {
+		fileJournal, err := journal.NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+
+		args, getSentTxsData := createEpochArgs(fileJournal)
+		args.TxTracker = createTrackerSettingTxState(fileJournal.SetTxState, journal.TxConfirmed)
+
+		ts, _ := NewTxSender(args)
+		result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{validatorSetChange},
+		})
+		require.Nil(t, result.Err())
+
+		result = ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{depositOfStaleEpoch, depositOfNewEpoch},
+		})
+		require.NotNil(t, result.Err())
+		requireEpochError(t, result.Results[0], errStaleEpoch)
+		require.Empty(t, result.Results[1].Error)
+		require.Equal(t, []string{
+			changeValidatorSetPrefix + "@validators",
+			executeDepositBridgeOpsPrefix + "@deposit",
+		}, getSentTxsData())
+
+		_, found := fileJournal.Get(depositOfStaleEpoch.Hash)
+		require.False(t, found)
+
+		// already sent validator set change should still return its tx hash
+		result = ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{validatorSetChange},
+		})
+		require.Nil(t, result.Err())
+		require.Equal(t, []string{"hash-" + changeValidatorSetPrefix + "@validators"}, result.TxHashes())
+		require.Len(t, getSentTxsData(), 2)
+	})
+	t.Run("failed validator set change should reject bridge operations of its epoch", func(t *testing.T) {
+		fileJournal, err := journal.NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+
+		args, getSentTxsData := createEpochArgs(fileJournal)
+		args.TxTracker = createTrackerSettingTxState(fileJournal.SetTxState, journal.TxFailed)
+
+		ts, _ := NewTxSender(args)
+		defer func() {
+			_ = ts.Close()
+		}()
+
+		result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{validatorSetChange, depositOfNewEpoch},
+		})
+		require.Nil(t, result.Err())
+		require.True(t, result.Results[1].Held)
+
+		// once received again, the bridge operations of the failed validator set change's epoch are rejected
+		result = ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{depositOfNewEpoch},
+		})
+		require.NotNil(t, result.Err())
+		requireEpochError(t, result.Results[0], errValidatorSetChangeFailed)
+		require.Equal(t, []string{changeValidatorSetPrefix + "@validators"}, getSentTxsData())
+	})
+	t.Run("resumed bridge operations should go through the epoch checks", func(t *testing.T) {
+		fileJournal, err := journal.NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+
+		args, getSentTxsData := createEpochArgs(fileJournal)
+		ts, _ := NewTxSender(args)
+		result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{validatorSetChange, depositOfNewEpoch},
+		})
+		require.Nil(t, result.Err())
+		require.True(t, result.Results[1].Held)
+		_ = ts.Close()
+
+		// after a restart, the unfinished bridge operations of the new epoch are held until the validator set change
+		// is confirmed
+		ts, _ = NewTxSender(args)
+		defer func() {
+			_ = ts.Close()
+		}()
+
+		result = ts.ResumeUnfinished(context.Background())
+		require.Nil(t, result.Err())
+		require.Len(t, result.Results, 1)
+		require.True(t, result.Results[0].Held)
+
+		// once the validator set change is confirmed, unfinished bridge operations of a stale epoch are rejected
+		txHash := "hash-" + changeValidatorSetPrefix + "@validators"
+		_ = fileJournal.SetTxState(validatorSetChange.Hash, txHash, journal.TxConfirmed)
+		require.Nil(t, fileJournal.Add(depositOfStaleEpoch))
+
+		// the held deposit is sent either by the resume or by the background recheck of held bridge operations
+		result = ts.ResumeUnfinished(context.Background())
+		require.NotNil(t, result.Err())
+		require.Eventually(t, func() bool {
+			return len(fileJournal.Unfinished()) == 0
+		}, time.Second, time.Millisecond)
+		require.Equal(t, []string{
+			changeValidatorSetPrefix + "@validators",
+			executeDepositBridgeOpsPrefix + "@deposit",
+		}, getSentTxsData())
+
+		entry, _ := fileJournal.Get(depositOfStaleEpoch.Hash)
+		require.True(t, entry.Failed)
+	})
+	t.Run("rotated epoch should be loaded from journal", func(t *testing.T) {
+		fileJournal, err := journal.NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+
+		args, getSentTxsData := createEpochArgs(fileJournal)
+		args.TxTracker = createTrackerSettingTxState(fileJournal.SetTxState, journal.TxConfirmed)
+
+		ts, _ := NewTxSender(args)
+		result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{validatorSetChange},
+		})
+		require.Nil(t, result.Err())
+
+		ts, _ = NewTxSender(args)
+		result = ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{depositOfStaleEpoch},
+		})
+		requireEpochError(t, result.Results[0], errStaleEpoch)
+		require.Len(t, getSentTxsData(), 1)
+	})
+}
+
+func TestTxSender_ValidatorSetChangeHandlers(t *testing.T) {
+	t.Parallel()
+
+	validatorSetChange := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("validators"),
+		Type:  int32(block.OutGoingMbChangeValidatorSet),
+		Epoch: 4,
+	}
+	deposit := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("deposit"),
+		Type:  int32(block.OutGoingMbDeposit),
+		Epoch: 4,
+	}
+
+	for _, state := range []journal.TxState{journal.TxConfirmed, journal.TxFailed} {
+		fileJournal, err := journal.NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+
+		args, _ := createEpochArgs(fileJournal)
+		args.TxTracker = createTrackerSettingTxState(fileJournal.SetTxState, state)
+
+		ts, _ := NewTxSender(args)
+		mut := sync.Mutex{}
+		notified := make(map[string]bool)
+		ts.RegisterValidatorSetChangeHandler(nil)
+		ts.RegisterValidatorSetChangeHandler(func(bridgeData *sovereign.BridgeOutGoingData, confirmed bool) {
+			mut.Lock()
+			notified[string(bridgeData.Hash)] = confirmed
+			mut.Unlock()
+		})
+
+		result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{validatorSetChange, deposit},
+		})
+		require.Len(t, result.TxHashes(), 2)
+
+		// only the validator set change is notified, once its tx outcome is known
+		require.Eventually(t, func() bool {
+			mut.Lock()
+			defer mut.Unlock()
+
+			return len(notified) == 1
+		}, time.Second, time.Millisecond)
+		mut.Lock()
+		require.Equal(t, map[string]bool{"validators": state == journal.TxConfirmed}, notified)
+		mut.Unlock()
+
+		_ = ts.Close()
+	}
+}
+
+func TestTxSender_AcceptEpochs(t *testing.T) {
+	t.Parallel()
+
+	fileJournal, err := journal.NewFileJournal(t.TempDir())
+	require.Nil(t, err)
+
+	args, _ := createEpochArgs(fileJournal)
+	args.TxTracker = createTrackerSettingTxState(fileJournal.SetTxState, journal.TxConfirmed)
+
+	validatorSetChange := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("validators"),
+		Type:  int32(block.OutGoingMbChangeValidatorSet),
+		Epoch: 1,
+	}
+	ts, _ := NewTxSender(args)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{validatorSetChange},
+	})
+	require.Nil(t, result.Err())
+
+	staleDeposit := &sovereign.BridgeOutGoingData{Hash: []byte("staleDeposit"), Epoch: 1}
+	deposit := &sovereign.BridgeOutGoingData{Hash: []byte("deposit"), Epoch: 2}
+	result = ts.Accept(&sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{staleDeposit, validatorSetChange, deposit},
+	})
+	requireEpochError(t, result.Results[0], errStaleEpoch)
+	require.Empty(t, result.Results[1].Error)
+	require.Empty(t, result.Results[2].Error)
+
+	_, found := fileJournal.Get(staleDeposit.Hash)
+	require.False(t, found)
+	_, found = fileJournal.Get(deposit.Hash)
+	require.True(t, found)
+
+	// the accepted validator set change is tracked, so bridge operations of its new epoch are held until it is confirmed
+	nextValidatorSetChange := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("nextValidators"),
+		Type:  int32(block.OutGoingMbChangeValidatorSet),
+		Epoch: 2,
+	}
+	result = ts.Accept(&sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{nextValidatorSetChange},
+	})
+	require.Nil(t, result.Err())
+
+	result = ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("nextDeposit"), Epoch: 3}},
+	})
+	require.Nil(t, result.Err())
+	require.True(t, result.Results[0].Held)
+}
+
+func TestTxSender_ValidatorSetChangeJournalError(t *testing.T) {
+	t.Parallel()
+
+	errJournal := errors.New("journal error")
+	validatorSetChange := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("validators"),
+		Type:  int32(block.OutGoingMbChangeValidatorSet),
+		Epoch: 4,
+	}
+	depositOfNewEpoch := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("deposit"),
+		Type:  int32(block.OutGoingMbDeposit),
+		Epoch: 5,
+	}
+
+	args, getSentTxsData := createEpochArgs(&testscommon.JournalMock{
+		AddCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+			if isValidatorSetChange(bridgeData) {
+				return errJournal
+			}
+			return nil
+		},
+	})
+	ts, _ := NewTxSender(args)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	// the validator set change which could not be journaled is never sent, so it does not hold its new epoch
+	result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{validatorSetChange, depositOfNewEpoch},
+	})
+	require.NotNil(t, result.Err())
+	require.Equal(t, bridge.ErrorStage_Journal, result.Results[0].Stage)
+	require.Equal(t, errJournal.Error(), result.Results[0].Error)
+	require.False(t, result.Results[1].Held)
+	require.Equal(t, []string{executeDepositBridgeOpsPrefix + "@deposit"}, getSentTxsData())
+
+	result = ts.Accept(&sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{validatorSetChange},
+	})
+	require.Equal(t, bridge.ErrorStage_Journal, result.Results[0].Stage)
+
+	result = ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("nextDeposit"), Epoch: 5}},
+	})
+	require.Nil(t, result.Err())
+	require.False(t, result.Results[0].Held)
+}
+
+func TestTxSender_PruneJournal(t *testing.T) {
+	t.Parallel()
+
+	fileJournal, err := journal.NewFileJournal(t.TempDir())
+	require.Nil(t, err)
+
+	args, getSentTxsData := createEpochArgs(fileJournal)
+	args.TxTracker = createTrackerSettingTxState(fileJournal.SetTxState, journal.TxConfirmed)
+	args.JournalRetention = time.Millisecond
+	args.JournalHistorySize = 10
+
+	oldValidatorSetChange := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("oldValidators"),
+		Type:  int32(block.OutGoingMbChangeValidatorSet),
+		Epoch: 3,
+	}
+	validatorSetChange := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("validators"),
+		Type:  int32(block.OutGoingMbChangeValidatorSet),
+		Epoch: 4,
+	}
+	deposit := &sovereign.BridgeOutGoingData{
+		Hash:  []byte("deposit"),
+		Type:  int32(block.OutGoingMbDeposit),
+		Epoch: 5,
+	}
+
+	ts, _ := NewTxSender(args)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{oldValidatorSetChange, validatorSetChange, deposit},
+	})
+	require.Nil(t, result.Err())
+
+	// the deposit of the new epoch is sent in background, once the validator set change is confirmed
+	require.Eventually(t, func() bool {
+		entry, _ := fileJournal.Get(deposit.Hash)
+		return isConfirmed(entry)
+	}, time.Second, time.Millisecond)
+
+	time.Sleep(time.Millisecond * 5)
+	ts.pruneJournal()
+
+	entries := fileJournal.Entries()
+	require.Len(t, entries, 1)
+	require.Equal(t, validatorSetChange.Hash, entries[0].Hash)
+
+	// pruned bridge operations received again should not be sent again
+	result = ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{deposit},
+	})
+	require.Nil(t, result.Err())
+	require.Equal(t, []string{"hash-" + executeDepositBridgeOpsPrefix + "@deposit"}, result.TxHashes())
+	require.Len(t, getSentTxsData(), 3)
+
+	// rotated epoch should still be known after a restart
+	reloadedTs, _ := NewTxSender(args)
+	defer func() {
+		_ = reloadedTs.Close()
+	}()
+
+	result = reloadedTs.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("staleDeposit"), Epoch: 4}},
+	})
+	requireEpochError(t, result.Results[0], errStaleEpoch)
+}
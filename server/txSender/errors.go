@@ -8,12 +8,18 @@ var errNilWallet = errors.New("nil wallet provided")
 
 var errNilProxy = errors.New("nil proxy provided")
 
-var errNilTxInteractor = errors.New("nil tx interactor provided")
-
 var errNilDataFormatter = errors.New("nil data formatter provided")
 
 var errNilNonceHandler = errors.New("nil nonce handler provided")
 
+var errNilTxHashComputer = errors.New("nil tx hash computer provided")
+
+var errInvalidIntervalToSend = errors.New("invalid interval to send provided")
+
+var errNilJournal = errors.New("nil journal provided")
+
+var errNilTxTracker = errors.New("nil tx tracker provided")
+
 var errNoHeaderVerifierSCAddress = errors.New("no header verifier sc address provided")
 
 var errNoEsdtSafeSCAddress = errors.New("no esdt safe sc address provided")
@@ -25,3 +31,79 @@ var errNoChainConfigSCAddress = errors.New("no chain config sc address provided"
 var errInvalidBridgeDataSetValidatorChange = errors.New("invalid number of bridge data operations for validator set change")
 
 var errInvalidTxDataPrefix = errors.New("invalid/unknown tx data endpoint to call")
+
+var errEmptyEndpointPrefix = errors.New("empty endpoint prefix in routing table")
+
+var errDuplicatedEndpointPrefix = errors.New("duplicated endpoint prefix in routing table")
+
+var errUnroutedEndpointPrefix = errors.New("endpoint prefix not routed in routing table")
+
+var errNoEndpointReceiver = errors.New("no endpoint receiver address provided")
+
+var errInvalidEndpointGasLimit = errors.New("invalid endpoint gas limit, should be positive and not exceed max gas limit")
+
+var errInvalidEndpointValue = errors.New("invalid endpoint value, should be a non-negative integer")
+
+var errNilGasEstimator = errors.New("nil gas estimator provided")
+
+var errInvalidGasMultiplier = errors.New("invalid gas estimation multiplier provided")
+
+var errGasEstimationFailed = errors.New("gas estimation failed")
+
+var errJournalEntryNotFound = errors.New("journal entry not found")
+
+var errUnknownBridgeDataType = errors.New("unknown bridge data type")
+
+var errNilTxDataFormatter = errors.New("nil tx data formatter provided")
+
+var errDuplicatedBridgeDataType = errors.New("duplicated data formatter for bridge data type")
+
+var errInvalidMaxRetryAttempts = errors.New("invalid max retry attempts provided")
+
+var errInvalidRetryBackoff = errors.New("invalid retry backoff provided")
+
+var errInvalidStuckTxTimeout = errors.New("invalid stuck tx timeout provided")
+
+var errInvalidGasPriceBump = errors.New("invalid gas price bump percentage provided")
+
+var errInvalidMaxGasPrice = errors.New("invalid max gas price provided")
+
+var errStuckTxNotFound = errors.New("stuck tx not found in journal")
+
+var errMaxGasPriceReached = errors.New("max gas price reached")
+
+var errNilWalletPool = errors.New("nil wallet pool provided")
+
+var errNoWallets = errors.New("no wallets provided")
+
+var errDuplicatedWallet = errors.New("duplicated wallet provided")
+
+var errInvalidWalletsRefreshInterval = errors.New("invalid wallets refresh interval provided")
+
+var errWalletNotFound = errors.New("wallet not found in pool")
+
+var errNetworkConfigNotLoaded = errors.New("network config not loaded")
+
+var errProxyUnreachable = errors.New("proxy unreachable")
+
+var errInvalidMaxBatchSize = errors.New("invalid max batch size provided")
+
+var errInvalidJournalRetention = errors.New("invalid journal retention provided")
+
+var errInvalidJournalHistorySize = errors.New("invalid journal history size provided")
+
+var errBridgeDataFailed = errors.New("bridge data previously failed, its txs cannot be built")
+
+var errTxsNotAccepted = errors.New("txs not accepted by the network")
+
+var errPreviousTxNotSent = errors.New("tx not sent, since a previous tx of the same wallet could not be sent")
+
+var errDependencyNotSent = errors.New("tx not sent, since the tx it depends on could not be sent")
+
+var errDependencyFailed = errors.New("tx cancelled, since the tx it depends on failed")
+
+var errDuplicatedTypePriority = errors.New("duplicated bridge outgoing data type priority")
+
+var errStaleEpoch = errors.New("bridge operation rejected, since the validator set of a later epoch was already rotated on-chain")
+
+var errValidatorSetChangeFailed = errors.New("bridge operation rejected, since the validator set change of its epoch failed")
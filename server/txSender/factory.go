@@ -8,12 +8,21 @@ import (
 	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
 	"github.com/multiversx/mx-sdk-go/builders"
 	"github.com/multiversx/mx-sdk-go/core"
-	"github.com/multiversx/mx-sdk-go/interactors"
-	"github.com/multiversx/mx-sdk-go/interactors/nonceHandlerV3"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/tracker"
 )
 
-// CreateTxSender creates a new transactions sender
-func CreateTxSender(wallet core.CryptoComponentsHolder, cfg TxSenderConfig) (*txSender, error) {
+// CreateTxSender creates a new transactions sender, which distributes bridge txs across the wallets of the provided signers.
+// Bridge outgoing data types of the provided registrations are formatted by their own formatters, while their endpoints
+// are added to the routing table.
+func CreateTxSender(signers []TxSigner, cfg TxSenderConfig, registrations ...DataFormatterRegistration) (*txSender, error) {
+	routingTable, err := createConfiguredRoutingTable(cfg)
+	if err != nil {
+		return nil, err
+	}
+	routingTable = append(routingTable, getRegisteredEndpoints(registrations)...)
+
 	args := blockchain.ArgsProxy{
 		ProxyURL:            cfg.Proxy,
 		Client:              nil,
@@ -28,43 +37,109 @@ func CreateTxSender(wallet core.CryptoComponentsHolder, cfg TxSenderConfig) (*tx
 		return nil, err
 	}
 
-	nonceHandler, err := nonceHandlerV3.NewNonceTransactionHandlerV3(nonceHandlerV3.ArgsNonceTransactionsHandlerV3{
+	txBuilder, err := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	if err != nil {
+		return nil, err
+	}
+
+	nonceHandler, err := NewNonceSender(ArgsNonceSender{
 		Proxy:          proxy,
+		TxHashComputer: txBuilder,
 		IntervalToSend: time.Millisecond * time.Duration(cfg.IntervalToSend),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	txBuilder, err := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	hasher, err := factory.NewHasher(cfg.Hasher)
 	if err != nil {
 		return nil, err
 	}
 
-	ti, err := interactors.NewTransactionInteractor(proxy, txBuilder)
+	dtaFormatter, err := NewDataFormatter(hasher, registrations...)
 	if err != nil {
 		return nil, err
 	}
 
-	hasher, err := factory.NewHasher(cfg.Hasher)
+	gasEstimator, err := NewGasEstimator(ArgsGasEstimator{
+		Proxy:      proxy,
+		Multiplier: cfg.GasEstimationMultiplier,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	dtaFormatter, err := NewDataFormatter(hasher)
+	outboxJournal, err := journal.NewFileJournal(cfg.JournalDir)
+	if err != nil {
+		return nil, err
+	}
+
+	txTracker, err := tracker.NewTxTracker(tracker.ArgsTxTracker{
+		Proxy:        proxy,
+		Journal:      outboxJournal,
+		PollInterval: time.Millisecond * time.Duration(cfg.StatusPollInterval),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	walletPool, err := NewWalletPool(ArgsWalletPool{
+		Wallets:         signers,
+		Proxy:           proxy,
+		RefreshInterval: time.Millisecond * time.Duration(cfg.StatusPollInterval),
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return NewTxSender(TxSenderArgs{
-		Wallet:                    wallet,
-		Proxy:                     proxy,
-		TxInteractor:              ti,
-		TxNonceHandler:            nonceHandler,
-		DataFormatter:             dtaFormatter,
-		SCHeaderVerifierAddress:   cfg.HeaderVerifierSCAddress,
-		SCChainConfigAddress:      cfg.ChainConfigSCAddress,
-		SCEsdtSafeAddress:         cfg.EsdtSafeSCAddress,
-		SCChangeValidatorsAddress: cfg.ChangeValidatorsSCAddress,
+		WalletPool:     walletPool,
+		Proxy:          proxy,
+		TxNonceHandler: nonceHandler,
+		TxHashComputer: txBuilder,
+		DataFormatter:  dtaFormatter,
+		GasEstimator:   gasEstimator,
+		Journal:        outboxJournal,
+		TxTracker:      txTracker,
+		RetryPolicy: RetryPolicy{
+			MaxAttempts:            cfg.MaxRetryAttempts,
+			Backoff:                time.Millisecond * time.Duration(cfg.RetryBackoff),
+			GasPriceBumpPercentage: cfg.GasPriceBumpPercentage,
+			MaxGasPrice:            cfg.MaxGasPrice,
+			StuckTxTimeout:         time.Millisecond * time.Duration(cfg.StuckTxTimeout),
+		},
+		RoutingTable:   routingTable,
+		TypePriorities: cfg.TypePriorities,
+		MaxBatchSize:   cfg.MaxBatchSize,
+		DryRun:         cfg.DryRun,
+
+		JournalRetention:   time.Millisecond * time.Duration(cfg.JournalRetention),
+		JournalHistorySize: cfg.JournalHistorySize,
 	})
 }
+
+// createConfiguredRoutingTable loads the routing table from the configured file, falling back to the default sovereign contracts
+// deployment, if no file is configured
+func createConfiguredRoutingTable(cfg TxSenderConfig) ([]EndpointConfig, error) {
+	if len(cfg.RoutingTableFile) != 0 {
+		return LoadRoutingTable(cfg.RoutingTableFile)
+	}
+
+	return NewDefaultRoutingTable(ArgsDefaultRoutingTable{
+		HeaderVerifierSCAddress:   cfg.HeaderVerifierSCAddress,
+		EsdtSafeSCAddress:         cfg.EsdtSafeSCAddress,
+		ChangeValidatorsSCAddress: cfg.ChangeValidatorsSCAddress,
+		ChainConfigSCAddress:      cfg.ChainConfigSCAddress,
+	})
+}
+
+// getRegisteredEndpoints returns the endpoints called by the txs of all registered data formatters. Endpoints which are
+// already routed are reported as duplicated when the routing table is validated.
+func getRegisteredEndpoints(registrations []DataFormatterRegistration) []EndpointConfig {
+	endpoints := make([]EndpointConfig, 0)
+	for _, registration := range registrations {
+		endpoints = append(endpoints, registration.Endpoints...)
+	}
+
+	return endpoints
+}
@@ -0,0 +1,62 @@
+package txSender
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+const minGasMultiplier = 1.0
+
+// ArgsGasEstimator holds args to create a new gas estimator
+type ArgsGasEstimator struct {
+	Proxy      Proxy
+	Multiplier float64
+}
+
+type gasEstimator struct {
+	proxy      Proxy
+	multiplier float64
+}
+
+// NewGasEstimator creates a gas estimator which simulates txs using the proxy's cost endpoint
+func NewGasEstimator(args ArgsGasEstimator) (*gasEstimator, error) {
+	if check.IfNil(args.Proxy) {
+		return nil, errNilProxy
+	}
+	if args.Multiplier < minGasMultiplier {
+		return nil, fmt.Errorf("%w, provided: %f, min: %f", errInvalidGasMultiplier, args.Multiplier, minGasMultiplier)
+	}
+
+	return &gasEstimator{
+		proxy:      args.Proxy,
+		multiplier: args.Multiplier,
+	}, nil
+}
+
+// EstimateGas returns the gas needed to execute the provided tx, increased by the safety multiplier
+func (ge *gasEstimator) EstimateGas(ctx context.Context, tx *transaction.FrontendTransaction) (uint64, error) {
+	txCopy := *tx
+	txCopy.GasLimit = 0
+	txCopy.Signature = ""
+
+	cost, err := ge.proxy.RequestTransactionCost(ctx, &txCopy)
+	if err != nil {
+		return 0, err
+	}
+	if len(cost.RetMessage) != 0 {
+		return 0, fmt.Errorf("%w: %s", errGasEstimationFailed, cost.RetMessage)
+	}
+	if cost.TxCost == 0 {
+		return 0, fmt.Errorf("%w: zero gas units estimated", errGasEstimationFailed)
+	}
+
+	return uint64(float64(cost.TxCost) * ge.multiplier), nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (ge *gasEstimator) IsInterfaceNil() bool {
+	return ge == nil
+}
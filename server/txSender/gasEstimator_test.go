@@ -0,0 +1,115 @@
+package txSender
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+func TestNewGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy", func(t *testing.T) {
+		ge, err := NewGasEstimator(ArgsGasEstimator{
+			Proxy:      nil,
+			Multiplier: 1.5,
+		})
+		require.Equal(t, errNilProxy, err)
+		require.Nil(t, ge)
+	})
+	t.Run("invalid multiplier", func(t *testing.T) {
+		ge, err := NewGasEstimator(ArgsGasEstimator{
+			Proxy:      &testscommon.ProxyMock{},
+			Multiplier: 0.9,
+		})
+		require.ErrorIs(t, err, errInvalidGasMultiplier)
+		require.Nil(t, ge)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ge, err := NewGasEstimator(ArgsGasEstimator{
+			Proxy:      &testscommon.ProxyMock{},
+			Multiplier: 1,
+		})
+		require.Nil(t, err)
+		require.False(t, ge.IsInterfaceNil())
+	})
+}
+
+func TestGasEstimator_EstimateGas(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.FrontendTransaction{
+		Nonce:     4,
+		GasLimit:  gasLimitDefault,
+		Data:      []byte("executeBridgeOps@01"),
+		Signature: "signature",
+	}
+
+	t.Run("proxy error", func(t *testing.T) {
+		errProxy := errors.New("proxy error")
+		ge, _ := NewGasEstimator(ArgsGasEstimator{
+			Proxy: &testscommon.ProxyMock{
+				RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+					return nil, errProxy
+				},
+			},
+			Multiplier: 1.5,
+		})
+
+		gas, err := ge.EstimateGas(context.Background(), tx)
+		require.Equal(t, errProxy, err)
+		require.Zero(t, gas)
+	})
+	t.Run("simulation failed", func(t *testing.T) {
+		ge, _ := NewGasEstimator(ArgsGasEstimator{
+			Proxy: &testscommon.ProxyMock{
+				RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+					return &data.TxCostResponseData{RetMessage: "invalid signature"}, nil
+				},
+			},
+			Multiplier: 1.5,
+		})
+
+		gas, err := ge.EstimateGas(context.Background(), tx)
+		require.ErrorIs(t, err, errGasEstimationFailed)
+		require.Contains(t, err.Error(), "invalid signature")
+		require.Zero(t, gas)
+	})
+	t.Run("zero gas estimated", func(t *testing.T) {
+		ge, _ := NewGasEstimator(ArgsGasEstimator{
+			Proxy:      &testscommon.ProxyMock{},
+			Multiplier: 1.5,
+		})
+
+		gas, err := ge.EstimateGas(context.Background(), tx)
+		require.ErrorIs(t, err, errGasEstimationFailed)
+		require.Zero(t, gas)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ge, _ := NewGasEstimator(ArgsGasEstimator{
+			Proxy: &testscommon.ProxyMock{
+				RequestTransactionCostCalled: func(ctx context.Context, simulatedTx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+					require.Zero(t, simulatedTx.GasLimit)
+					require.Empty(t, simulatedTx.Signature)
+					require.Equal(t, tx.Nonce, simulatedTx.Nonce)
+					require.Equal(t, tx.Data, simulatedTx.Data)
+
+					return &data.TxCostResponseData{TxCost: 10_000_000}, nil
+				},
+			},
+			Multiplier: 1.5,
+		})
+
+		gas, err := ge.EstimateGas(context.Background(), tx)
+		require.Nil(t, err)
+		require.Equal(t, uint64(15_000_000), gas)
+		require.Equal(t, uint64(gasLimitDefault), tx.GasLimit)
+		require.Equal(t, "signature", tx.Signature)
+	})
+}
@@ -2,16 +2,22 @@ package txSender
 
 import (
 	"context"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
 )
 
-// TxInteractor defines a tx interactor with multiversx blockchain
-type TxInteractor interface {
-	ApplyUserSignature(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+// TxSigner defines a signer of bridge txs, holding the private key of a wallet either in-process or remotely
+type TxSigner interface {
+	GetBech32() string
+	GetAddressHandler() core.AddressHandler
+	SignTx(ctx context.Context, tx *transaction.FrontendTransaction) error
 	IsInterfaceNil() bool
 }
 
@@ -19,22 +25,80 @@ type TxInteractor interface {
 type Proxy interface {
 	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
 	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error)
+	SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error)
+	GetLatestHyperBlockNonce(ctx context.Context) (uint64, error)
 	IsInterfaceNil() bool
 }
 
 // DataFormatter should format txs data for bridge operations
 type DataFormatter interface {
 	CreateTxsData(data *sovereign.BridgeOperations) [][]byte
+	CreateBridgeDataTxsData(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error)
 	IsInterfaceNil() bool
 }
 
 // TxNonceSenderHandler should handle nonce management and tx interactions
 type TxNonceSenderHandler interface {
 	ApplyNonceAndGasPrice(ctx context.Context, txs ...*transaction.FrontendTransaction) error
+	ReserveNonces(ctx context.Context, txs ...*transaction.FrontendTransaction) error
+	ReleaseNonces(txs ...*transaction.FrontendTransaction)
 	SendTransactions(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error)
 	IsInterfaceNil() bool
 }
 
-type txDataFormatter interface {
-	createTxsData(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error)
+// TxHashComputer should compute the hash of a signed tx
+type TxHashComputer interface {
+	ComputeTxHash(tx *transaction.FrontendTransaction) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// GasEstimator should estimate the gas needed to execute a tx
+type GasEstimator interface {
+	EstimateGas(ctx context.Context, tx *transaction.FrontendTransaction) (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// Journal defines an outbox journal which persists received bridge operations and the state of their txs
+type Journal interface {
+	Add(bridgeData *sovereign.BridgeOutGoingData) error
+	SetTxsData(hash []byte, txsData [][]byte) error
+	SetFailed(hash []byte, reason string) error
+	UpdateTx(hash []byte, txIndex int, tx *journal.TxRecord) error
+	ResetFailedTxs(hash []byte) error
+	Get(hash []byte) (*journal.Entry, bool)
+	GetByOperation(operationHash []byte) (*journal.Entry, bool)
+	Unfinished() []*journal.Entry
+	Entries() []*journal.Entry
+	Prune(completedBefore time.Time, historySize int, keep func(entry *journal.Entry) bool) (int, error)
+	IsInterfaceNil() bool
+}
+
+// WalletPool defines a pool of wallets across which bridge txs are distributed
+type WalletPool interface {
+	Select() TxSigner
+	Acquire(address string) (TxSigner, bool)
+	Release(address string)
+	Get(address string) (TxSigner, bool)
+	TxSent(address string, nonce uint64)
+	GetStatus() []*results.WalletStatus
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TxTracker defines a tracker which follows sent bridge txs until their outcome is final
+type TxTracker interface {
+	Track(bridgeDataHash []byte, txHash string)
+	Replace(bridgeDataHash []byte, oldTxHash string, newTxHash string)
+	SetCancelled(bridgeDataHash []byte, reason string)
+	RegisterHandler(handler func(bridgeDataHash []byte, txHash string, state journal.TxState))
+	GetStuckTxs(pendingFor time.Duration) []*results.PendingTx
+	GetOperationResult(bridgeDataHash []byte) (*results.OperationResult, bool)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TxDataFormatter should format the txs data of a single bridge outgoing data type
+type TxDataFormatter interface {
+	CreateTxsData(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error)
 }
@@ -0,0 +1,227 @@
+package txSender
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ArgsNonceSender holds args to create a new nonce sender
+type ArgsNonceSender struct {
+	Proxy          Proxy
+	TxHashComputer TxHashComputer
+	IntervalToSend time.Duration
+}
+
+type nonceSender struct {
+	proxy          Proxy
+	txHashComputer TxHashComputer
+	intervalToSend time.Duration
+
+	mutNonces     sync.Mutex
+	nextNonces    map[string]uint64
+	resyncSenders map[string]struct{}
+
+	mutSend  sync.Mutex
+	lastSend time.Time
+}
+
+// NewNonceSender creates a nonce sender which assigns consecutive nonces to the txs of each sender and broadcasts txs
+// in a single multi-send request. The next nonce of a sender is fetched from the network the first time it is needed,
+// as well as after a tx of the sender was not accepted, since its nonce may have drifted from the on-chain one. Nonces
+// of txs which end up not being sent are released, so that no nonce gaps are created.
+func NewNonceSender(args ArgsNonceSender) (*nonceSender, error) {
+	if check.IfNil(args.Proxy) {
+		return nil, errNilProxy
+	}
+	if check.IfNil(args.TxHashComputer) {
+		return nil, errNilTxHashComputer
+	}
+	if args.IntervalToSend < 0 {
+		return nil, errInvalidIntervalToSend
+	}
+
+	return &nonceSender{
+		proxy:          args.Proxy,
+		txHashComputer: args.TxHashComputer,
+		intervalToSend: args.IntervalToSend,
+		nextNonces:     make(map[string]uint64),
+		resyncSenders:  make(map[string]struct{}),
+	}, nil
+}
+
+// ApplyNonceAndGasPrice assigns the next nonces of their senders to the txs, in order, and raises their gas price to
+// the network's min gas price
+func (ns *nonceSender) ApplyNonceAndGasPrice(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+	netConfigs, err := ns.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	ns.mutNonces.Lock()
+	defer ns.mutNonces.Unlock()
+
+	for _, tx := range txs {
+		nonce, err := ns.getNextNonce(ctx, tx.Sender)
+		if err != nil {
+			return err
+		}
+
+		tx.Nonce = nonce
+		tx.GasPrice = max(tx.GasPrice, netConfigs.MinGasPrice)
+		ns.nextNonces[tx.Sender] = nonce + 1
+	}
+
+	return nil
+}
+
+// getNextNonce returns the cached next nonce of the sender. The on-chain nonce is fetched if none is cached yet or if the
+// sender should be resynced, in which case the larger of the cached and on-chain nonces is returned, since the cached
+// one already accounts for the txs which are not executed yet.
+func (ns *nonceSender) getNextNonce(ctx context.Context, sender string) (uint64, error) {
+	nonce, found := ns.nextNonces[sender]
+	_, shouldResync := ns.resyncSenders[sender]
+	if found && !shouldResync {
+		return nonce, nil
+	}
+
+	address, err := data.NewAddressFromBech32String(sender)
+	if err != nil {
+		return 0, err
+	}
+
+	account, err := ns.proxy.GetAccount(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+
+	delete(ns.resyncSenders, sender)
+	if nonce > account.Nonce {
+		log.Debug("cached nonce ahead of the on-chain nonce", "sender", sender, "nonce", nonce, "on-chain nonce", account.Nonce)
+		return nonce, nil
+	}
+
+	return account.Nonce, nil
+}
+
+// resyncNonces marks the senders of the txs which were not accepted, so that their next nonce is checked against the
+// on-chain one
+func (ns *nonceSender) resyncNonces(txs []*transaction.FrontendTransaction) {
+	ns.mutNonces.Lock()
+	defer ns.mutNonces.Unlock()
+
+	for _, tx := range txs {
+		ns.resyncSenders[tx.Sender] = struct{}{}
+	}
+}
+
+// ReserveNonces marks the nonces of the txs, which were assigned before the process stopped, as taken, so that the next
+// nonce of each sender follows them
+func (ns *nonceSender) ReserveNonces(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+	ns.mutNonces.Lock()
+	defer ns.mutNonces.Unlock()
+
+	for _, tx := range txs {
+		nonce, err := ns.getNextNonce(ctx, tx.Sender)
+		if err != nil {
+			return err
+		}
+
+		ns.nextNonces[tx.Sender] = max(nonce, tx.Nonce+1)
+	}
+
+	return nil
+}
+
+// ReleaseNonces hands out again the nonces of the txs, which were assigned but are not going to be sent. The next nonce
+// of each sender is set back to the lowest released nonce.
+func (ns *nonceSender) ReleaseNonces(txs ...*transaction.FrontendTransaction) {
+	ns.mutNonces.Lock()
+	defer ns.mutNonces.Unlock()
+
+	for _, tx := range txs {
+		nextNonce, found := ns.nextNonces[tx.Sender]
+		if found && tx.Nonce < nextNonce {
+			ns.nextNonces[tx.Sender] = tx.Nonce
+		}
+	}
+}
+
+// SendTransactions broadcasts the signed txs in a single multi-send request. The returned hashes follow the order of
+// the txs, with an empty hash for each tx which was not accepted by the network. Since the proxy only reports the
+// accepted txs, they are matched by their locally computed hashes. The nonces of the senders of the txs which were not
+// accepted are resynced before being assigned again.
+func (ns *nonceSender) SendTransactions(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+	hashes := make([]string, len(txs))
+	if len(txs) == 0 {
+		return hashes, nil
+	}
+
+	err := ns.waitIntervalToSend(ctx)
+	if err != nil {
+		return hashes, err
+	}
+
+	sentHashes, err := ns.proxy.SendTransactions(ctx, txs)
+	if err != nil {
+		ns.resyncNonces(txs)
+		return hashes, err
+	}
+
+	accepted := make(map[string]struct{}, len(sentHashes))
+	for _, sentHash := range sentHashes {
+		accepted[sentHash] = struct{}{}
+	}
+
+	notAccepted := make([]*transaction.FrontendTransaction, 0)
+	for idx, tx := range txs {
+		txHash, errHash := ns.txHashComputer.ComputeTxHash(tx)
+		if errHash != nil {
+			log.Warn("could not compute tx hash", "nonce", tx.Nonce, "error", errHash)
+			notAccepted = append(notAccepted, tx)
+			continue
+		}
+
+		hexTxHash := hex.EncodeToString(txHash)
+		if _, found := accepted[hexTxHash]; !found {
+			notAccepted = append(notAccepted, tx)
+			continue
+		}
+
+		hashes[idx] = hexTxHash
+	}
+	ns.resyncNonces(notAccepted)
+
+	return hashes, nil
+}
+
+// waitIntervalToSend spaces out consecutive multi-send requests by the interval to send
+func (ns *nonceSender) waitIntervalToSend(ctx context.Context) error {
+	ns.mutSend.Lock()
+	defer ns.mutSend.Unlock()
+
+	wait := ns.intervalToSend - time.Since(ns.lastSend)
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	ns.lastSend = time.Now()
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (ns *nonceSender) IsInterfaceNil() bool {
+	return ns == nil
+}
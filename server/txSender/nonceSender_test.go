@@ -0,0 +1,230 @@
+package txSender
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+const senderAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func createNonceSenderArgs() ArgsNonceSender {
+	return ArgsNonceSender{
+		Proxy: &testscommon.ProxyMock{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Nonce: 10}, nil
+			},
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{MinGasPrice: 1_000_000_000}, nil
+			},
+		},
+		TxHashComputer: &testscommon.TxHashComputerMock{},
+	}
+}
+
+func createSenderTxs(numTxs int) []*transaction.FrontendTransaction {
+	txs := make([]*transaction.FrontendTransaction, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		txs = append(txs, &transaction.FrontendTransaction{Sender: senderAddress})
+	}
+
+	return txs
+}
+
+func TestNewNonceSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy", func(t *testing.T) {
+		args := createNonceSenderArgs()
+		args.Proxy = nil
+		ns, err := NewNonceSender(args)
+		require.Nil(t, ns)
+		require.Equal(t, errNilProxy, err)
+	})
+	t.Run("nil tx hash computer", func(t *testing.T) {
+		args := createNonceSenderArgs()
+		args.TxHashComputer = nil
+		ns, err := NewNonceSender(args)
+		require.Nil(t, ns)
+		require.Equal(t, errNilTxHashComputer, err)
+	})
+	t.Run("invalid interval to send", func(t *testing.T) {
+		args := createNonceSenderArgs()
+		args.IntervalToSend = -1
+		ns, err := NewNonceSender(args)
+		require.Nil(t, ns)
+		require.Equal(t, errInvalidIntervalToSend, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ns, err := NewNonceSender(createNonceSenderArgs())
+		require.Nil(t, err)
+		require.False(t, ns.IsInterfaceNil())
+	})
+}
+
+func TestNonceSender_ApplyNonceAndGasPrice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should assign consecutive nonces, fetching the account nonce once", func(t *testing.T) {
+		args := createNonceSenderArgs()
+		numGetAccount := 0
+		args.Proxy.(*testscommon.ProxyMock).GetAccountCalled = func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			numGetAccount++
+			return &data.Account{Nonce: 10}, nil
+		}
+		ns, _ := NewNonceSender(args)
+
+		txs := createSenderTxs(3)
+		txs[2].GasPrice = 2_000_000_000
+		require.Nil(t, ns.ApplyNonceAndGasPrice(context.Background(), txs[:2]...))
+		require.Nil(t, ns.ApplyNonceAndGasPrice(context.Background(), txs[2]))
+
+		require.Equal(t, 1, numGetAccount)
+		require.Equal(t, []uint64{10, 11, 12}, []uint64{txs[0].Nonce, txs[1].Nonce, txs[2].Nonce})
+		require.Equal(t, uint64(1_000_000_000), txs[0].GasPrice)
+		require.Equal(t, uint64(2_000_000_000), txs[2].GasPrice)
+	})
+	t.Run("account error", func(t *testing.T) {
+		args := createNonceSenderArgs()
+		errAccount := errors.New("account error")
+		args.Proxy.(*testscommon.ProxyMock).GetAccountCalled = func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			return nil, errAccount
+		}
+		ns, _ := NewNonceSender(args)
+
+		require.Equal(t, errAccount, ns.ApplyNonceAndGasPrice(context.Background(), createSenderTxs(1)...))
+	})
+}
+
+func TestNonceSender_ReleaseNonces(t *testing.T) {
+	t.Parallel()
+
+	ns, _ := NewNonceSender(createNonceSenderArgs())
+
+	txs := createSenderTxs(3)
+	require.Nil(t, ns.ApplyNonceAndGasPrice(context.Background(), txs...))
+
+	// only the first tx was sent, so the next nonces are assigned again
+	ns.ReleaseNonces(txs[1:]...)
+	ns.ReleaseNonces()
+
+	nextTxs := createSenderTxs(2)
+	require.Nil(t, ns.ApplyNonceAndGasPrice(context.Background(), nextTxs...))
+	require.Equal(t, uint64(11), nextTxs[0].Nonce)
+	require.Equal(t, uint64(12), nextTxs[1].Nonce)
+}
+
+func TestNonceSender_ReserveNonces(t *testing.T) {
+	t.Parallel()
+
+	ns, _ := NewNonceSender(createNonceSenderArgs())
+
+	// txs signed before a restart keep their nonces, which are not handed out again
+	reservedTxs := createSenderTxs(2)
+	reservedTxs[0].Nonce = 10
+	reservedTxs[1].Nonce = 11
+	require.Nil(t, ns.ReserveNonces(context.Background(), reservedTxs...))
+
+	txs := createSenderTxs(1)
+	require.Nil(t, ns.ApplyNonceAndGasPrice(context.Background(), txs...))
+	require.Equal(t, uint64(12), txs[0].Nonce)
+}
+
+func TestNonceSender_SendTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should send all txs in one request and map the accepted ones by hash", func(t *testing.T) {
+		args := createNonceSenderArgs()
+		numRequests := 0
+		args.Proxy.(*testscommon.ProxyMock).SendTransactionsCalled = func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+			numRequests++
+			require.Len(t, txs, 3)
+
+			// the second tx was not accepted, so it is missing from the response
+			return []string{
+				hex.EncodeToString([]byte(txs[0].Signature)),
+				hex.EncodeToString([]byte(txs[2].Signature)),
+			}, nil
+		}
+		ns, _ := NewNonceSender(args)
+
+		txs := createSenderTxs(3)
+		for idx, tx := range txs {
+			tx.Signature = string(rune('a' + idx))
+		}
+
+		hashes, err := ns.SendTransactions(context.Background(), txs...)
+		require.Nil(t, err)
+		require.Equal(t, 1, numRequests)
+		require.Equal(t, []string{hex.EncodeToString([]byte("a")), "", hex.EncodeToString([]byte("c"))}, hashes)
+	})
+	t.Run("send error", func(t *testing.T) {
+		args := createNonceSenderArgs()
+		errSend := errors.New("send error")
+		args.Proxy.(*testscommon.ProxyMock).SendTransactionsCalled = func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+			return nil, errSend
+		}
+		ns, _ := NewNonceSender(args)
+
+		hashes, err := ns.SendTransactions(context.Background(), createSenderTxs(2)...)
+		require.Equal(t, errSend, err)
+		require.Equal(t, []string{"", ""}, hashes)
+	})
+	t.Run("not accepted tx, should resync the nonce of its sender", func(t *testing.T) {
+		args := createNonceSenderArgs()
+		accountNonce := uint64(10)
+		numGetAccount := 0
+		args.Proxy.(*testscommon.ProxyMock).GetAccountCalled = func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			numGetAccount++
+			return &data.Account{Nonce: accountNonce}, nil
+		}
+		args.Proxy.(*testscommon.ProxyMock).SendTransactionsCalled = func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+			return []string{}, nil
+		}
+		ns, _ := NewNonceSender(args)
+
+		txs := createSenderTxs(2)
+		require.Nil(t, ns.ApplyNonceAndGasPrice(context.Background(), txs...))
+		_, _ = ns.SendTransactions(context.Background(), txs...)
+		ns.ReleaseNonces(txs...)
+
+		// an external tx of the same wallet used the released nonce, so the on-chain nonce is used
+		accountNonce = 11
+		nextTxs := createSenderTxs(1)
+		require.Nil(t, ns.ApplyNonceAndGasPrice(context.Background(), nextTxs...))
+		require.Equal(t, uint64(11), nextTxs[0].Nonce)
+		require.Equal(t, 2, numGetAccount)
+
+		// the cached nonce is kept once ahead of the on-chain one, since the previous txs were not executed yet
+		_, _ = ns.SendTransactions(context.Background(), createSenderTxs(1)...)
+		nextTxs = createSenderTxs(1)
+		require.Nil(t, ns.ApplyNonceAndGasPrice(context.Background(), nextTxs...))
+		require.Equal(t, uint64(12), nextTxs[0].Nonce)
+		require.Equal(t, 3, numGetAccount)
+
+		nextTxs = createSenderTxs(1)
+		require.Nil(t, ns.ApplyNonceAndGasPrice(context.Background(), nextTxs...))
+		require.Equal(t, uint64(13), nextTxs[0].Nonce)
+		require.Equal(t, 3, numGetAccount)
+	})
+	t.Run("no txs", func(t *testing.T) {
+		args := createNonceSenderArgs()
+		args.Proxy.(*testscommon.ProxyMock).SendTransactionsCalled = func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+			require.Fail(t, "should not send")
+			return nil, nil
+		}
+		ns, _ := NewNonceSender(args)
+
+		hashes, err := ns.SendTransactions(context.Background())
+		require.Nil(t, err)
+		require.Empty(t, hashes)
+	})
+}
@@ -0,0 +1,154 @@
+package txSender
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+)
+
+// defaultValidatorSetChangePriority is the priority of validator set changes, if not configured, so that they are
+// sent ahead of all other bridge operations. Following bridge operations are signed by the new validator set.
+const defaultValidatorSetChangePriority = 1
+
+// maxSubmissionPriority is the priority of submissions which are not bridge operations received from sovereign
+// nodes, such as resuming unfinished operations or replacing stuck txs
+const maxSubmissionPriority = math.MaxInt
+
+// TypePriorityConfig holds the submission priority of a bridge outgoing data type. Bridge outgoing data with a higher
+// priority is sent first, types without a configured priority having priority 0.
+type TypePriorityConfig struct {
+	Type     int32
+	Priority int
+}
+
+// createTypePriorities indexes the configured priorities by bridge outgoing data type. Validator set changes have
+// the default priority, unless configured otherwise.
+func createTypePriorities(cfgs []TypePriorityConfig) (map[int32]int, error) {
+	priorities := make(map[int32]int, len(cfgs))
+	for _, cfg := range cfgs {
+		if _, exists := priorities[cfg.Type]; exists {
+			return nil, fmt.Errorf("%w, type = %d", errDuplicatedTypePriority, cfg.Type)
+		}
+
+		priorities[cfg.Type] = cfg.Priority
+	}
+
+	validatorSetChangeType := int32(block.OutGoingMbChangeValidatorSet)
+	if _, exists := priorities[validatorSetChangeType]; !exists {
+		priorities[validatorSetChangeType] = defaultValidatorSetChangePriority
+	}
+
+	return priorities, nil
+}
+
+// priorityGroup holds the indexes of the received bridge outgoing data having the same priority
+type priorityGroup struct {
+	priority int
+	indexes  []int
+}
+
+// groupByPriority groups the bridge outgoing data by priority, highest first. The received order is preserved within
+// each group, so that bridge outgoing data of the same type is sent in order.
+func groupByPriority(data []*sovereign.BridgeOutGoingData, priorities map[int32]int) []*priorityGroup {
+	groupsByPriority := make(map[int]*priorityGroup)
+	groups := make([]*priorityGroup, 0)
+	for idx, bridgeData := range data {
+		priority := priorities[bridgeData.Type]
+		group, exists := groupsByPriority[priority]
+		if !exists {
+			group = &priorityGroup{
+				priority: priority,
+				indexes:  make([]int, 0),
+			}
+			groupsByPriority[priority] = group
+			groups = append(groups, group)
+		}
+
+		group.indexes = append(group.indexes, idx)
+	}
+
+	slices.SortFunc(groups, func(a, b *priorityGroup) int {
+		return cmp.Compare(b.priority, a.priority)
+	})
+
+	return groups
+}
+
+// submissionQueue serializes the sending of bridge txs, so that retried requests for the same bridge data are always
+// deduplicated. The turn is granted to the queued submission with the highest priority, submissions with the same
+// priority being granted in the order they were queued.
+type submissionQueue struct {
+	mut     sync.Mutex
+	busy    bool
+	waiting []*submission
+}
+
+// submission is a turn to send bridge txs, granted by the submission queue
+type submission struct {
+	priority int
+	granted  chan struct{}
+}
+
+func newSubmissionQueue() *submissionQueue {
+	return &submissionQueue{
+		waiting: make([]*submission, 0),
+	}
+}
+
+// enqueue queues a submission with the provided priority, which should be waited for before sending any tx
+func (sq *submissionQueue) enqueue(priority int) *submission {
+	sq.mut.Lock()
+	defer sq.mut.Unlock()
+
+	sub := &submission{
+		priority: priority,
+		granted:  make(chan struct{}),
+	}
+	sq.waiting = append(sq.waiting, sub)
+	sq.grantNext()
+
+	return sub
+}
+
+// acquire queues a submission with the provided priority and waits for its turn
+func (sq *submissionQueue) acquire(priority int) {
+	sq.enqueue(priority).wait()
+}
+
+// release ends the current submission and grants the turn to the next one
+func (sq *submissionQueue) release() {
+	sq.mut.Lock()
+	defer sq.mut.Unlock()
+
+	sq.busy = false
+	sq.grantNext()
+}
+
+func (sq *submissionQueue) grantNext() {
+	if sq.busy || len(sq.waiting) == 0 {
+		return
+	}
+
+	// waiting submissions are in queued order, so the first one with the highest priority is granted
+	next := 0
+	for idx, sub := range sq.waiting {
+		if sub.priority > sq.waiting[next].priority {
+			next = idx
+		}
+	}
+
+	sub := sq.waiting[next]
+	sq.waiting = slices.Delete(sq.waiting, next, next+1)
+	sq.busy = true
+	close(sub.granted)
+}
+
+// wait blocks until the submission is granted its turn
+func (sub *submission) wait() {
+	<-sub.granted
+}
@@ -0,0 +1,218 @@
+package txSender
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+func TestCreateTypePriorities(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no config, validator set changes should have default priority", func(t *testing.T) {
+		priorities, err := createTypePriorities(nil)
+		require.Nil(t, err)
+		require.Equal(t, map[int32]int{int32(block.OutGoingMbChangeValidatorSet): defaultValidatorSetChangePriority}, priorities)
+	})
+	t.Run("configured priorities should override the default", func(t *testing.T) {
+		priorities, err := createTypePriorities([]TypePriorityConfig{
+			{Type: int32(block.OutGoingMbChangeValidatorSet), Priority: 5},
+			{Type: int32(block.OutGoingMBRegisterToken), Priority: 2},
+		})
+		require.Nil(t, err)
+		require.Equal(t, 5, priorities[int32(block.OutGoingMbChangeValidatorSet)])
+		require.Equal(t, 2, priorities[int32(block.OutGoingMBRegisterToken)])
+		require.Equal(t, 0, priorities[int32(block.OutGoingMbDeposit)])
+	})
+	t.Run("duplicated type", func(t *testing.T) {
+		priorities, err := createTypePriorities([]TypePriorityConfig{
+			{Type: 2, Priority: 1},
+			{Type: 2, Priority: 3},
+		})
+		require.ErrorIs(t, err, errDuplicatedTypePriority)
+		require.Nil(t, priorities)
+	})
+}
+
+func TestGroupByPriority(t *testing.T) {
+	t.Parallel()
+
+	priorities, _ := createTypePriorities([]TypePriorityConfig{{Type: int32(block.OutGoingMBRegisterToken), Priority: -1}})
+	data := []*sovereign.BridgeOutGoingData{
+		{Type: int32(block.OutGoingMbDeposit)},
+		{Type: int32(block.OutGoingMBRegisterToken)},
+		{Type: int32(block.OutGoingMbChangeValidatorSet)},
+		{Type: int32(block.OutGoingMbDeposit)},
+	}
+
+	groups := groupByPriority(data, priorities)
+	require.Equal(t, []*priorityGroup{
+		{priority: 1, indexes: []int{2}},
+		{priority: 0, indexes: []int{0, 3}},
+		{priority: -1, indexes: []int{1}},
+	}, groups)
+}
+
+func TestSubmissionQueue(t *testing.T) {
+	t.Parallel()
+
+	sq := newSubmissionQueue()
+	sq.acquire(0)
+
+	low1 := sq.enqueue(0)
+	low2 := sq.enqueue(0)
+	high := sq.enqueue(1)
+
+	mut := sync.Mutex{}
+	granted := make([]string, 0)
+	wg := sync.WaitGroup{}
+	waitTurn := func(name string, sub *submission) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sub.wait()
+			mut.Lock()
+			granted = append(granted, name)
+			mut.Unlock()
+			sq.release()
+		}()
+	}
+	waitTurn("low2", low2)
+	waitTurn("low1", low1)
+	waitTurn("high", high)
+
+	time.Sleep(10 * time.Millisecond)
+	require.Empty(t, granted)
+
+	sq.release()
+	wg.Wait()
+	require.Equal(t, []string{"high", "low1", "low2"}, granted)
+}
+
+func TestTxSender_SendTxsPriorities(t *testing.T) {
+	t.Parallel()
+
+	createTxsData := func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+		if bridgeData.Type == int32(block.OutGoingMbChangeValidatorSet) {
+			return [][]byte{[]byte(changeValidatorSetPrefix + "@" + string(bridgeData.Hash))}, nil
+		}
+
+		return [][]byte{[]byte(executeDepositBridgeOpsPrefix + "@" + string(bridgeData.Hash))}, nil
+	}
+
+	t.Run("validator set changes should be sent ahead of deposits of the same request", func(t *testing.T) {
+		args := createArgs()
+		args.DataFormatter = &testscommon.DataFormatterMock{
+			CreateBridgeDataTxsDataCalled: createTxsData,
+		}
+		sentTxsData := make([]string, 0)
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				hashes := make([]string, 0, len(txs))
+				for _, tx := range txs {
+					sentTxsData = append(sentTxsData, string(tx.Data))
+					hashes = append(hashes, "hash-"+string(tx.Data))
+				}
+				return hashes, nil
+			},
+		}
+
+		bridgeOps := &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{
+				{Hash: []byte("deposit1"), Type: int32(block.OutGoingMbDeposit)},
+				{Hash: []byte("deposit2"), Type: int32(block.OutGoingMbDeposit)},
+				{Hash: []byte("validators"), Type: int32(block.OutGoingMbChangeValidatorSet)},
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		result := ts.SendTxs(context.Background(), bridgeOps)
+		require.Nil(t, result.Err())
+		require.Equal(t, []string{
+			changeValidatorSetPrefix + "@validators",
+			executeDepositBridgeOpsPrefix + "@deposit1",
+			executeDepositBridgeOpsPrefix + "@deposit2",
+		}, sentTxsData)
+
+		// results keep the received order
+		for idx, bridgeDataResult := range result.Results {
+			require.Equal(t, bridgeOps.Data[idx].Hash, bridgeDataResult.Hash)
+		}
+	})
+	t.Run("validator set change should jump ahead of waiting deposits", func(t *testing.T) {
+		args := createArgs()
+		args.DataFormatter = &testscommon.DataFormatterMock{
+			CreateBridgeDataTxsDataCalled: createTxsData,
+		}
+
+		firstSendStarted := make(chan struct{})
+		finishFirstSend := make(chan struct{})
+		mut := sync.Mutex{}
+		sentTxsData := make([]string, 0)
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				mut.Lock()
+				sentTxsData = append(sentTxsData, string(txs[0].Data))
+				isFirstSend := len(sentTxsData) == 1
+				mut.Unlock()
+
+				if isFirstSend {
+					close(firstSendStarted)
+					<-finishFirstSend
+				}
+
+				return []string{"hash-" + string(txs[0].Data)}, nil
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		sendTxs := func(hash string, outGoingType block.OutGoingMBType) {
+			result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+				Data: []*sovereign.BridgeOutGoingData{{Hash: []byte(hash), Type: int32(outGoingType)}},
+			})
+			require.Nil(t, result.Err())
+		}
+
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sendTxs("deposit1", block.OutGoingMbDeposit)
+		}()
+		<-firstSendStarted
+
+		for _, pending := range []struct {
+			hash         string
+			outGoingType block.OutGoingMBType
+		}{
+			{hash: "deposit2", outGoingType: block.OutGoingMbDeposit},
+			{hash: "validators", outGoingType: block.OutGoingMbChangeValidatorSet},
+		} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sendTxs(pending.hash, pending.outGoingType)
+			}()
+
+			// lets the request queue its submission before sending the next one
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		close(finishFirstSend)
+		wg.Wait()
+		require.Equal(t, []string{
+			executeDepositBridgeOpsPrefix + "@deposit1",
+			changeValidatorSetPrefix + "@validators",
+			executeDepositBridgeOpsPrefix + "@deposit2",
+		}, sentTxsData)
+	})
+}
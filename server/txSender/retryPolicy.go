@@ -0,0 +1,84 @@
+package txSender
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const maxRetryBackoff = time.Second * 30
+
+// RetryPolicy holds the policy applied when broadcasting bridge txs fails or when sent txs are stuck
+type RetryPolicy struct {
+	MaxAttempts            int
+	Backoff                time.Duration
+	GasPriceBumpPercentage uint64
+	MaxGasPrice            uint64
+	StuckTxTimeout         time.Duration
+}
+
+func checkRetryPolicy(policy RetryPolicy) error {
+	if policy.MaxAttempts < 1 {
+		return errInvalidMaxRetryAttempts
+	}
+	if policy.Backoff <= 0 {
+		return errInvalidRetryBackoff
+	}
+	if policy.StuckTxTimeout < 0 {
+		return errInvalidStuckTxTimeout
+	}
+
+	// stuck txs replacement is disabled
+	if policy.StuckTxTimeout == 0 {
+		return nil
+	}
+	if policy.GasPriceBumpPercentage == 0 {
+		return errInvalidGasPriceBump
+	}
+	if policy.MaxGasPrice == 0 {
+		return errInvalidMaxGasPrice
+	}
+
+	return nil
+}
+
+// retry calls the provided function until it succeeds, the max number of attempts is reached or the context is done.
+// The time waited between attempts doubles after each failure.
+func (policy RetryPolicy) retry(ctx context.Context, operation string, f func() error) error {
+	backoff := policy.Backoff
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || attempt >= policy.MaxAttempts || !isTransientError(err) {
+			return err
+		}
+
+		log.Debug("retrying failed operation",
+			"operation", operation,
+			"attempt", attempt,
+			"backoff", backoff,
+			"error", err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, maxRetryBackoff)
+	}
+}
+
+func isTransientError(err error) bool {
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// bumpGasPrice returns the increased gas price, capped to the max gas price, and whether it is higher than the provided one
+func (policy RetryPolicy) bumpGasPrice(gasPrice uint64) (uint64, bool) {
+	bumpedGasPrice := gasPrice + gasPrice*policy.GasPriceBumpPercentage/100
+	if bumpedGasPrice > policy.MaxGasPrice {
+		bumpedGasPrice = policy.MaxGasPrice
+	}
+
+	return bumpedGasPrice, bumpedGasPrice > gasPrice
+}
@@ -0,0 +1,182 @@
+package txSender
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// gas limits used when estimation fails
+const (
+	gasLimitDefault       = 50_000_000
+	gasLimitRegisterToken = 80_000_000
+)
+
+// max gas limits per endpoint, estimated gas is capped to these values
+const (
+	maxGasLimitDefault          = 200_000_000
+	maxGasLimitExecuteBridgeOps = 600_000_000
+)
+
+// routedPrefixes holds the tx data prefixes produced by the data formatters, each of them must be routed
+var routedPrefixes = []string{
+	registerBridgeOpsPrefix,
+	executeDepositBridgeOpsPrefix,
+	executeRegisterTokenPrefix,
+	changeValidatorSetPrefix,
+	executeRegisterValidatorPrefix,
+	executeUnRegisterValidatorPrefix,
+}
+
+// EndpointConfig holds the receiver, gas limits and value of the txs calling an endpoint, identified by its tx data prefix.
+// Gas limit is used when estimation fails, while estimated gas is capped to max gas limit.
+type EndpointConfig struct {
+	Prefix      string
+	Receiver    string
+	GasLimit    uint64
+	MaxGasLimit uint64
+	Value       string
+}
+
+// RoutingTableConfig holds the routing table, as loaded from a config file
+type RoutingTableConfig struct {
+	Endpoints []EndpointConfig
+}
+
+// ArgsDefaultRoutingTable holds the addresses of the sovereign contracts, as deployed by default
+type ArgsDefaultRoutingTable struct {
+	HeaderVerifierSCAddress   string
+	EsdtSafeSCAddress         string
+	ChangeValidatorsSCAddress string
+	ChainConfigSCAddress      string
+}
+
+// NewDefaultRoutingTable creates the routing table of the default sovereign contracts deployment
+func NewDefaultRoutingTable(args ArgsDefaultRoutingTable) ([]EndpointConfig, error) {
+	if len(args.HeaderVerifierSCAddress) == 0 {
+		return nil, errNoHeaderVerifierSCAddress
+	}
+	if len(args.EsdtSafeSCAddress) == 0 {
+		return nil, errNoEsdtSafeSCAddress
+	}
+	if len(args.ChangeValidatorsSCAddress) == 0 {
+		return nil, errNoChangeValidatorSetSCAddress
+	}
+	if len(args.ChainConfigSCAddress) == 0 {
+		return nil, errNoChainConfigSCAddress
+	}
+
+	return []EndpointConfig{
+		{
+			Prefix:      registerBridgeOpsPrefix,
+			Receiver:    args.HeaderVerifierSCAddress,
+			GasLimit:    gasLimitDefault,
+			MaxGasLimit: maxGasLimitDefault,
+		},
+		{
+			Prefix:      executeDepositBridgeOpsPrefix,
+			Receiver:    args.EsdtSafeSCAddress,
+			GasLimit:    gasLimitDefault,
+			MaxGasLimit: maxGasLimitExecuteBridgeOps,
+		},
+		{
+			Prefix:      executeRegisterTokenPrefix,
+			Receiver:    args.EsdtSafeSCAddress,
+			GasLimit:    gasLimitRegisterToken,
+			MaxGasLimit: maxGasLimitDefault,
+		},
+		{
+			Prefix:      changeValidatorSetPrefix,
+			Receiver:    args.ChangeValidatorsSCAddress,
+			GasLimit:    gasLimitDefault,
+			MaxGasLimit: maxGasLimitDefault,
+		},
+		{
+			Prefix:      executeRegisterValidatorPrefix,
+			Receiver:    args.ChainConfigSCAddress,
+			GasLimit:    gasLimitDefault,
+			MaxGasLimit: maxGasLimitDefault,
+		},
+		{
+			Prefix:      executeUnRegisterValidatorPrefix,
+			Receiver:    args.ChainConfigSCAddress,
+			GasLimit:    gasLimitDefault,
+			MaxGasLimit: maxGasLimitDefault,
+		},
+	}, nil
+}
+
+// LoadRoutingTable loads the routing table from the provided toml file
+func LoadRoutingTable(filePath string) ([]EndpointConfig, error) {
+	cfg := &RoutingTableConfig{}
+	err := core.LoadTomlFile(cfg, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg.Endpoints, nil
+}
+
+type txConfig struct {
+	receiver    string
+	gasLimit    uint64
+	maxGasLimit uint64
+	value       string
+}
+
+// createTxConfigs validates the routing table and indexes it by tx data prefix. Every prefix produced by the data
+// formatters must be routed exactly once.
+func createTxConfigs(routingTable []EndpointConfig) (map[string]*txConfig, error) {
+	txConfigs := make(map[string]*txConfig, len(routingTable))
+	for _, endpoint := range routingTable {
+		err := checkEndpointConfig(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := txConfigs[endpoint.Prefix]; exists {
+			return nil, fmt.Errorf("%w, prefix = %s", errDuplicatedEndpointPrefix, endpoint.Prefix)
+		}
+
+		value := endpoint.Value
+		if len(value) == 0 {
+			value = "0"
+		}
+
+		txConfigs[endpoint.Prefix] = &txConfig{
+			receiver:    endpoint.Receiver,
+			gasLimit:    endpoint.GasLimit,
+			maxGasLimit: endpoint.MaxGasLimit,
+			value:       value,
+		}
+	}
+
+	for _, prefix := range routedPrefixes {
+		if _, found := txConfigs[prefix]; !found {
+			return nil, fmt.Errorf("%w, prefix = %s", errUnroutedEndpointPrefix, prefix)
+		}
+	}
+
+	return txConfigs, nil
+}
+
+func checkEndpointConfig(endpoint EndpointConfig) error {
+	if len(endpoint.Prefix) == 0 {
+		return errEmptyEndpointPrefix
+	}
+	if len(endpoint.Receiver) == 0 {
+		return fmt.Errorf("%w, prefix = %s", errNoEndpointReceiver, endpoint.Prefix)
+	}
+	if endpoint.GasLimit == 0 || endpoint.MaxGasLimit < endpoint.GasLimit {
+		return fmt.Errorf("%w, prefix = %s, gas limit = %d, max gas limit = %d",
+			errInvalidEndpointGasLimit, endpoint.Prefix, endpoint.GasLimit, endpoint.MaxGasLimit)
+	}
+	if len(endpoint.Value) != 0 {
+		value, ok := big.NewInt(0).SetString(endpoint.Value, 10)
+		if !ok || value.Sign() < 0 {
+			return fmt.Errorf("%w, prefix = %s, value = %s", errInvalidEndpointValue, endpoint.Prefix, endpoint.Value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package txSender
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createDefaultRoutingTableArgs() ArgsDefaultRoutingTable {
+	return ArgsDefaultRoutingTable{
+		HeaderVerifierSCAddress:   scHeaderVerifierAddress,
+		EsdtSafeSCAddress:         scEsdtSafeAddress,
+		ChangeValidatorsSCAddress: scChangeValidatorsSetAddress,
+		ChainConfigSCAddress:      scChainConfigAddress,
+	}
+}
+
+func TestNewDefaultRoutingTable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty sc header verifier address", func(t *testing.T) {
+		args := createDefaultRoutingTableArgs()
+		args.HeaderVerifierSCAddress = ""
+
+		routingTable, err := NewDefaultRoutingTable(args)
+		require.Nil(t, routingTable)
+		require.Equal(t, errNoHeaderVerifierSCAddress, err)
+	})
+	t.Run("empty sc esdt safe address", func(t *testing.T) {
+		args := createDefaultRoutingTableArgs()
+		args.EsdtSafeSCAddress = ""
+
+		routingTable, err := NewDefaultRoutingTable(args)
+		require.Nil(t, routingTable)
+		require.Equal(t, errNoEsdtSafeSCAddress, err)
+	})
+	t.Run("empty sc change validators address", func(t *testing.T) {
+		args := createDefaultRoutingTableArgs()
+		args.ChangeValidatorsSCAddress = ""
+
+		routingTable, err := NewDefaultRoutingTable(args)
+		require.Nil(t, routingTable)
+		require.Equal(t, errNoChangeValidatorSetSCAddress, err)
+	})
+	t.Run("empty chain config address", func(t *testing.T) {
+		args := createDefaultRoutingTableArgs()
+		args.ChainConfigSCAddress = ""
+
+		routingTable, err := NewDefaultRoutingTable(args)
+		require.Nil(t, routingTable)
+		require.Equal(t, errNoChainConfigSCAddress, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		routingTable, err := NewDefaultRoutingTable(createDefaultRoutingTableArgs())
+		require.Nil(t, err)
+		require.Len(t, routingTable, len(routedPrefixes))
+
+		txConfigs, err := createTxConfigs(routingTable)
+		require.Nil(t, err)
+		require.Len(t, txConfigs, len(routedPrefixes))
+	})
+}
+
+func TestCreateTxConfigs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty prefix", func(t *testing.T) {
+		routingTable := createRoutingTable()
+		routingTable[0].Prefix = ""
+
+		txConfigs, err := createTxConfigs(routingTable)
+		require.Nil(t, txConfigs)
+		require.Equal(t, errEmptyEndpointPrefix, err)
+	})
+	t.Run("empty receiver", func(t *testing.T) {
+		routingTable := createRoutingTable()
+		routingTable[0].Receiver = ""
+
+		txConfigs, err := createTxConfigs(routingTable)
+		require.Nil(t, txConfigs)
+		require.ErrorIs(t, err, errNoEndpointReceiver)
+	})
+	t.Run("zero gas limit", func(t *testing.T) {
+		routingTable := createRoutingTable()
+		routingTable[0].GasLimit = 0
+
+		txConfigs, err := createTxConfigs(routingTable)
+		require.Nil(t, txConfigs)
+		require.ErrorIs(t, err, errInvalidEndpointGasLimit)
+	})
+	t.Run("gas limit above max gas limit", func(t *testing.T) {
+		routingTable := createRoutingTable()
+		routingTable[0].MaxGasLimit = routingTable[0].GasLimit - 1
+
+		txConfigs, err := createTxConfigs(routingTable)
+		require.Nil(t, txConfigs)
+		require.ErrorIs(t, err, errInvalidEndpointGasLimit)
+	})
+	t.Run("invalid value", func(t *testing.T) {
+		routingTable := createRoutingTable()
+		routingTable[0].Value = "-1"
+
+		txConfigs, err := createTxConfigs(routingTable)
+		require.Nil(t, txConfigs)
+		require.ErrorIs(t, err, errInvalidEndpointValue)
+
+		routingTable[0].Value = "1egld"
+		txConfigs, err = createTxConfigs(routingTable)
+		require.Nil(t, txConfigs)
+		require.ErrorIs(t, err, errInvalidEndpointValue)
+	})
+	t.Run("duplicated prefix", func(t *testing.T) {
+		routingTable := append(createRoutingTable(), createRoutingTable()[0])
+
+		txConfigs, err := createTxConfigs(routingTable)
+		require.Nil(t, txConfigs)
+		require.ErrorIs(t, err, errDuplicatedEndpointPrefix)
+	})
+	t.Run("registered endpoints", func(t *testing.T) {
+		registrations := []DataFormatterRegistration{
+			{
+				Type: 999,
+				Endpoints: []EndpointConfig{
+					{Prefix: "executeNewOperation", Receiver: scEsdtSafeAddress, GasLimit: 1000, MaxGasLimit: 2000},
+				},
+			},
+		}
+
+		txConfigs, err := createTxConfigs(append(createRoutingTable(), getRegisteredEndpoints(registrations)...))
+		require.Nil(t, err)
+		require.Equal(t, scEsdtSafeAddress, txConfigs["executeNewOperation"].receiver)
+
+		registrations[0].Endpoints[0].Prefix = registerBridgeOpsPrefix
+		txConfigs, err = createTxConfigs(append(createRoutingTable(), getRegisteredEndpoints(registrations)...))
+		require.Nil(t, txConfigs)
+		require.ErrorIs(t, err, errDuplicatedEndpointPrefix)
+	})
+	t.Run("unrouted prefix", func(t *testing.T) {
+		routingTable := createRoutingTable()
+		routingTable[0].Prefix = "otherEndpoint"
+
+		txConfigs, err := createTxConfigs(routingTable)
+		require.Nil(t, txConfigs)
+		require.ErrorIs(t, err, errUnroutedEndpointPrefix)
+	})
+	t.Run("should work with value", func(t *testing.T) {
+		routingTable := createRoutingTable()
+		routingTable[0].Value = "1000"
+
+		txConfigs, err := createTxConfigs(routingTable)
+		require.Nil(t, err)
+		require.Equal(t, "1000", txConfigs[routingTable[0].Prefix].value)
+		require.Equal(t, "0", txConfigs[routingTable[1].Prefix].value)
+	})
+}
+
+func TestLoadRoutingTable(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "routing.toml")
+	err := os.WriteFile(filePath, []byte(`
+[[Endpoints]]
+    Prefix = "registerBridgeOps"
+    Receiver = "erd1qqq"
+    GasLimit = 50000000
+    MaxGasLimit = 200000000
+
+[[Endpoints]]
+    Prefix = "executeBridgeOps"
+    Receiver = "erd1qqqe"
+    GasLimit = 50000000
+    MaxGasLimit = 600000000
+    Value = "10"
+`), 0644)
+	require.Nil(t, err)
+
+	routingTable, err := LoadRoutingTable(filePath)
+	require.Nil(t, err)
+	require.Equal(t, []EndpointConfig{
+		{
+			Prefix:      registerBridgeOpsPrefix,
+			Receiver:    scHeaderVerifierAddress,
+			GasLimit:    50000000,
+			MaxGasLimit: 200000000,
+		},
+		{
+			Prefix:      executeDepositBridgeOpsPrefix,
+			Receiver:    scEsdtSafeAddress,
+			GasLimit:    50000000,
+			MaxGasLimit: 600000000,
+			Value:       "10",
+		},
+	}, routingTable)
+
+	_, err = LoadRoutingTable(filepath.Join(t.TempDir(), "missing.toml"))
+	require.NotNil(t, err)
+}
@@ -2,192 +2,1297 @@ package txSender
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	coreTx "github.com/multiversx/mx-chain-core-go/data/transaction"
-	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
-)
 
-const (
-	gasLimitDefault       = 50_000_000
-	gasLimitRegisterToken = 80_000_000
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
 )
 
 // TxSenderArgs holds args to create a new tx sender
 type TxSenderArgs struct {
-	Wallet                    core.CryptoComponentsHolder
-	Proxy                     Proxy
-	TxInteractor              TxInteractor
-	TxNonceHandler            TxNonceSenderHandler
-	DataFormatter             DataFormatter
-	SCHeaderVerifierAddress   string
-	SCEsdtSafeAddress         string
-	SCChangeValidatorsAddress string
-	SCChainConfigAddress      string
-}
+	WalletPool     WalletPool
+	Proxy          Proxy
+	TxNonceHandler TxNonceSenderHandler
+	TxHashComputer TxHashComputer
+	DataFormatter  DataFormatter
+	GasEstimator   GasEstimator
+	Journal        Journal
+	TxTracker      TxTracker
+	RetryPolicy    RetryPolicy
+	RoutingTable   []EndpointConfig
+	TypePriorities []TypePriorityConfig
+	MaxBatchSize   int
+	DryRun         bool
 
-type txConfig struct {
-	receiver string
-	gasLimit uint64
+	JournalRetention   time.Duration
+	JournalHistorySize int
 }
 
 type txSender struct {
-	wallet         core.CryptoComponentsHolder
+	walletPool     WalletPool
+	proxy          Proxy
 	netConfigs     *data.NetworkConfig
-	txInteractor   TxInteractor
 	txNonceHandler TxNonceSenderHandler
+	txHashComputer TxHashComputer
 	dataFormatter  DataFormatter
+	gasEstimator   GasEstimator
+	journal        Journal
+	txTracker      TxTracker
+	retryPolicy    RetryPolicy
 	txConfigs      map[string]*txConfig
+	priorities     map[int32]int
+	epochs         *epochTracker
+	maxBatchSize   int
+	dryRun         bool
+	cancel         context.CancelFunc
+	loops          sync.WaitGroup
+
+	journalRetention   time.Duration
+	journalHistorySize int
+
+	submissions *submissionQueue
+
+	mutHeld              sync.Mutex
+	finished             map[string]struct{}
+	released             map[string]struct{}
+	cancelled            map[string]struct{}
+	heldByEpoch          map[string]struct{}
+	chReleased           chan struct{}
+	validatorSetHandlers []func(bridgeData *sovereign.BridgeOutGoingData, confirmed bool)
+	heldDataHandlers     []func(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult)
 }
 
-// NewTxSender creates a new tx sender
+// NewTxSender creates a new tx sender. In dry-run mode, txs are built and signed, but never sent.
 func NewTxSender(args TxSenderArgs) (*txSender, error) {
 	err := checkArgs(args)
 	if err != nil {
 		return nil, err
 	}
 
+	txConfigs, err := createTxConfigs(args.RoutingTable)
+	if err != nil {
+		return nil, err
+	}
+
+	priorities, err := createTypePriorities(args.TypePriorities)
+	if err != nil {
+		return nil, err
+	}
+
 	networkConfig, err := args.Proxy.GetNetworkConfig(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
-	return &txSender{
-		wallet:         args.Wallet,
+	ts := &txSender{
+		walletPool:     args.WalletPool,
+		proxy:          args.Proxy,
 		netConfigs:     networkConfig,
-		txInteractor:   args.TxInteractor,
 		txNonceHandler: args.TxNonceHandler,
+		txHashComputer: args.TxHashComputer,
 		dataFormatter:  args.DataFormatter,
-		txConfigs: map[string]*txConfig{
-			registerBridgeOpsPrefix: {
-				receiver: args.SCHeaderVerifierAddress,
-				gasLimit: gasLimitDefault,
-			},
+		gasEstimator:   args.GasEstimator,
+		journal:        args.Journal,
+		txTracker:      args.TxTracker,
+		retryPolicy:    args.RetryPolicy,
+		txConfigs:      txConfigs,
+		priorities:     priorities,
+		epochs:         newEpochTracker(args.Journal),
+		maxBatchSize:   args.MaxBatchSize,
+		dryRun:         args.DryRun,
 
-			executeDepositBridgeOpsPrefix: {
-				receiver: args.SCEsdtSafeAddress,
-				gasLimit: gasLimitDefault,
-			},
-			executeRegisterTokenPrefix: {
-				receiver: args.SCEsdtSafeAddress,
-				gasLimit: gasLimitRegisterToken,
-			},
+		journalRetention:   args.JournalRetention,
+		journalHistorySize: args.JournalHistorySize,
+		submissions:        newSubmissionQueue(),
+		finished:           make(map[string]struct{}),
+		released:           make(map[string]struct{}),
+		cancelled:          make(map[string]struct{}),
+		heldByEpoch:        make(map[string]struct{}),
+		chReleased:         make(chan struct{}, 1),
+	}
 
-			changeValidatorSetPrefix: {
-				receiver: args.SCChangeValidatorsAddress,
-				gasLimit: gasLimitDefault,
-			},
+	ctx, cancel := context.WithCancel(context.Background())
+	ts.cancel = cancel
+	if args.RetryPolicy.StuckTxTimeout > 0 && !args.DryRun {
+		ts.startLoop(ctx, ts.replaceStuckTxsLoop)
+	}
+	if args.JournalRetention > 0 && !args.DryRun {
+		ts.startLoop(ctx, ts.pruneJournalLoop)
+	}
+	if !args.DryRun {
+		ts.txTracker.RegisterHandler(ts.onTxOutcome)
+		ts.startLoop(ctx, ts.releaseHeldLoop)
+	}
 
-			executeRegisterValidatorPrefix: {
-				receiver: args.SCChainConfigAddress,
-				gasLimit: gasLimitDefault,
-			},
-			executeUnRegisterValidatorPrefix: {
-				receiver: args.SCChainConfigAddress,
-				gasLimit: gasLimitDefault,
-			},
-		},
-	}, nil
+	return ts, nil
+}
+
+// startLoop runs the background loop until the context is cancelled, so that Close waits for it to return
+func (ts *txSender) startLoop(ctx context.Context, loop func(ctx context.Context)) {
+	ts.loops.Add(1)
+	go func() {
+		defer ts.loops.Done()
+		loop(ctx)
+	}()
 }
 
 func checkArgs(args TxSenderArgs) error {
-	if check.IfNil(args.Wallet) {
-		return errNilWallet
+	if check.IfNil(args.WalletPool) {
+		return errNilWalletPool
 	}
 	if check.IfNil(args.Proxy) {
 		return errNilProxy
 	}
-	if check.IfNil(args.TxInteractor) {
-		return errNilTxInteractor
-	}
 	if check.IfNil(args.DataFormatter) {
 		return errNilDataFormatter
 	}
 	if check.IfNil(args.TxNonceHandler) {
 		return errNilNonceHandler
 	}
-	if len(args.SCHeaderVerifierAddress) == 0 {
-		return errNoHeaderVerifierSCAddress
+	if check.IfNil(args.TxHashComputer) {
+		return errNilTxHashComputer
+	}
+	if check.IfNil(args.GasEstimator) {
+		return errNilGasEstimator
+	}
+	if check.IfNil(args.Journal) {
+		return errNilJournal
+	}
+	if check.IfNil(args.TxTracker) {
+		return errNilTxTracker
 	}
-	if len(args.SCEsdtSafeAddress) == 0 {
-		return errNoEsdtSafeSCAddress
+	if args.MaxBatchSize < 1 {
+		return errInvalidMaxBatchSize
 	}
-	if len(args.SCChangeValidatorsAddress) == 0 {
-		return errNoChangeValidatorSetSCAddress
+	if args.JournalRetention < 0 {
+		return errInvalidJournalRetention
 	}
-	if len(args.SCChainConfigAddress) == 0 {
-		return errNoChainConfigSCAddress
+	if args.JournalHistorySize < 0 {
+		return errInvalidJournalHistorySize
 	}
 
-	return nil
+	return checkRetryPolicy(args.RetryPolicy)
 }
 
-// SendTxs should send bridge data operation txs
-func (ts *txSender) SendTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+// SendTxs should send bridge data operation txs. For each bridge outgoing data, it returns the hash of every sent tx or
+// the error which prevented sending it. In dry-run mode, either configured or requested through the context, it returns
+// the json encoded signed txs instead of sending them.
+func (ts *txSender) SendTxs(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+	isDryRun := ts.isDryRun(ctx)
 	if len(data.Data) == 0 {
-		return make([]string, 0), nil
+		return &bridge.OperationsResult{
+			Results: make([]*bridge.OutGoingDataResult, 0),
+			DryRun:  isDryRun,
+		}
+	}
+	if isDryRun {
+		return ts.dryRunTxs(ctx, data)
 	}
 
 	return ts.createAndSendTxs(ctx, data)
 }
 
-func (ts *txSender) createAndSendTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
-	txHashes := make([]string, 0)
-	txsData := ts.dataFormatter.CreateTxsData(data)
+// createAndSendTxs sends the bridge outgoing data whose epoch's validator set is rotated on-chain right away, while
+// the bridge outgoing data of a newer epoch is journaled and sent in background once the validator set change of its
+// epoch is confirmed.
+func (ts *txSender) createAndSendTxs(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
+	result := &bridge.OperationsResult{
+		Results: make([]*bridge.OutGoingDataResult, len(data.Data)),
+	}
+
+	ready, held := ts.splitByEpoch(data.Data, result)
+	ts.sendByPriority(ctx, data.Data, ready, result)
+	ts.holdByEpoch(data.Data, held, result)
+	ts.recheckHeld(held)
+
+	return result
+}
+
+// sendByPriority sends the bridge outgoing data with the provided indexes grouped by the priority of their type,
+// highest first, each group waiting for its turn in the submission queue. Bridge outgoing data of a group is journaled
+// when its turn comes, so a validator set change is not delayed by a large deposits backlog, while deposits are still
+// sent in order.
+func (ts *txSender) sendByPriority(ctx context.Context, data []*sovereign.BridgeOutGoingData, indexes []int, result *bridge.OperationsResult) {
+	selected := make([]*sovereign.BridgeOutGoingData, 0, len(indexes))
+	for _, idx := range indexes {
+		selected = append(selected, data[idx])
+	}
+
+	// all submissions are queued before waiting for any, so that lower priority groups keep their place in queue
+	groups := groupByPriority(selected, ts.priorities)
+	submissions := make([]*submission, 0, len(groups))
+	for _, group := range groups {
+		submissions = append(submissions, ts.submissions.enqueue(group.priority))
+	}
+
+	for idx, group := range groups {
+		groupIndexes := make([]int, 0, len(group.indexes))
+		for _, selectedIdx := range group.indexes {
+			groupIndexes = append(groupIndexes, indexes[selectedIdx])
+		}
+
+		submissions[idx].wait()
+		ts.sendBridgeDataGroup(ctx, data, groupIndexes, result)
+		ts.submissions.release()
+	}
+}
+
+// sendBridgeDataGroup journals and sends the txs of the bridge outgoing data with the provided indexes
+func (ts *txSender) sendBridgeDataGroup(
+	ctx context.Context,
+	data []*sovereign.BridgeOutGoingData,
+	indexes []int,
+	result *bridge.OperationsResult,
+) {
+	groupData := make([]*sovereign.BridgeOutGoingData, 0, len(indexes))
+	for _, idx := range indexes {
+		groupData = append(groupData, data[idx])
+	}
+
+	submittedEntries, journalErrors := ts.journalBridgeData(groupData)
+	tasks := make([]*sendTask, 0, len(groupData))
+	for groupIdx, bridgeData := range groupData {
+		idx := indexes[groupIdx]
+		err, journalFailed := journalErrors[groupIdx]
+		if journalFailed {
+			result.Results[idx] = createBridgeDataErrorResult(bridgeData.Hash, bridge.ErrorStage_Journal, err)
+			continue
+		}
+
+		entries := submittedEntries[groupIdx]
+		tasks = append(tasks, &sendTask{
+			wallet: ts.acquireWallet(getEntriesSender(entries)),
+			prepare: func(wallet TxSigner) *preparedTxs {
+				return ts.prepareBridgeDataTxs(wallet, bridgeData, entries)
+			},
+			setResult: func(bridgeDataResult *bridge.OutGoingDataResult) {
+				result.Results[idx] = bridgeDataResult
+			},
+		})
+	}
+
+	ts.sendTasks(ctx, tasks)
+}
+
+// journalBridgeData returns the journaled entries of the already submitted bridge data and journals the new ones.
+// Already submitted bridge data is detected before journaling, since journaling makes it known. Every new bridge data
+// is journaled before building any tx, so that nothing is lost if the process stops mid-batch.
+func (ts *txSender) journalBridgeData(data []*sovereign.BridgeOutGoingData) (map[int][]*journal.Entry, map[int]error) {
+	submittedEntries := make(map[int][]*journal.Entry)
+	journalErrors := make(map[int]error)
+	for idx, bridgeData := range data {
+		entries, isSubmitted := ts.getSubmittedEntries(bridgeData)
+		if isSubmitted {
+			submittedEntries[idx] = entries
+			continue
+		}
+
+		err := ts.journal.Add(bridgeData)
+		if err != nil {
+			log.Error("could not journal bridge data", "hash", bridgeData.Hash, "error", err)
+			journalErrors[idx] = err
+		}
+	}
+
+	return submittedEntries, journalErrors
+}
+
+// Accept journals all bridge outgoing data, without sending any tx, so that it is sent even if the process stops
+// before SendTxs is called with it. It returns, for each bridge outgoing data, the error which prevented journaling
+// it, if any. Bridge outgoing data of a stale epoch is rejected without being journaled. Validator set changes are
+// tracked once journaled, the same way as when sent. Nothing is journaled in dry-run mode.
+func (ts *txSender) Accept(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+	if ts.dryRun {
+		return &bridge.OperationsResult{
+			Results: make([]*bridge.OutGoingDataResult, 0),
+			DryRun:  true,
+		}
+	}
+
+	result := &bridge.OperationsResult{
+		Results: make([]*bridge.OutGoingDataResult, len(data.Data)),
+	}
+	journalFailed := ts.journalValidatorSetChanges(data.Data, result)
+	accepted := make([]int, 0, len(data.Data))
+	acceptedData := make([]*sovereign.BridgeOutGoingData, 0, len(data.Data))
+	for idx, bridgeData := range data.Data {
+		if _, failed := journalFailed[idx]; failed {
+			continue
+		}
+		if !ts.isAlreadySent(bridgeData) {
+			_, err := ts.epochs.checkEpoch(bridgeData.Epoch)
+			if err != nil {
+				log.Error("rejected bridge operation", "hash", bridgeData.Hash, "error", err)
+				result.Results[idx] = createBridgeDataErrorResult(bridgeData.Hash, bridge.ErrorStage_Epoch, err)
+				continue
+			}
+		}
+
+		accepted = append(accepted, idx)
+		acceptedData = append(acceptedData, bridgeData)
+	}
+
+	_, journalErrors := ts.journalBridgeData(acceptedData)
+	for acceptedIdx, idx := range accepted {
+		err, journalFailed := journalErrors[acceptedIdx]
+		if journalFailed {
+			result.Results[idx] = createBridgeDataErrorResult(data.Data[idx].Hash, bridge.ErrorStage_Journal, err)
+			continue
+		}
+
+		result.Results[idx] = newPreparedTxs(data.Data[idx].Hash).result
+	}
+
+	return result
+}
+
+// sendTask holds the sending of all txs of a bridge data, which are always signed by the same wallet, so that
+// dependent txs (e.g. registerBridgeOps and its executeBridgeOps) are sent in order
+type sendTask struct {
+	wallet    TxSigner
+	prepare   func(wallet TxSigner) *preparedTxs
+	setResult func(result *bridge.OutGoingDataResult)
+}
+
+// preparedTxs holds the outcome of a bridge outgoing data, together with its journaled txs which are not sent yet
+type preparedTxs struct {
+	result  *bridge.OutGoingDataResult
+	pending []*pendingTx
+}
+
+// finish adds the outcome of the pending txs to the bridge data result
+func (prepared *preparedTxs) finish() *bridge.OutGoingDataResult {
+	for _, ptx := range prepared.pending {
+		if ptx.result != nil {
+			prepared.result.Txs = append(prepared.result.Txs, ptx.result)
+		}
+	}
+
+	return prepared.result
+}
+
+// pendingTx holds a built tx, which is signed and sent in a batch together with the other txs of its wallet. A tx which
+// depends on another tx of the same bridge data remains journaled as built, and is sent in background once the tx it
+// depends on is confirmed. A tx journaled as signed, whose broadcast outcome is unknown since the process stopped
+// before journaling it, keeps its journaled nonce.
+type pendingTx struct {
+	wallet         TxSigner
+	bridgeDataHash []byte
+	txIndex        int
+	dependsOn      int
+	journaledNonce bool
+	tx             *coreTx.FrontendTransaction
+	txCfg          *txConfig
+	result         *bridge.TxResult
+}
 
+// sendTasks runs tasks assigned to different wallets in parallel. The txs of all tasks assigned to the same wallet are
+// sent together, in the received order, so that their nonces follow the order of the bridge data.
+func (ts *txSender) sendTasks(ctx context.Context, tasks []*sendTask) {
+	tasksPerWallet := make(map[string][]*sendTask)
+	for _, task := range tasks {
+		address := task.wallet.GetBech32()
+		tasksPerWallet[address] = append(tasksPerWallet[address], task)
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(tasksPerWallet))
+	for _, walletTasks := range tasksPerWallet {
+		go func(walletTasks []*sendTask) {
+			defer wg.Done()
+
+			ts.sendWalletTasks(ctx, walletTasks)
+		}(walletTasks)
+	}
+	wg.Wait()
+}
+
+func (ts *txSender) sendWalletTasks(ctx context.Context, tasks []*sendTask) {
+	prepared := make([]*preparedTxs, 0, len(tasks))
+	pending := make([]*pendingTx, 0)
+	for _, task := range tasks {
+		taskTxs := task.prepare(task.wallet)
+		prepared = append(prepared, taskTxs)
+		pending = append(pending, taskTxs.pending...)
+	}
+
+	ready, held := splitDependentTxs(pending)
+	ts.sendPendingTxs(ctx, ready)
+	setPendingTxsHeld(ready, held)
+
+	for idx, task := range tasks {
+		task.setResult(prepared[idx].finish())
+		ts.walletPool.Release(task.wallet.GetBech32())
+	}
+}
+
+// acquireWallet returns the wallet which already signed txs of the bridge data, if any, so that its remaining txs
+// are sent in order from the same account. Otherwise, the least loaded wallet of the pool is selected.
+func (ts *txSender) acquireWallet(sender string) TxSigner {
+	if len(sender) != 0 {
+		wallet, found := ts.walletPool.Acquire(sender)
+		if found {
+			return wallet
+		}
+
+		log.Warn("wallet of journaled bridge operation not found in pool, selecting another one", "address", sender)
+	}
+
+	return ts.walletPool.Select()
+}
+
+// getEntryWallet returns the wallet which already signed txs of the entry, or the provided wallet if there is none
+func (ts *txSender) getEntryWallet(entry *journal.Entry, wallet TxSigner) TxSigner {
+	sender := entry.Sender()
+	if len(sender) == 0 {
+		return wallet
+	}
+
+	entryWallet, found := ts.walletPool.Get(sender)
+	if !found {
+		return wallet
+	}
+
+	return entryWallet
+}
+
+func getEntriesSender(entries []*journal.Entry) string {
+	for _, entry := range entries {
+		sender := entry.Sender()
+		if len(sender) != 0 {
+			return sender
+		}
+	}
+
+	return ""
+}
+
+// getSubmittedEntries returns the journaled entries of an already received bridge data. A bridge data is considered
+// already received if its hash is journaled or if all of its outgoing operations are journaled.
+func (ts *txSender) getSubmittedEntries(bridgeData *sovereign.BridgeOutGoingData) ([]*journal.Entry, bool) {
+	entry, found := ts.journal.Get(bridgeData.Hash)
+	if found {
+		return []*journal.Entry{entry}, true
+	}
+	if len(bridgeData.OutGoingOperations) == 0 {
+		return nil, false
+	}
+
+	entries := make([]*journal.Entry, 0)
+	entriesHashes := make(map[string]struct{})
+	for _, operation := range bridgeData.OutGoingOperations {
+		entry, found = ts.journal.GetByOperation(operation.Hash)
+		if !found {
+			return nil, false
+		}
+
+		if _, exists := entriesHashes[string(entry.Hash)]; !exists {
+			entriesHashes[string(entry.Hash)] = struct{}{}
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, true
+}
+
+func (ts *txSender) prepareBridgeDataTxs(
+	wallet TxSigner,
+	bridgeData *sovereign.BridgeOutGoingData,
+	submittedEntries []*journal.Entry,
+) *preparedTxs {
+	if len(submittedEntries) == 0 {
+		return ts.prepareNewBridgeDataTxs(wallet, bridgeData)
+	}
+
+	prepared := newPreparedTxs(bridgeData.Hash)
+	for _, entry := range submittedEntries {
+		entryTxs := ts.prepareSubmittedEntryTxs(ts.getEntryWallet(entry, wallet), entry)
+		prepared.result.Txs = append(prepared.result.Txs, entryTxs.result.Txs...)
+		prepared.pending = append(prepared.pending, entryTxs.pending...)
+		if entryTxs.result.Stage != bridge.ErrorStage_None {
+			prepared.result.Stage = entryTxs.result.Stage
+			prepared.result.Error = entryTxs.result.Error
+		}
+	}
+
+	return prepared
+}
+
+// prepareSubmittedEntryTxs returns the txs previously sent for the journaled entry. Txs which failed on the network
+// are prepared to be sent again, as well as txs which were not sent at all. Entries whose txs could never be built
+// return their journaled error.
+func (ts *txSender) prepareSubmittedEntryTxs(wallet TxSigner, entry *journal.Entry) *preparedTxs {
+	if entry.Failed {
+		err := fmt.Errorf("%w, error = %s", errBridgeDataFailed, entry.Error)
+		return newPreparedTxsError(entry.Hash, bridge.ErrorStage_Formatting, err)
+	}
+	if entry.HasFailedTxs() {
+		log.Info("resending failed txs of already received bridge operation", "hash", entry.Hash)
+
+		err := ts.journal.ResetFailedTxs(entry.Hash)
+		if err != nil {
+			return newPreparedTxsError(entry.Hash, bridge.ErrorStage_Journal, err)
+		}
+
+		updatedEntry, found := ts.journal.Get(entry.Hash)
+		if !found {
+			err = fmt.Errorf("%w, hash = %s", errJournalEntryNotFound, hex.EncodeToString(entry.Hash))
+			return newPreparedTxsError(entry.Hash, bridge.ErrorStage_Journal, err)
+		}
+
+		entry = updatedEntry
+	}
+
+	sentTxs := make([]*bridge.TxResult, 0, len(entry.Txs))
+	for _, txRecord := range entry.Txs {
+		if txRecord.IsBroadcast() && len(txRecord.Hash) != 0 {
+			sentTxs = append(sentTxs, &bridge.TxResult{
+				Data: txRecord.Data,
+				Hash: txRecord.Hash,
+			})
+		}
+	}
+
+	if entry.IsFinished() {
+		log.Debug("bridge operation already sent", "hash", entry.Hash, "num txs", len(sentTxs))
+		prepared := newPreparedTxs(entry.Hash)
+		prepared.result.Txs = sentTxs
+		return prepared
+	}
+
+	prepared := ts.prepareEntryTxs(wallet, entry)
+	prepared.result.Txs = append(sentTxs, prepared.result.Txs...)
+	return prepared
+}
+
+func (ts *txSender) prepareNewBridgeDataTxs(wallet TxSigner, bridgeData *sovereign.BridgeOutGoingData) *preparedTxs {
+	txsData, err := ts.dataFormatter.CreateBridgeDataTxsData(bridgeData)
+	if err != nil {
+		log.Error("could not create txs data", "hash", bridgeData.Hash, "error", err)
+		ts.setEntryFailed(bridgeData.Hash, err)
+		return newPreparedTxsError(bridgeData.Hash, bridge.ErrorStage_Formatting, err)
+	}
+
+	prepared := newPreparedTxs(bridgeData.Hash)
+
+	// txs data which cannot be sent are reported, but not journaled, since they would never be finished
+	validTxsData := make([][]byte, 0, len(txsData))
 	for _, txData := range txsData {
+		_, err = ts.getTxConfig(txData)
+		if err != nil {
+			log.Error("invalid tx data created", "data", string(txData), "error", err)
+			prepared.result.Txs = append(prepared.result.Txs, createTxErrorResult(txData, bridge.ErrorStage_Formatting, err))
+			continue
+		}
+
+		validTxsData = append(validTxsData, txData)
+	}
+
+	err = ts.journal.SetTxsData(bridgeData.Hash, validTxsData)
+	if err != nil {
+		prepared.result.Stage = bridge.ErrorStage_Journal
+		prepared.result.Error = err.Error()
+		return prepared
+	}
+
+	txs := make([]*journal.TxRecord, 0, len(validTxsData))
+	for _, txData := range validTxsData {
+		txs = append(txs, &journal.TxRecord{
+			Data:  txData,
+			State: journal.TxBuilt,
+		})
+	}
+
+	ts.addJournaledTxs(prepared, wallet, bridgeData.Hash, txs)
+	return prepared
+}
+
+// addJournaledTxs builds all txs which were not broadcast yet, to be sent in the next batch. Txs whose data cannot be
+// routed are reported, without being sent, while txs whose dependency already failed on the network are cancelled. Txs
+// journaled as signed by the same wallet are built again with their journaled nonce and gas, so that signing them
+// again results in the same tx.
+func (ts *txSender) addJournaledTxs(prepared *preparedTxs, wallet TxSigner, bridgeDataHash []byte, txs []*journal.TxRecord) {
+	dependencies := getDependencies(txs)
+	for txIndex, txRecord := range txs {
+		if txRecord.IsBroadcast() {
+			continue
+		}
+
+		dependsOn, err := getJournaledDependency(txs, dependencies[txIndex])
+		if err != nil {
+			ts.cancelTx(bridgeDataHash, txIndex, txRecord, err)
+			prepared.result.Txs = append(prepared.result.Txs, createTxErrorResult(txRecord.Data, bridge.ErrorStage_Dependency, err))
+			continue
+		}
+
 		tx := &coreTx.FrontendTransaction{
-			Value:    "0",
-			Sender:   ts.wallet.GetBech32(),
+			Sender:   wallet.GetBech32(),
 			GasPrice: ts.netConfigs.MinGasPrice,
-			GasLimit: gasLimitDefault, // todo: we need proper gas estimation in the future
-			Data:     txData,
+			Data:     txRecord.Data,
 			ChainID:  ts.netConfigs.ChainID,
 			Version:  ts.netConfigs.MinTransactionVersion,
 		}
 
-		err := ts.setTxFields(txData, tx)
+		txCfg, err := ts.setTxFields(txRecord.Data, tx)
 		if err != nil {
-			log.Error("invalid tx data received", "data", string(txData), "error", err)
+			log.Error("invalid tx data received", "data", string(txRecord.Data), "error", err)
+			prepared.result.Txs = append(prepared.result.Txs, createTxErrorResult(txRecord.Data, bridge.ErrorStage_Formatting, err))
 			continue
 		}
 
-		err = ts.txNonceHandler.ApplyNonceAndGasPrice(ctx, tx)
+		journaledNonce := txRecord.State == journal.TxSigned && txRecord.Sender == wallet.GetBech32()
+		if journaledNonce {
+			setJournaledTxFields(tx, txRecord)
+		}
+
+		prepared.pending = append(prepared.pending, &pendingTx{
+			wallet:         wallet,
+			bridgeDataHash: bridgeDataHash,
+			txIndex:        txIndex,
+			dependsOn:      dependsOn,
+			journaledNonce: journaledNonce,
+			tx:             tx,
+			txCfg:          txCfg,
+		})
+	}
+}
+
+func setJournaledTxFields(tx *coreTx.FrontendTransaction, txRecord *journal.TxRecord) {
+	tx.Nonce = txRecord.Nonce
+	if txRecord.GasPrice != 0 {
+		tx.GasPrice = txRecord.GasPrice
+	}
+	if txRecord.GasLimit != 0 {
+		tx.GasLimit = txRecord.GasLimit
+	}
+}
+
+// sendPendingTxs assigns nonces to all pending txs in one pass, signs them and broadcasts them in batches of at most
+// the max batch size. Once a tx fails after being built, the following txs are not sent, so that no nonce gaps are
+// created, and the nonces starting with the first tx which was not sent are released. Txs which were not sent are journaled as built
+// again and resumed when the same bridge data is received again. Txs keeping their journaled nonce are sent first.
+func (ts *txSender) sendPendingTxs(ctx context.Context, pending []*pendingTx) {
+	pending = ts.resumeSignedTxs(ctx, pending)
+	if len(pending) == 0 {
+		return
+	}
+
+	txs := make([]*coreTx.FrontendTransaction, 0, len(pending))
+	newNonceTxs := make([]*coreTx.FrontendTransaction, 0, len(pending))
+	newNoncePending := make([]*pendingTx, 0, len(pending))
+	for _, ptx := range pending {
+		txs = append(txs, ptx.tx)
+		if !ptx.journaledNonce {
+			newNonceTxs = append(newNonceTxs, ptx.tx)
+			newNoncePending = append(newNoncePending, ptx)
+		}
+	}
+
+	err := ts.retryPolicy.retry(ctx, "apply nonce", func() error {
+		return ts.txNonceHandler.ApplyNonceAndGasPrice(ctx, newNonceTxs...)
+	})
+	if err != nil {
+		log.Error("failed to apply nonce", "error", err)
+		setPendingTxsError(pending, bridge.ErrorStage_Nonce, err)
+		return
+	}
+
+	ts.estimateGasLimits(ctx, newNoncePending)
+	signed := ts.signPendingTxs(ctx, pending)
+	numSent := 0
+	for start := 0; start < len(signed); start += ts.maxBatchSize {
+		end := min(start+ts.maxBatchSize, len(signed))
+		numBatchSent := ts.broadcastPendingTxs(ctx, signed[start:end])
+		numSent = start + numBatchSent
+		if numSent < end {
+			setPendingTxsError(signed[end:], bridge.ErrorStage_Broadcast, errPreviousTxNotSent)
+			break
+		}
+	}
+
+	ts.resetUnsentTxs(pending[numSent:])
+	ts.txNonceHandler.ReleaseNonces(txs[numSent:]...)
+}
+
+// resumeSignedTxs handles the txs journaled as signed before the process stopped, whose broadcast outcome is unknown.
+// A tx whose nonce was already used on-chain was broadcast, so it is journaled as broadcast, with the hash of the same
+// signed tx, and tracked instead of being sent again. The nonces of the others are reserved, so that they are sent
+// again with their journaled nonce, ahead of the txs needing a new nonce. It returns the txs left to send.
+func (ts *txSender) resumeSignedTxs(ctx context.Context, pending []*pendingTx) []*pendingTx {
+	resumed := make([]*pendingTx, 0)
+	newNoncePending := make([]*pendingTx, 0, len(pending))
+	for _, ptx := range pending {
+		if ptx.journaledNonce {
+			resumed = append(resumed, ptx)
+			continue
+		}
+
+		newNoncePending = append(newNoncePending, ptx)
+	}
+	if len(resumed) == 0 {
+		return pending
+	}
+
+	accountNonces, err := ts.getAccountNonces(ctx, resumed)
+	if err != nil {
+		log.Error("could not check the nonces of the resumed signed txs", "error", err)
+		setPendingTxsError(pending, bridge.ErrorStage_Nonce, err)
+		return nil
+	}
+
+	sort.SliceStable(resumed, func(i, j int) bool {
+		return resumed[i].tx.Nonce < resumed[j].tx.Nonce
+	})
+	toSend := make([]*pendingTx, 0, len(resumed))
+	toSendTxs := make([]*coreTx.FrontendTransaction, 0, len(resumed))
+	for _, ptx := range resumed {
+		if ptx.tx.Nonce < accountNonces[ptx.tx.Sender] {
+			ts.setResumedTxBroadcast(ctx, ptx)
+			continue
+		}
+
+		toSend = append(toSend, ptx)
+		toSendTxs = append(toSendTxs, ptx.tx)
+	}
+
+	err = ts.txNonceHandler.ReserveNonces(ctx, toSendTxs...)
+	if err != nil {
+		log.Error("failed to reserve the nonces of the resumed signed txs", "error", err)
+		setPendingTxsError(toSend, bridge.ErrorStage_Nonce, err)
+		setPendingTxsError(newNoncePending, bridge.ErrorStage_Nonce, errPreviousTxNotSent)
+		return nil
+	}
+
+	return append(toSend, newNoncePending...)
+}
+
+func (ts *txSender) getAccountNonces(ctx context.Context, pending []*pendingTx) (map[string]uint64, error) {
+	accountNonces := make(map[string]uint64)
+	for _, ptx := range pending {
+		if _, found := accountNonces[ptx.tx.Sender]; found {
+			continue
+		}
+
+		account, err := ts.proxy.GetAccount(ctx, ptx.wallet.GetAddressHandler())
 		if err != nil {
 			return nil, err
 		}
 
-		err = ts.txInteractor.ApplyUserSignature(ts.wallet, tx)
+		accountNonces[ptx.tx.Sender] = account.Nonce
+	}
+
+	return accountNonces, nil
+}
+
+// setResumedTxBroadcast journals as broadcast the resumed signed tx, whose nonce was already used on-chain, and tracks it
+func (ts *txSender) setResumedTxBroadcast(ctx context.Context, ptx *pendingTx) {
+	err := ptx.wallet.SignTx(ctx, ptx.tx)
+	if err != nil {
+		log.Error("failed to sign resumed tx", "error", err, "nonce", ptx.tx.Nonce)
+		ptx.result = createTxErrorResult(ptx.tx.Data, bridge.ErrorStage_Signing, err)
+		return
+	}
+
+	txHash, err := ts.txHashComputer.ComputeTxHash(ptx.tx)
+	if err != nil {
+		log.Error("could not compute hash of resumed tx", "error", err, "nonce", ptx.tx.Nonce)
+		ptx.result = createTxErrorResult(ptx.tx.Data, bridge.ErrorStage_Signing, err)
+		return
+	}
+
+	hexTxHash := hex.EncodeToString(txHash)
+	log.Info("resumed tx was already broadcast", "hash", ptx.bridgeDataHash, "tx hash", hexTxHash, "nonce", ptx.tx.Nonce)
+	ptx.result = &bridge.TxResult{
+		Data: ptx.tx.Data,
+		Hash: hexTxHash,
+	}
+
+	err = ts.journal.UpdateTx(ptx.bridgeDataHash, ptx.txIndex, createTxRecord(ptx.tx, hexTxHash, journal.TxBroadcast))
+	if err != nil {
+		log.Error("could not journal sent tx", "hash", hexTxHash, "error", err)
+		ptx.result.Stage = bridge.ErrorStage_Journal
+		ptx.result.Error = err.Error()
+	}
+
+	ts.txTracker.Track(ptx.bridgeDataHash, hexTxHash)
+}
+
+// resetUnsentTxs journals the signed txs which were not sent as built again, since their nonces are released
+func (ts *txSender) resetUnsentTxs(unsent []*pendingTx) {
+	for _, ptx := range unsent {
+		if len(ptx.tx.Signature) == 0 && !ptx.journaledNonce {
+			continue
+		}
+
+		err := ts.journal.UpdateTx(ptx.bridgeDataHash, ptx.txIndex, &journal.TxRecord{State: journal.TxBuilt})
 		if err != nil {
-			return nil, err
+			log.Error("could not journal unsent tx", "hash", ptx.bridgeDataHash, "nonce", ptx.tx.Nonce, "error", err)
 		}
+	}
+}
 
-		hash, err := ts.txNonceHandler.SendTransactions(ctx, tx)
+// estimateGasLimits estimates the gas of each tx, with a single cost request for the txs having the same data
+func (ts *txSender) estimateGasLimits(ctx context.Context, pending []*pendingTx) {
+	gasLimits := make(map[string]uint64, len(pending))
+	for _, ptx := range pending {
+		gasLimit, found := gasLimits[string(ptx.tx.Data)]
+		if found {
+			ptx.tx.GasLimit = gasLimit
+			continue
+		}
+
+		ts.estimateGasLimit(ctx, ptx.tx, ptx.txCfg)
+		gasLimits[string(ptx.tx.Data)] = ptx.tx.GasLimit
+	}
+}
+
+// signPendingTxs signs each tx and journals it as signed. It returns the txs signed before the first failure.
+func (ts *txSender) signPendingTxs(ctx context.Context, pending []*pendingTx) []*pendingTx {
+	for idx, ptx := range pending {
+		err := ptx.wallet.SignTx(ctx, ptx.tx)
 		if err != nil {
-			log.Error("failed to send tx", "error", err, "nonce", tx.Nonce)
-			return nil, err
+			log.Error("failed to sign tx", "error", err, "nonce", ptx.tx.Nonce)
+			ptx.result = createTxErrorResult(ptx.tx.Data, bridge.ErrorStage_Signing, err)
+			setPendingTxsError(pending[idx+1:], bridge.ErrorStage_Signing, errPreviousTxNotSent)
+			return pending[:idx]
+		}
+
+		err = ts.journal.UpdateTx(ptx.bridgeDataHash, ptx.txIndex, createTxRecord(ptx.tx, "", journal.TxSigned))
+		if err != nil {
+			ptx.result = createTxErrorResult(ptx.tx.Data, bridge.ErrorStage_Journal, err)
+			setPendingTxsError(pending[idx+1:], bridge.ErrorStage_Journal, errPreviousTxNotSent)
+			return pending[:idx]
+		}
+	}
+
+	return pending
+}
+
+// broadcastPendingTxs sends the txs in a single request and sets the outcome of each of them. The batch is only sent up
+// to the first tx which was not accepted, since the following txs would wait behind a nonce gap: they are all reported
+// as not sent, so that their nonces are released, even if accepted. It returns the number of sent txs.
+func (ts *txSender) broadcastPendingTxs(ctx context.Context, batch []*pendingTx) int {
+	txs := make([]*coreTx.FrontendTransaction, 0, len(batch))
+	for _, ptx := range batch {
+		txs = append(txs, ptx.tx)
+	}
+
+	hashes, err := ts.sendTxs(ctx, txs)
+	for idx, ptx := range batch {
+		sentTxHash := hashes[idx]
+		if len(sentTxHash) == 0 {
+			setNotSentTxsError(batch[idx:], hashes[idx:], err)
+			return idx
+		}
+
+		ts.walletPool.TxSent(ptx.tx.Sender, ptx.tx.Nonce)
+
+		ptx.result = &bridge.TxResult{
+			Data: ptx.tx.Data,
+			Hash: sentTxHash,
+		}
+
+		errJournal := ts.journal.UpdateTx(ptx.bridgeDataHash, ptx.txIndex, createTxRecord(ptx.tx, sentTxHash, journal.TxBroadcast))
+		if errJournal != nil {
+			log.Error("could not journal sent tx", "hash", sentTxHash, "error", errJournal)
+			ptx.result.Stage = bridge.ErrorStage_Journal
+			ptx.result.Error = errJournal.Error()
+		}
+
+		ts.txTracker.Track(ptx.bridgeDataHash, sentTxHash)
+	}
+
+	return len(batch)
+}
+
+// setNotSentTxsError reports the txs starting with the first one which was not accepted. Txs accepted after it are
+// reported as not sent as well, since they cannot be executed before the missing nonce.
+func setNotSentTxsError(notSent []*pendingTx, hashes []string, err error) {
+	for idx, ptx := range notSent {
+		if len(hashes[idx]) != 0 {
+			log.Warn("tx accepted after a tx which was not, its nonce is released", "hash", hashes[idx], "nonce", ptx.tx.Nonce)
+			ptx.result = createTxErrorResult(ptx.tx.Data, bridge.ErrorStage_Broadcast, errPreviousTxNotSent)
+			continue
+		}
+
+		log.Error("failed to send tx", "error", err, "nonce", ptx.tx.Nonce)
+		ptx.result = createTxErrorResult(ptx.tx.Data, bridge.ErrorStage_Broadcast, err)
+	}
+}
+
+func setPendingTxsError(pending []*pendingTx, stage bridge.ErrorStage, err error) {
+	for _, ptx := range pending {
+		ptx.result = createTxErrorResult(ptx.tx.Data, stage, err)
+	}
+}
+
+// ResumeUnfinished sends all journaled txs which were not broadcast before the last shutdown. Bridge data whose txs
+// were not built yet are formatted again, once their epoch is checked, while already built txs are signed again with a
+// fresh nonce. Txs signed before the shutdown keep their nonce: they are journaled as broadcast if their nonce was
+// already used on-chain, or sent again otherwise.
+// Nothing is resumed in dry-run mode.
+func (ts *txSender) ResumeUnfinished(ctx context.Context) *bridge.OperationsResult {
+	if ts.dryRun {
+		log.Info("dry-run mode, unfinished bridge operations are not resumed")
+		return &bridge.OperationsResult{
+			Results: make([]*bridge.OutGoingDataResult, 0),
+			DryRun:  true,
+		}
+	}
+
+	ts.submissions.acquire(maxSubmissionPriority)
+	defer ts.submissions.release()
+
+	unfinished := ts.journal.Unfinished()
+	result := &bridge.OperationsResult{
+		Results: make([]*bridge.OutGoingDataResult, len(unfinished)),
+	}
+	ready := ts.splitUnfinishedByEpoch(unfinished, result)
+	tasks := make([]*sendTask, 0, len(ready))
+	for _, idx := range ready {
+		entry := unfinished[idx]
+		log.Info("resuming unfinished bridge operation", "hash", entry.Hash, "txs built", entry.TxsBuilt)
+
+		tasks = append(tasks, &sendTask{
+			wallet: ts.acquireWallet(entry.Sender()),
+			prepare: func(wallet TxSigner) *preparedTxs {
+				return ts.prepareEntryTxs(wallet, entry)
+			},
+			setResult: func(entryResult *bridge.OutGoingDataResult) {
+				result.Results[idx] = entryResult
+			},
+		})
+	}
+
+	ts.sendTasks(ctx, tasks)
+
+	return result
+}
+
+func (ts *txSender) prepareEntryTxs(wallet TxSigner, entry *journal.Entry) *preparedTxs {
+	if entry.TxsBuilt {
+		prepared := newPreparedTxs(entry.Hash)
+		ts.addJournaledTxs(prepared, wallet, entry.Hash, entry.Txs)
+		return prepared
+	}
+
+	bridgeData, err := entry.BridgeOutGoingData()
+	if err != nil {
+		log.Error("could not decode journaled bridge data", "hash", entry.Hash, "error", err)
+		ts.setEntryFailed(entry.Hash, err)
+		return newPreparedTxsError(entry.Hash, bridge.ErrorStage_Journal, err)
+	}
+
+	return ts.prepareNewBridgeDataTxs(wallet, bridgeData)
+}
+
+// setEntryFailed marks the journaled entry as failed, so that its txs are not built again by every retry or resume
+func (ts *txSender) setEntryFailed(hash []byte, err error) {
+	errJournal := ts.journal.SetFailed(hash, err.Error())
+	if errJournal != nil {
+		log.Error("could not journal failed bridge operation", "hash", hash, "error", errJournal)
+	}
+}
+
+func newPreparedTxs(hash []byte) *preparedTxs {
+	return &preparedTxs{
+		result: &bridge.OutGoingDataResult{
+			Hash: hash,
+			Txs:  make([]*bridge.TxResult, 0),
+		},
+	}
+}
+
+func newPreparedTxsError(hash []byte, stage bridge.ErrorStage, err error) *preparedTxs {
+	return &preparedTxs{
+		result: createBridgeDataErrorResult(hash, stage, err),
+	}
+}
+
+func createBridgeDataErrorResult(hash []byte, stage bridge.ErrorStage, err error) *bridge.OutGoingDataResult {
+	return &bridge.OutGoingDataResult{
+		Hash:  hash,
+		Txs:   make([]*bridge.TxResult, 0),
+		Stage: stage,
+		Error: err.Error(),
+	}
+}
+
+func createTxErrorResult(txData []byte, stage bridge.ErrorStage, err error) *bridge.TxResult {
+	return &bridge.TxResult{
+		Data:  txData,
+		Stage: stage,
+		Error: err.Error(),
+	}
+}
+
+// GetOperationResult returns the outcome of all txs sent for the bridge outgoing data with the provided hash
+func (ts *txSender) GetOperationResult(bridgeDataHash []byte) (*results.OperationResult, bool) {
+	return ts.txTracker.GetOperationResult(bridgeDataHash)
+}
+
+// sendTxs broadcasts the txs in a single request. Txs which were not accepted are sent again, as configured by the
+// retry policy. It returns the hash of each tx, which is empty for the txs which could not be sent.
+func (ts *txSender) sendTxs(ctx context.Context, txs []*coreTx.FrontendTransaction) ([]string, error) {
+	hashes := make([]string, len(txs))
+	err := ts.retryPolicy.retry(ctx, "send txs", func() error {
+		unsentIndexes := make([]int, 0, len(txs))
+		unsentTxs := make([]*coreTx.FrontendTransaction, 0, len(txs))
+		for idx, tx := range txs {
+			if len(hashes[idx]) == 0 {
+				unsentIndexes = append(unsentIndexes, idx)
+				unsentTxs = append(unsentTxs, tx)
+			}
 		}
 
-		txHashes = append(txHashes, hash...)
+		sentHashes, errSend := ts.txNonceHandler.SendTransactions(ctx, unsentTxs...)
+		numNotAccepted := 0
+		for i, idx := range unsentIndexes {
+			if i < len(sentHashes) {
+				hashes[idx] = sentHashes[i]
+			}
+			if len(hashes[idx]) == 0 {
+				numNotAccepted++
+			}
+		}
+
+		if errSend != nil {
+			return errSend
+		}
+		if numNotAccepted != 0 {
+			return fmt.Errorf("%w, not accepted = %d", errTxsNotAccepted, numNotAccepted)
+		}
+
+		return nil
+	})
+
+	return hashes, err
+}
+
+// pruneJournalLoop removes the journaled bridge outgoing data completed for longer than the journal retention. The
+// latest rotated validator set change is kept, so that the rotated epoch is still known after a restart.
+func (ts *txSender) pruneJournalLoop(ctx context.Context) {
+	ticker := time.NewTicker(ts.journalRetention)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing journal pruning loop")
+			return
+		case <-ticker.C:
+			ts.pruneJournal()
+		}
+	}
+}
+
+func (ts *txSender) pruneJournal() {
+	numPruned, err := ts.journal.Prune(time.Now().Add(-ts.journalRetention), ts.journalHistorySize, ts.epochs.isLatestRotation)
+	if err != nil {
+		log.Warn("could not prune journal", "error", err)
+		return
+	}
+
+	if numPruned != 0 {
+		log.Debug("pruned completed bridge operations from journal", "num entries", numPruned)
 	}
+}
 
-	return txHashes, nil
+func (ts *txSender) replaceStuckTxsLoop(ctx context.Context) {
+	ticker := time.NewTicker(ts.retryPolicy.StuckTxTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing stuck txs replacement loop")
+			return
+		case <-ticker.C:
+			ts.replaceStuckTxs(ctx)
+		}
+	}
 }
 
-func (ts *txSender) setTxFields(txData []byte, tx *coreTx.FrontendTransaction) error {
+func (ts *txSender) replaceStuckTxs(ctx context.Context) {
+	for _, stuckTx := range ts.txTracker.GetStuckTxs(ts.retryPolicy.StuckTxTimeout) {
+		err := ts.replaceStuckTx(ctx, stuckTx)
+		if err != nil {
+			log.Warn("could not replace stuck tx", "tx hash", stuckTx.TxHash, "error", err)
+		}
+	}
+}
+
+// replaceStuckTx re-signs the stuck tx with the same nonce and a higher gas price, so that the new tx replaces it. The
+// stuck tx is journaled as replaced and still tracked, since it may be executed before the new tx.
+func (ts *txSender) replaceStuckTx(ctx context.Context, stuckTx *results.PendingTx) error {
+	ts.submissions.acquire(maxSubmissionPriority)
+	defer ts.submissions.release()
+
+	entry, found := ts.journal.Get(stuckTx.BridgeDataHash)
+	if !found {
+		return fmt.Errorf("%w, hash = %s", errJournalEntryNotFound, hex.EncodeToString(stuckTx.BridgeDataHash))
+	}
+
+	txIndex, txRecord, found := findBroadcastTx(entry, stuckTx.TxHash)
+	if !found {
+		return fmt.Errorf("%w, tx hash = %s", errStuckTxNotFound, stuckTx.TxHash)
+	}
+
+	wallet, found := ts.walletPool.Get(txRecord.Sender)
+	if !found {
+		return fmt.Errorf("%w, address = %s", errWalletNotFound, txRecord.Sender)
+	}
+
+	// txs journaled without a gas price were sent with the network's min gas price
+	gasPrice := txRecord.GasPrice
+	if gasPrice == 0 {
+		gasPrice = ts.netConfigs.MinGasPrice
+	}
+	bumpedGasPrice, canBump := ts.retryPolicy.bumpGasPrice(gasPrice)
+	if !canBump {
+		return fmt.Errorf("%w, gas price = %d", errMaxGasPriceReached, gasPrice)
+	}
+
+	tx := &coreTx.FrontendTransaction{
+		Nonce:    txRecord.Nonce,
+		Sender:   wallet.GetBech32(),
+		GasPrice: bumpedGasPrice,
+		Data:     txRecord.Data,
+		ChainID:  ts.netConfigs.ChainID,
+		Version:  ts.netConfigs.MinTransactionVersion,
+	}
+	_, err := ts.setTxFields(txRecord.Data, tx)
+	if err != nil {
+		return err
+	}
+	if txRecord.GasLimit != 0 {
+		tx.GasLimit = txRecord.GasLimit
+	}
+
+	err = wallet.SignTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	hashes, err := ts.sendTxs(ctx, []*coreTx.FrontendTransaction{tx})
+	if err != nil {
+		return err
+	}
+
+	newTxHash := hashes[0]
+	log.Info("replaced stuck tx",
+		"nonce", tx.Nonce,
+		"old tx hash", stuckTx.TxHash,
+		"new tx hash", newTxHash,
+		"gas price", tx.GasPrice,
+	)
+
+	ts.txTracker.Replace(entry.Hash, stuckTx.TxHash, newTxHash)
+
+	newTxRecord := createTxRecord(tx, newTxHash, journal.TxBroadcast)
+	newTxRecord.ReplacedHashes = append(append([]string{}, txRecord.ReplacedHashes...), txRecord.Hash)
+	return ts.journal.UpdateTx(entry.Hash, txIndex, newTxRecord)
+}
+
+// GetWalletsStatus returns the nonce, balance and number of in-flight txs of each wallet used to send bridge txs
+func (ts *txSender) GetWalletsStatus() []*results.WalletStatus {
+	return ts.walletPool.GetStatus()
+}
+
+// Close stops replacing stuck txs, tracking sent txs and refreshing the wallets. It waits for the background loops to
+// return, so that nothing is sent after closing.
+func (ts *txSender) Close() error {
+	ts.cancel()
+	ts.loops.Wait()
+
+	errWalletPool := ts.walletPool.Close()
+	errTracker := ts.txTracker.Close()
+	if errWalletPool != nil {
+		return errWalletPool
+	}
+
+	return errTracker
+}
+
+func (ts *txSender) getTxConfig(txData []byte) (*txConfig, error) {
 	prefixID := getTxDataPrefix(txData)
 	txCfg, found := ts.txConfigs[prefixID]
 	if !found {
-		return fmt.Errorf("%w, prefix = %s", errInvalidTxDataPrefix, prefixID)
+		return nil, fmt.Errorf("%w, prefix = %s", errInvalidTxDataPrefix, prefixID)
+	}
+
+	return txCfg, nil
+}
+
+func (ts *txSender) setTxFields(txData []byte, tx *coreTx.FrontendTransaction) (*txConfig, error) {
+	txCfg, err := ts.getTxConfig(txData)
+	if err != nil {
+		return nil, err
 	}
 
 	tx.Receiver = txCfg.receiver
 	tx.GasLimit = txCfg.gasLimit
-	return nil
+	tx.Value = txCfg.value
+	return txCfg, nil
+}
+
+// estimateGasLimit sets the estimated gas limit on the tx, capped to the endpoint's max gas limit. If estimation fails,
+// the endpoint's default gas limit is kept.
+func (ts *txSender) estimateGasLimit(ctx context.Context, tx *coreTx.FrontendTransaction, txCfg *txConfig) {
+	endpoint := getTxDataPrefix(tx.Data)
+	gasLimit, err := ts.gasEstimator.EstimateGas(ctx, tx)
+	if err != nil {
+		log.Warn("could not estimate tx gas, using default gas limit",
+			"endpoint", endpoint,
+			"gas limit", tx.GasLimit,
+			"error", err,
+		)
+		return
+	}
+
+	if gasLimit > txCfg.maxGasLimit {
+		log.Warn("estimated tx gas exceeds endpoint max gas limit",
+			"endpoint", endpoint,
+			"estimated gas", gasLimit,
+			"max gas limit", txCfg.maxGasLimit,
+		)
+		gasLimit = txCfg.maxGasLimit
+	}
+
+	log.Debug("estimated tx gas", "endpoint", endpoint, "gas limit", gasLimit)
+	tx.GasLimit = gasLimit
+}
+
+func findBroadcastTx(entry *journal.Entry, txHash string) (int, *journal.TxRecord, bool) {
+	for idx, txRecord := range entry.Txs {
+		if txRecord.Hash == txHash && txRecord.State == journal.TxBroadcast {
+			return idx, txRecord, true
+		}
+	}
+
+	return 0, nil, false
+}
+
+func createTxRecord(tx *coreTx.FrontendTransaction, txHash string, state journal.TxState) *journal.TxRecord {
+	return &journal.TxRecord{
+		Sender:   tx.Sender,
+		Nonce:    tx.Nonce,
+		GasLimit: tx.GasLimit,
+		GasPrice: tx.GasPrice,
+		Hash:     txHash,
+		State:    state,
+	}
 }
 
 func getTxDataPrefix(txData []byte) string {
@@ -195,6 +1300,20 @@ func getTxDataPrefix(txData []byte) string {
 	return prefix[0]
 }
 
+// CheckNetwork checks that the network config is loaded and that the proxy is reachable
+func (ts *txSender) CheckNetwork(ctx context.Context) error {
+	if ts.netConfigs == nil || len(ts.netConfigs.ChainID) == 0 {
+		return errNetworkConfigNotLoaded
+	}
+
+	_, err := ts.proxy.GetLatestHyperBlockNonce(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errProxyUnreachable, err)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil checks if the underlying pointer is nil
 func (ts *txSender) IsInterfaceNil() bool {
 	return ts == nil
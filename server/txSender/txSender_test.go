@@ -2,10 +2,17 @@ package txSender
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
 
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
@@ -13,6 +20,7 @@ import (
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -24,28 +32,72 @@ const (
 
 func createArgs() TxSenderArgs {
 	return TxSenderArgs{
-		Wallet:                    &testscommon.CryptoComponentsHolderMock{},
-		Proxy:                     &testscommon.ProxyMock{},
-		TxInteractor:              &testscommon.TxInteractorMock{},
-		DataFormatter:             &testscommon.DataFormatterMock{},
-		TxNonceHandler:            &testscommon.TxNonceSenderHandlerMock{},
-		SCHeaderVerifierAddress:   scHeaderVerifierAddress,
-		SCEsdtSafeAddress:         scEsdtSafeAddress,
-		SCChangeValidatorsAddress: scChangeValidatorsSetAddress,
-		SCChainConfigAddress:      scChainConfigAddress,
+		WalletPool:     createWalletPool(createWallet("erd1sender")),
+		Proxy:          &testscommon.ProxyMock{},
+		DataFormatter:  &testscommon.DataFormatterMock{},
+		GasEstimator:   &testscommon.GasEstimatorMock{},
+		TxNonceHandler: &testscommon.TxNonceSenderHandlerMock{},
+		TxHashComputer: &testscommon.TxHashComputerMock{},
+		Journal:        &testscommon.JournalMock{},
+		TxTracker:      &testscommon.TxTrackerMock{},
+		RetryPolicy: RetryPolicy{
+			MaxAttempts: 3,
+			Backoff:     time.Millisecond,
+		},
+		RoutingTable: createRoutingTable(),
+		MaxBatchSize: 100,
+	}
+}
+
+// createTrackerSettingTxState returns a tracker which journals the provided state of each tracked tx right away, as if
+// its outcome was already known, and notifies its registered handlers
+func createTrackerSettingTxState(
+	setTxState func(hash []byte, txHash string, state journal.TxState) error,
+	state journal.TxState,
+) *testscommon.TxTrackerMock {
+	mut := sync.Mutex{}
+	handlers := make([]func(bridgeDataHash []byte, txHash string, state journal.TxState), 0)
+
+	return &testscommon.TxTrackerMock{
+		RegisterHandlerCalled: func(handler func(bridgeDataHash []byte, txHash string, state journal.TxState)) {
+			mut.Lock()
+			handlers = append(handlers, handler)
+			mut.Unlock()
+		},
+		TrackCalled: func(bridgeDataHash []byte, txHash string) {
+			_ = setTxState(bridgeDataHash, txHash, state)
+
+			mut.Lock()
+			defer mut.Unlock()
+
+			for _, handler := range handlers {
+				handler(bridgeDataHash, txHash, state)
+			}
+		},
 	}
 }
 
+func createRoutingTable() []EndpointConfig {
+	routingTable, _ := NewDefaultRoutingTable(ArgsDefaultRoutingTable{
+		HeaderVerifierSCAddress:   scHeaderVerifierAddress,
+		EsdtSafeSCAddress:         scEsdtSafeAddress,
+		ChangeValidatorsSCAddress: scChangeValidatorsSetAddress,
+		ChainConfigSCAddress:      scChainConfigAddress,
+	})
+
+	return routingTable
+}
+
 func TestNewTxSender(t *testing.T) {
 	t.Parallel()
 
-	t.Run("nil wallet", func(t *testing.T) {
+	t.Run("nil wallet pool", func(t *testing.T) {
 		args := createArgs()
-		args.Wallet = nil
+		args.WalletPool = nil
 
 		ts, err := NewTxSender(args)
 		require.Nil(t, ts)
-		require.Equal(t, errNilWallet, err)
+		require.Equal(t, errNilWalletPool, err)
 	})
 	t.Run("nil proxy", func(t *testing.T) {
 		args := createArgs()
@@ -55,21 +107,21 @@ func TestNewTxSender(t *testing.T) {
 		require.Nil(t, ts)
 		require.Equal(t, errNilProxy, err)
 	})
-	t.Run("nil tx interactor", func(t *testing.T) {
+	t.Run("nil data formatter", func(t *testing.T) {
 		args := createArgs()
-		args.TxInteractor = nil
+		args.DataFormatter = nil
 
 		ts, err := NewTxSender(args)
 		require.Nil(t, ts)
-		require.Equal(t, errNilTxInteractor, err)
+		require.Equal(t, errNilDataFormatter, err)
 	})
-	t.Run("nil data formatter", func(t *testing.T) {
+	t.Run("nil gas estimator", func(t *testing.T) {
 		args := createArgs()
-		args.DataFormatter = nil
+		args.GasEstimator = nil
 
 		ts, err := NewTxSender(args)
 		require.Nil(t, ts)
-		require.Equal(t, errNilDataFormatter, err)
+		require.Equal(t, errNilGasEstimator, err)
 	})
 	t.Run("nil tx nonce handler", func(t *testing.T) {
 		args := createArgs()
@@ -79,51 +131,80 @@ func TestNewTxSender(t *testing.T) {
 		require.Nil(t, ts)
 		require.Equal(t, errNilNonceHandler, err)
 	})
-	t.Run("empty sc header verifier address", func(t *testing.T) {
+	t.Run("nil tx hash computer", func(t *testing.T) {
 		args := createArgs()
-		args.SCHeaderVerifierAddress = ""
+		args.TxHashComputer = nil
 
 		ts, err := NewTxSender(args)
 		require.Nil(t, ts)
-		require.Equal(t, errNoHeaderVerifierSCAddress, err)
+		require.Equal(t, errNilTxHashComputer, err)
 	})
-	t.Run("empty sc esdt safe address", func(t *testing.T) {
+	t.Run("nil journal", func(t *testing.T) {
 		args := createArgs()
-		args.SCEsdtSafeAddress = ""
+		args.Journal = nil
 
 		ts, err := NewTxSender(args)
 		require.Nil(t, ts)
-		require.Equal(t, errNoEsdtSafeSCAddress, err)
+		require.Equal(t, errNilJournal, err)
 	})
-	t.Run("empty sc change validators address", func(t *testing.T) {
+	t.Run("nil tx tracker", func(t *testing.T) {
 		args := createArgs()
-		args.SCChangeValidatorsAddress = ""
+		args.TxTracker = nil
 
 		ts, err := NewTxSender(args)
 		require.Nil(t, ts)
-		require.Equal(t, errNoChangeValidatorSetSCAddress, err)
+		require.Equal(t, errNilTxTracker, err)
 	})
-	t.Run("empty chain config address", func(t *testing.T) {
+	t.Run("invalid retry policy", func(t *testing.T) {
 		args := createArgs()
-		args.SCChainConfigAddress = ""
+		args.RetryPolicy.MaxAttempts = 0
 
 		ts, err := NewTxSender(args)
 		require.Nil(t, ts)
-		require.Equal(t, errNoChainConfigSCAddress, err)
+		require.Equal(t, errInvalidMaxRetryAttempts, err)
 	})
-	t.Run("should work", func(t *testing.T) {
+	t.Run("invalid journal retention", func(t *testing.T) {
+		args := createArgs()
+		args.JournalRetention = -1
+
+		ts, err := NewTxSender(args)
+		require.Nil(t, ts)
+		require.Equal(t, errInvalidJournalRetention, err)
+
+		args = createArgs()
+		args.JournalHistorySize = -1
+
+		ts, err = NewTxSender(args)
+		require.Nil(t, ts)
+		require.Equal(t, errInvalidJournalHistorySize, err)
+	})
+	t.Run("duplicated type priority", func(t *testing.T) {
+		args := createArgs()
+		args.TypePriorities = []TypePriorityConfig{{Type: 1, Priority: 1}, {Type: 1, Priority: 2}}
+
+		ts, err := NewTxSender(args)
+		require.Nil(t, ts)
+		require.ErrorIs(t, err, errDuplicatedTypePriority)
+	})
+	t.Run("invalid routing table", func(t *testing.T) {
 		args := createArgs()
+		args.RoutingTable = args.RoutingTable[1:]
 
 		ts, err := NewTxSender(args)
+		require.Nil(t, ts)
+		require.ErrorIs(t, err, errUnroutedEndpointPrefix)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ts, err := NewTxSender(createArgs())
 		require.Nil(t, err)
 		require.False(t, ts.IsInterfaceNil())
 		require.Equal(t, map[string]*txConfig{
-			registerBridgeOpsPrefix:          {receiver: args.SCHeaderVerifierAddress, gasLimit: gasLimitDefault},
-			executeDepositBridgeOpsPrefix:    {receiver: args.SCEsdtSafeAddress, gasLimit: gasLimitDefault},
-			changeValidatorSetPrefix:         {receiver: args.SCChangeValidatorsAddress, gasLimit: gasLimitDefault},
-			executeRegisterValidatorPrefix:   {receiver: args.SCChainConfigAddress, gasLimit: gasLimitDefault},
-			executeUnRegisterValidatorPrefix: {receiver: args.SCChainConfigAddress, gasLimit: gasLimitDefault},
-			executeRegisterTokenPrefix:       {receiver: args.SCEsdtSafeAddress, gasLimit: gasLimitRegisterToken},
+			registerBridgeOpsPrefix:          {receiver: scHeaderVerifierAddress, gasLimit: gasLimitDefault, maxGasLimit: maxGasLimitDefault, value: "0"},
+			executeDepositBridgeOpsPrefix:    {receiver: scEsdtSafeAddress, gasLimit: gasLimitDefault, maxGasLimit: maxGasLimitExecuteBridgeOps, value: "0"},
+			changeValidatorSetPrefix:         {receiver: scChangeValidatorsSetAddress, gasLimit: gasLimitDefault, maxGasLimit: maxGasLimitDefault, value: "0"},
+			executeRegisterValidatorPrefix:   {receiver: scChainConfigAddress, gasLimit: gasLimitDefault, maxGasLimit: maxGasLimitDefault, value: "0"},
+			executeUnRegisterValidatorPrefix: {receiver: scChainConfigAddress, gasLimit: gasLimitDefault, maxGasLimit: maxGasLimitDefault, value: "0"},
+			executeRegisterTokenPrefix:       {receiver: scEsdtSafeAddress, gasLimit: gasLimitRegisterToken, maxGasLimit: maxGasLimitDefault, value: "0"},
 		}, ts.txConfigs)
 	})
 }
@@ -131,9 +212,13 @@ func TestNewTxSender(t *testing.T) {
 func TestTxSender_SendTxs(t *testing.T) {
 	t.Parallel()
 
+	mut := sync.Mutex{}
 	expectedCtx := context.Background()
-	expectedNonce := 0
-	expectedDataIdx := 0
+	numNonceCalls := 0
+	numSendCalls := 0
+	numSigned := 0
+	numApplied := 0
+	numSent := 0
 	expectedTxHashes := []string{"txHash1", "txHash2", "txHash3"}
 	expectedTxsData := [][]byte{
 		[]byte(registerBridgeOpsPrefix + "@" + "txData1"),
@@ -161,7 +246,20 @@ func TestTxSender_SendTxs(t *testing.T) {
 		MinTransactionVersion: 2,
 	}
 
+	wallet := createWallet("erd1sender")
+	wallet.SignTxCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) error {
+		if numSigned == 0 {
+			// the execute txs are signed in background, once the register tx is confirmed
+			require.Equal(t, expectedCtx, ctx)
+		}
+		require.Equal(t, uint64(numSigned+1), tx.Nonce)
+		tx.Signature = expectedSigs[numSigned]
+		numSigned++
+		return nil
+	}
+
 	args := createArgs()
+	args.WalletPool = createWalletPool(wallet)
 	args.Proxy = &testscommon.ProxyMock{
 		GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
 			require.Equal(t, expectedCtx, ctx)
@@ -169,66 +267,1180 @@ func TestTxSender_SendTxs(t *testing.T) {
 		},
 	}
 	args.DataFormatter = &testscommon.DataFormatterMock{
-		CreateTxsDataCalled: func(data *sovereign.BridgeOperations) [][]byte {
-			require.Equal(t, expectedBridgeData, data)
-			return expectedTxsData
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			require.Equal(t, expectedBridgeData.Data[0], bridgeData)
+			return expectedTxsData, nil
 		},
 	}
-	args.TxInteractor = &testscommon.TxInteractorMock{
-		ApplyUserSignatureCalled: func(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error {
-			tx.Signature = expectedSigs[expectedDataIdx]
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+			numNonceCalls++
+			// the register tx is sent first, while the execute txs are sent in one pass once it is confirmed
+			require.Len(t, txs, []int{1, 2}[numNonceCalls-1])
+			for _, tx := range txs {
+				require.Equal(t, &transaction.FrontendTransaction{
+					Nonce:    0,
+					Value:    "0",
+					Receiver: expectedTxsReceiver[numApplied],
+					Sender:   wallet.GetBech32(),
+					GasPrice: expectedNetworkConfig.MinGasPrice,
+					GasLimit: 50_000_000,
+					Data:     expectedTxsData[numApplied],
+					ChainID:  expectedNetworkConfig.ChainID,
+					Version:  expectedNetworkConfig.MinTransactionVersion,
+				}, tx)
+
+				numApplied++
+				tx.Nonce = uint64(numApplied)
+			}
 			return nil
 		},
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			mut.Lock()
+			defer mut.Unlock()
+
+			numSendCalls++
+			if numSendCalls == 1 {
+				require.Equal(t, expectedCtx, ctx)
+			}
+			require.Len(t, txs, []int{1, 2}[numSendCalls-1])
+
+			hashes := make([]string, 0, len(txs))
+			for _, tx := range txs {
+				require.Equal(t, &transaction.FrontendTransaction{
+					Nonce:     uint64(numSent + 1),
+					Value:     "0",
+					Receiver:  expectedTxsReceiver[numSent],
+					Sender:    wallet.GetBech32(),
+					GasPrice:  expectedNetworkConfig.MinGasPrice,
+					GasLimit:  50_000_000,
+					Data:      expectedTxsData[numSent],
+					Signature: expectedSigs[numSent],
+					ChainID:   expectedNetworkConfig.ChainID,
+					Version:   expectedNetworkConfig.MinTransactionVersion,
+				}, tx)
+
+				hashes = append(hashes, expectedTxHashes[numSent])
+				numSent++
+			}
+
+			return hashes, nil
+		},
 	}
+
+	journaledStates := make(map[int][]journal.TxState)
+	journaledTxs := make([]*journal.TxRecord, 3)
+	args.Journal = &testscommon.JournalMock{
+		AddCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+			require.Equal(t, expectedBridgeData.Data[0], bridgeData)
+			return nil
+		},
+		SetTxsDataCalled: func(hash []byte, txsData [][]byte) error {
+			require.Equal(t, expectedBridgeData.Data[0].Hash, hash)
+			require.Equal(t, expectedTxsData[:3], txsData) // invalid txs data should not be journaled
+			for idx, txData := range txsData {
+				journaledTxs[idx] = &journal.TxRecord{Data: txData, State: journal.TxBuilt}
+			}
+			return nil
+		},
+		UpdateTxCalled: func(hash []byte, txIndex int, tx *journal.TxRecord) error {
+			require.Equal(t, expectedBridgeData.Data[0].Hash, hash)
+			require.Equal(t, wallet.GetBech32(), tx.Sender)
+			require.Equal(t, uint64(txIndex+1), tx.Nonce)
+			require.Equal(t, uint64(gasLimitDefault), tx.GasLimit)
+			require.Equal(t, expectedNetworkConfig.MinGasPrice, tx.GasPrice)
+			journaledStates[txIndex] = append(journaledStates[txIndex], tx.State)
+			journaledTxs[txIndex] = tx
+			if tx.State == journal.TxBroadcast {
+				require.Equal(t, expectedTxHashes[txIndex], tx.Hash)
+			}
+			return nil
+		},
+		GetCalled: func(hash []byte) (*journal.Entry, bool) {
+			if journaledTxs[0] == nil {
+				return nil, false
+			}
+
+			return &journal.Entry{Hash: hash, TxsBuilt: true, Txs: journaledTxs}, true
+		},
+	}
+
+	trackedTxHashes := make([]string, 0)
+	var onTxOutcome func(bridgeDataHash []byte, txHash string, state journal.TxState)
+	args.TxTracker = &testscommon.TxTrackerMock{
+		RegisterHandlerCalled: func(handler func(bridgeDataHash []byte, txHash string, state journal.TxState)) {
+			onTxOutcome = handler
+		},
+		TrackCalled: func(bridgeDataHash []byte, txHash string) {
+			mut.Lock()
+			defer mut.Unlock()
+
+			require.Equal(t, expectedBridgeData.Data[0].Hash, bridgeDataHash)
+			trackedTxHashes = append(trackedTxHashes, txHash)
+			journaledTxs[len(trackedTxHashes)-1].State = journal.TxConfirmed
+			onTxOutcome(bridgeDataHash, txHash, journal.TxConfirmed)
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	result := ts.SendTxs(expectedCtx, expectedBridgeData)
+	require.Equal(t, expectedTxHashes[:1], result.TxHashes())
+	require.Len(t, result.Results, 1)
+	require.Equal(t, expectedBridgeData.Data[0].Hash, result.Results[0].Hash)
+	require.True(t, result.Results[0].Failed())
+
+	txResults := result.Results[0].Txs
+	require.Len(t, txResults, 5)
+	for idx, invalidTxData := range expectedTxsData[3:] {
+		require.Equal(t, invalidTxData, txResults[idx].Data)
+		require.Equal(t, bridge.ErrorStage_Formatting, txResults[idx].Stage)
+		require.Contains(t, txResults[idx].Error, errInvalidTxDataPrefix.Error())
+	}
+	require.Equal(t, expectedTxsData[0], txResults[2].Data)
+	require.Equal(t, expectedTxHashes[0], txResults[2].Hash)
+	for idx := 1; idx < len(expectedTxHashes); idx++ {
+		require.Equal(t, expectedTxsData[idx], txResults[idx+2].Data)
+		require.True(t, txResults[idx+2].Held)
+		require.False(t, txResults[idx+2].Failed())
+	}
+
+	require.Eventually(t, func() bool {
+		mut.Lock()
+		defer mut.Unlock()
+
+		return len(trackedTxHashes) == len(expectedTxHashes)
+	}, time.Second, time.Millisecond)
+	mut.Lock()
+	defer mut.Unlock()
+
+	require.Equal(t, expectedTxHashes, trackedTxHashes)
+	require.Equal(t, 2, numNonceCalls)
+	require.Equal(t, 2, numSendCalls)
+	require.Equal(t, 3, numSigned)
+	require.Equal(t, map[int][]journal.TxState{
+		0: {journal.TxSigned, journal.TxBroadcast},
+		1: {journal.TxSigned, journal.TxBroadcast},
+		2: {journal.TxSigned, journal.TxBroadcast},
+	}, journaledStates)
+}
+
+func TestTxSender_SendTxsGasEstimation(t *testing.T) {
+	t.Parallel()
+
+	txsData := [][]byte{
+		[]byte(registerBridgeOpsPrefix + "@" + "txData1"),
+		[]byte(executeDepositBridgeOpsPrefix + "@" + "txData2"),
+		[]byte(executeRegisterTokenPrefix + "@" + "txData3"),
+	}
+	estimatedGas := map[string]uint64{
+		string(txsData[0]): 10_000_000,
+		string(txsData[1]): maxGasLimitExecuteBridgeOps + 1,
+	}
+
+	args := createArgs()
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			return txsData, nil
+		},
+	}
+	args.GasEstimator = &testscommon.GasEstimatorMock{
+		EstimateGasCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (uint64, error) {
+			gas, found := estimatedGas[string(tx.Data)]
+			if !found {
+				return 0, errGasEstimationFailed
+			}
+
+			return gas, nil
+		},
+	}
+
+	fileJournal, err := journal.NewFileJournal(t.TempDir())
+	require.Nil(t, err)
+	args.Journal = fileJournal
+	args.TxTracker = createTrackerSettingTxState(fileJournal.SetTxState, journal.TxConfirmed)
+
+	mut := sync.Mutex{}
+	sentGasLimits := make([]uint64, 0)
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			mut.Lock()
+			defer mut.Unlock()
+
+			hashes := make([]string, 0, len(txs))
+			for _, tx := range txs {
+				sentGasLimits = append(sentGasLimits, tx.GasLimit)
+				hashes = append(hashes, "hash"+string(tx.Data))
+			}
+			return hashes, nil
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("hash")}},
+	})
+	require.Nil(t, result.Err())
+
+	// the execute txs are sent in background, once the register tx is confirmed
+	require.Eventually(t, func() bool {
+		entry, _ := fileJournal.Get([]byte("hash"))
+		return isConfirmed(entry)
+	}, time.Second, time.Millisecond)
+	mut.Lock()
+	defer mut.Unlock()
+
+	require.Equal(t, []uint64{
+		10_000_000,                  // estimated
+		maxGasLimitExecuteBridgeOps, // capped
+		gasLimitRegisterToken,       // default, estimation failed
+	}, sentGasLimits)
+}
+
+func TestTxSender_SendTxsAlreadySubmitted(t *testing.T) {
+	t.Parallel()
+
+	fileJournal, err := journal.NewFileJournal(t.TempDir())
+	require.Nil(t, err)
+
+	bridgeData := &sovereign.BridgeOutGoingData{
+		Hash: []byte("bridgeDataHash"),
+		OutGoingOperations: []*sovereign.OutGoingOperation{
+			{Hash: []byte("opHash1"), Data: []byte("opData1")},
+			{Hash: []byte("opHash2"), Data: []byte("opData2")},
+		},
+	}
+	// same operations received again, under a different hash
+	resentBridgeData := &sovereign.BridgeOutGoingData{
+		Hash:               []byte("otherBridgeDataHash"),
+		OutGoingOperations: bridgeData.OutGoingOperations,
+	}
+
+	args := createArgs()
+	args.Journal = fileJournal
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(data *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			require.Equal(t, bridgeData.Hash, data.Hash)
+			return [][]byte{
+				[]byte(executeDepositBridgeOpsPrefix + "@opData1"),
+				[]byte(executeDepositBridgeOpsPrefix + "@opData2"),
+			}, nil
+		},
+	}
+
+	sentTxsData := make([]string, 0)
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			sentTxsData = append(sentTxsData, string(txs[0].Data))
+			return []string{fmt.Sprintf("txHash%d", len(sentTxsData))}, nil
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	sendTxs := func(bridgeData *sovereign.BridgeOutGoingData) []string {
+		result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{bridgeData},
+		})
+		require.Nil(t, result.Err())
+		return result.TxHashes()
+	}
+
+	require.Equal(t, []string{"txHash1", "txHash2"}, sendTxs(bridgeData))
+	require.Len(t, sentTxsData, 2)
+
+	// retried request, should not resend anything
+	require.Equal(t, []string{"txHash1", "txHash2"}, sendTxs(bridgeData))
+	require.Equal(t, []string{"txHash1", "txHash2"}, sendTxs(resentBridgeData))
+	require.Len(t, sentTxsData, 2)
+
+	// second tx failed on the network, only this one should be resent
+	err = fileJournal.SetTxState(bridgeData.Hash, "txHash1", journal.TxConfirmed)
+	require.Nil(t, err)
+	err = fileJournal.SetTxState(bridgeData.Hash, "txHash2", journal.TxFailed)
+	require.Nil(t, err)
+
+	require.Equal(t, []string{"txHash1", "txHash3"}, sendTxs(bridgeData))
+	require.Equal(t, []string{
+		executeDepositBridgeOpsPrefix + "@opData1",
+		executeDepositBridgeOpsPrefix + "@opData2",
+		executeDepositBridgeOpsPrefix + "@opData2",
+	}, sentTxsData)
+}
+
+func TestTxSender_SendTxsGasEstimationPerTxData(t *testing.T) {
+	t.Parallel()
+
+	txsData := [][]byte{
+		[]byte(executeDepositBridgeOpsPrefix + "@" + "txData1"),
+		[]byte(executeDepositBridgeOpsPrefix + "@" + "largerTxData2"),
+		[]byte(executeDepositBridgeOpsPrefix + "@" + "txData1"),
+	}
+
+	args := createArgs()
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			return txsData, nil
+		},
+	}
+
+	estimatedTxsData := make([]string, 0)
+	args.GasEstimator = &testscommon.GasEstimatorMock{
+		EstimateGasCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (uint64, error) {
+			estimatedTxsData = append(estimatedTxsData, string(tx.Data))
+			return uint64(len(tx.Data)) * 1_000_000, nil
+		},
+	}
+
+	sentGasLimits := make([]uint64, 0)
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			hashes := make([]string, 0, len(txs))
+			for _, tx := range txs {
+				sentGasLimits = append(sentGasLimits, tx.GasLimit)
+				hashes = append(hashes, "hash"+string(tx.Data))
+			}
+			return hashes, nil
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("hash")}},
+	})
+	require.Nil(t, result.Err())
+
+	// each tx gets its own estimation, while txs with the same data are estimated once
+	require.Equal(t, []string{string(txsData[0]), string(txsData[1])}, estimatedTxsData)
+	require.Equal(t, []uint64{
+		uint64(len(txsData[0])) * 1_000_000,
+		uint64(len(txsData[1])) * 1_000_000,
+		uint64(len(txsData[2])) * 1_000_000,
+	}, sentGasLimits)
+}
+
+func TestTxSender_SendTxsPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	errFormat := errors.New("format error")
+	errBroadcast := errors.New("broadcast error")
+	txsData := [][]byte{
+		[]byte(executeDepositBridgeOpsPrefix + "@txData1"),
+		[]byte(executeDepositBridgeOpsPrefix + "@txData2"),
+		[]byte(executeDepositBridgeOpsPrefix + "@txData3"),
+	}
+
+	args := createArgs()
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			if string(bridgeData.Hash) == "invalidHash" {
+				return nil, errFormat
+			}
+
+			return txsData, nil
+		},
+	}
+
+	args.RetryPolicy.MaxAttempts = 1
+	args.MaxBatchSize = 1
+
+	wallet := createWallet("erd1sender")
+	wallet.SignTxCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) error {
+		tx.Signature = "signature"
+		return nil
+	}
+	args.WalletPool = createWalletPool(wallet)
+
+	journaledStates := make(map[int]journal.TxState)
+	args.Journal = &testscommon.JournalMock{
+		UpdateTxCalled: func(hash []byte, txIndex int, tx *journal.TxRecord) error {
+			journaledStates[txIndex] = tx.State
+			return nil
+		},
+	}
+
+	sentTxsData := make([]string, 0)
+	releasedTxsData := make([]string, 0)
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			sentTxsData = append(sentTxsData, string(txs[0].Data))
+			if len(sentTxsData) == 2 {
+				return nil, errBroadcast
+			}
+
+			return []string{"txHash1"}, nil
+		},
+		ReleaseNoncesCalled: func(txs ...*transaction.FrontendTransaction) {
+			for _, tx := range txs {
+				releasedTxsData = append(releasedTxsData, string(tx.Data))
+			}
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{Hash: []byte("invalidHash")},
+			{Hash: []byte("hash")},
+		},
+	})
+
+	require.NotNil(t, result.Err())
+	require.Equal(t, []string{"txHash1"}, result.TxHashes())
+	require.Len(t, result.Results, 2)
+
+	require.Equal(t, []byte("invalidHash"), result.Results[0].Hash)
+	require.Equal(t, bridge.ErrorStage_Formatting, result.Results[0].Stage)
+	require.Equal(t, errFormat.Error(), result.Results[0].Error)
+	require.Empty(t, result.Results[0].Txs)
+
+	// the third tx should not be sent after the second one failed, to avoid nonce gaps
+	require.Equal(t, []byte("hash"), result.Results[1].Hash)
+	require.Equal(t, []string{string(txsData[0]), string(txsData[1])}, sentTxsData)
+	require.Len(t, result.Results[1].Txs, 3)
+	require.Equal(t, "txHash1", result.Results[1].Txs[0].Hash)
+	require.False(t, result.Results[1].Txs[0].Failed())
+	require.Equal(t, txsData[1], result.Results[1].Txs[1].Data)
+	require.Equal(t, bridge.ErrorStage_Broadcast, result.Results[1].Txs[1].Stage)
+	require.Equal(t, errBroadcast.Error(), result.Results[1].Txs[1].Error)
+	require.Equal(t, txsData[2], result.Results[1].Txs[2].Data)
+	require.Equal(t, bridge.ErrorStage_Broadcast, result.Results[1].Txs[2].Stage)
+	require.Equal(t, errPreviousTxNotSent.Error(), result.Results[1].Txs[2].Error)
+
+	// nonces of the unsent txs should be assigned again to the next txs, so they are journaled as built again
+	require.Equal(t, []string{string(txsData[1]), string(txsData[2])}, releasedTxsData)
+	require.Equal(t, map[int]journal.TxState{0: journal.TxBroadcast, 1: journal.TxBuilt, 2: journal.TxBuilt}, journaledStates)
+}
+
+func TestTxSender_SendTxsSigningFailure(t *testing.T) {
+	t.Parallel()
+
+	errSign := errors.New("sign error")
+	txsData := [][]byte{
+		[]byte(executeDepositBridgeOpsPrefix + "@txData1"),
+		[]byte(executeDepositBridgeOpsPrefix + "@txData2"),
+		[]byte(executeDepositBridgeOpsPrefix + "@txData3"),
+	}
+
+	args := createArgs()
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			return txsData, nil
+		},
+	}
+
+	numSigned := 0
+	wallet := createWallet("erd1sender")
+	wallet.SignTxCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) error {
+		numSigned++
+		if numSigned == 2 {
+			return errSign
+		}
+		return nil
+	}
+	args.WalletPool = createWalletPool(wallet)
+
+	nonce := uint64(10)
+	releasedNonces := make([]uint64, 0)
+	sentNonces := make([]uint64, 0)
 	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
 		ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
-			require.Len(t, txs, 1) // we update transactions one at a time
-			require.Equal(t, &transaction.FrontendTransaction{
-				Nonce:    0,
-				Value:    "0",
-				Receiver: expectedTxsReceiver[expectedDataIdx],
-				Sender:   args.Wallet.GetBech32(),
-				GasPrice: expectedNetworkConfig.MinGasPrice,
-				GasLimit: 50_000_000,
-				Data:     expectedTxsData[expectedDataIdx],
-				ChainID:  expectedNetworkConfig.ChainID,
-				Version:  expectedNetworkConfig.MinTransactionVersion,
-			}, txs[0])
-
-			expectedNonce++
-			txs[0].Nonce = uint64(expectedNonce)
+			for _, tx := range txs {
+				tx.Nonce = nonce
+				nonce++
+			}
 			return nil
 		},
+		ReleaseNoncesCalled: func(txs ...*transaction.FrontendTransaction) {
+			for _, tx := range txs {
+				releasedNonces = append(releasedNonces, tx.Nonce)
+			}
+		},
 		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
-			defer func() {
-				expectedDataIdx++
-			}()
+			hashes := make([]string, 0, len(txs))
+			for _, tx := range txs {
+				sentNonces = append(sentNonces, tx.Nonce)
+				hashes = append(hashes, "txHash")
+			}
+			return hashes, nil
+		},
+	}
 
-			require.Equal(t, expectedCtx, ctx)
-			require.Len(t, txs, 1) // we send transactions one at a time
-			require.Equal(t, &transaction.FrontendTransaction{
-				Nonce:     uint64(expectedNonce),
-				Value:     "0",
-				Receiver:  expectedTxsReceiver[expectedDataIdx],
-				Sender:    args.Wallet.GetBech32(),
-				GasPrice:  expectedNetworkConfig.MinGasPrice,
-				GasLimit:  50_000_000,
-				Data:      expectedTxsData[expectedDataIdx],
-				Signature: expectedSigs[expectedDataIdx],
-				ChainID:   expectedNetworkConfig.ChainID,
-				Version:   expectedNetworkConfig.MinTransactionVersion,
-			}, txs[0])
+	ts, _ := NewTxSender(args)
+	result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("hash")}},
+	})
+
+	require.NotNil(t, result.Err())
+	require.Len(t, result.Results[0].Txs, 3)
+	require.Equal(t, bridge.ErrorStage_Signing, result.Results[0].Txs[1].Stage)
+	require.Equal(t, errSign.Error(), result.Results[0].Txs[1].Error)
+	require.Equal(t, errPreviousTxNotSent.Error(), result.Results[0].Txs[2].Error)
+	require.Equal(t, []uint64{10}, sentNonces)
+	require.Equal(t, []uint64{11, 12}, releasedNonces)
+}
+
+func TestTxSender_SendTxsFormattingFailure(t *testing.T) {
+	t.Parallel()
+
+	fileJournal, err := journal.NewFileJournal(t.TempDir())
+	require.Nil(t, err)
+
+	errFormat := errors.New("format error")
+	numFormatCalls := 0
+	args := createArgs()
+	args.Journal = fileJournal
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			numFormatCalls++
+			return nil, errFormat
+		},
+	}
 
-			return []string{expectedTxHashes[expectedDataIdx]}, nil
+	ts, _ := NewTxSender(args)
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("hash")}},
+	}
+	result := ts.SendTxs(context.Background(), bridgeOps)
+	require.Equal(t, bridge.ErrorStage_Formatting, result.Results[0].Stage)
+
+	entry, found := fileJournal.Get([]byte("hash"))
+	require.True(t, found)
+	require.True(t, entry.Failed)
+	require.Equal(t, errFormat.Error(), entry.Error)
+	require.Empty(t, fileJournal.Unfinished())
+
+	// retried bridge data should get the journaled error, without being formatted again
+	result = ts.SendTxs(context.Background(), bridgeOps)
+	require.Equal(t, bridge.ErrorStage_Formatting, result.Results[0].Stage)
+	require.Contains(t, result.Results[0].Error, errBridgeDataFailed.Error())
+	require.Contains(t, result.Results[0].Error, errFormat.Error())
+	require.Equal(t, 1, numFormatCalls)
+
+	result = ts.ResumeUnfinished(context.Background())
+	require.Empty(t, result.Results)
+	require.Equal(t, 1, numFormatCalls)
+}
+
+func TestTxSender_SendTxsBatches(t *testing.T) {
+	t.Parallel()
+
+	// execute txs without a register tx do not depend on each other, so they are all sent in the same pass
+	createTxsData := func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+		return [][]byte{
+			[]byte(executeDepositBridgeOpsPrefix + "@" + string(bridgeData.Hash) + "@op1"),
+			[]byte(executeDepositBridgeOpsPrefix + "@" + string(bridgeData.Hash) + "@op2"),
+		}, nil
+	}
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{Hash: []byte("hash1")},
+			{Hash: []byte("hash2")},
+			{Hash: []byte("hash3")},
+		},
+	}
+
+	t.Run("all txs of a request should be sent in batches of max size", func(t *testing.T) {
+		args := createArgs()
+		args.MaxBatchSize = 4
+		args.DataFormatter = &testscommon.DataFormatterMock{
+			CreateBridgeDataTxsDataCalled: createTxsData,
+		}
+
+		numNonceCalls := 0
+		batchSizes := make([]int, 0)
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+				numNonceCalls++
+				require.Len(t, txs, 6)
+				return nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				batchSizes = append(batchSizes, len(txs))
+				hashes := make([]string, 0, len(txs))
+				for _, tx := range txs {
+					hashes = append(hashes, "hash"+string(tx.Data))
+				}
+				return hashes, nil
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		result := ts.SendTxs(context.Background(), bridgeOps)
+		require.Nil(t, result.Err())
+		require.Len(t, result.TxHashes(), 6)
+		require.Equal(t, 1, numNonceCalls)
+		require.Equal(t, []int{4, 2}, batchSizes)
+		for idx, bridgeDataResult := range result.Results {
+			require.Equal(t, bridgeOps.Data[idx].Hash, bridgeDataResult.Hash)
+			require.Len(t, bridgeDataResult.Txs, 2)
+		}
+	})
+	t.Run("only unsent txs should be retried and txs after the first unsent one should be reported as not sent", func(t *testing.T) {
+		errBroadcast := errors.New("broadcast error")
+		args := createArgs()
+		args.RetryPolicy.MaxAttempts = 2
+		args.DataFormatter = &testscommon.DataFormatterMock{
+			CreateBridgeDataTxsDataCalled: createTxsData,
+		}
+
+		sentBatches := make([][]string, 0)
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				batch := make([]string, 0, len(txs))
+				hashes := make([]string, 0, len(txs))
+				for _, tx := range txs {
+					batch = append(batch, string(tx.Data))
+					if strings.Contains(string(tx.Data), "@hash2@") {
+						hashes = append(hashes, "")
+						continue
+					}
+					hashes = append(hashes, "hash"+string(tx.Data))
+				}
+				sentBatches = append(sentBatches, batch)
+
+				return hashes, errBroadcast
+			},
+		}
+
+		releasedTxsData := make([]string, 0)
+		args.TxNonceHandler.(*testscommon.TxNonceSenderHandlerMock).ReleaseNoncesCalled = func(txs ...*transaction.FrontendTransaction) {
+			for _, tx := range txs {
+				releasedTxsData = append(releasedTxsData, string(tx.Data))
+			}
+		}
+
+		ts, _ := NewTxSender(args)
+		result := ts.SendTxs(context.Background(), bridgeOps)
+		require.NotNil(t, result.Err())
+		require.Len(t, result.TxHashes(), 2)
+		require.Len(t, sentBatches, 2)
+		require.Len(t, sentBatches[0], 6)
+		require.Equal(t, []string{executeDepositBridgeOpsPrefix + "@hash2@op1", executeDepositBridgeOpsPrefix + "@hash2@op2"}, sentBatches[1])
+
+		require.False(t, result.Results[0].Failed())
+		require.True(t, result.Results[1].Failed())
+		require.True(t, result.Results[2].Failed())
+		for _, txResult := range result.Results[1].Txs {
+			require.Equal(t, bridge.ErrorStage_Broadcast, txResult.Stage)
+			require.Equal(t, errBroadcast.Error(), txResult.Error)
+			require.Empty(t, txResult.Hash)
+		}
+
+		// the txs accepted after the first unsent one would wait behind a nonce gap, so their nonces are released too
+		for _, txResult := range result.Results[2].Txs {
+			require.Equal(t, bridge.ErrorStage_Broadcast, txResult.Stage)
+			require.Equal(t, errPreviousTxNotSent.Error(), txResult.Error)
+			require.Empty(t, txResult.Hash)
+		}
+		require.Equal(t, sentBatches[0][2:], releasedTxsData)
+	})
+}
+
+func TestTxSender_SendTxsWalletPool(t *testing.T) {
+	t.Parallel()
+
+	fileJournal, err := journal.NewFileJournal(t.TempDir())
+	require.Nil(t, err)
+
+	wp, err := NewWalletPool(createWalletPoolArgs())
+	require.Nil(t, err)
+
+	args := createArgs()
+	args.Journal = fileJournal
+	args.TxTracker = createTrackerSettingTxState(fileJournal.SetTxState, journal.TxConfirmed)
+	args.WalletPool = wp
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			return [][]byte{
+				[]byte(registerBridgeOpsPrefix + "@" + string(bridgeData.Hash)),
+				[]byte(executeDepositBridgeOpsPrefix + "@" + string(bridgeData.Hash)),
+			}, nil
+		},
+	}
+
+	mut := sync.Mutex{}
+	sendersPerBridgeData := make(map[string][]string)
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			mut.Lock()
+			defer mut.Unlock()
+
+			hashes := make([]string, 0, len(txs))
+			for _, tx := range txs {
+				bridgeDataHash := strings.Split(string(tx.Data), "@")[1]
+				sendersPerBridgeData[bridgeDataHash] = append(sendersPerBridgeData[bridgeDataHash], tx.Sender)
+				hashes = append(hashes, fmt.Sprintf("%s-%s", tx.Sender, tx.Data))
+			}
+			return hashes, nil
 		},
 	}
 
 	ts, _ := NewTxSender(args)
-	txHashes, err := ts.SendTxs(expectedCtx, expectedBridgeData)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{Hash: []byte("hash1")},
+			{Hash: []byte("hash2")},
+		},
+	})
+	require.Nil(t, result.Err())
+	require.Len(t, result.TxHashes(), 2)
+
+	// the execute txs are sent in background, once the register txs are confirmed
+	require.Eventually(t, func() bool {
+		entry1, _ := fileJournal.Get([]byte("hash1"))
+		entry2, _ := fileJournal.Get([]byte("hash2"))
+		return isConfirmed(entry1) && isConfirmed(entry2)
+	}, time.Second, time.Millisecond)
+	mut.Lock()
+	defer mut.Unlock()
+
+	// txs of the same bridge data are sent from the same wallet, while bridge data are distributed across wallets
+	require.Equal(t, map[string][]string{
+		"hash1": {"erd1a", "erd1a"},
+		"hash2": {"erd1b", "erd1b"},
+	}, sendersPerBridgeData)
+
+	entry, _ := fileJournal.Get([]byte("hash2"))
+	require.Equal(t, "erd1b", entry.Sender())
+	require.Equal(t, []*results.WalletStatus{
+		{Address: "erd1a", InFlight: 1},
+		{Address: "erd1b", InFlight: 1},
+	}, ts.GetWalletsStatus())
+}
+
+func TestTxSender_SendTxsRetry(t *testing.T) {
+	t.Parallel()
+
+	errProxy := errors.New("proxy unavailable")
+	txData := []byte(executeDepositBridgeOpsPrefix + "@txData1")
+
+	args := createArgs()
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			return [][]byte{txData}, nil
+		},
+	}
+
+	sendAttempts := 0
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			sendAttempts++
+			if sendAttempts < args.RetryPolicy.MaxAttempts {
+				return nil, errProxy
+			}
+
+			return []string{"txHash1"}, nil
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("hash")}},
+	}
+	result := ts.SendTxs(context.Background(), bridgeOps)
+	require.Nil(t, result.Err())
+	require.Equal(t, []string{"txHash1"}, result.TxHashes())
+	require.Equal(t, args.RetryPolicy.MaxAttempts, sendAttempts)
+
+	// all attempts fail, the broadcast error is reported
+	sendAttempts = 0
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			sendAttempts++
+			return nil, errProxy
+		},
+	}
+
+	ts, _ = NewTxSender(args)
+	bridgeOps.Data[0].Hash = []byte("otherHash")
+	result = ts.SendTxs(context.Background(), bridgeOps)
+	require.ErrorContains(t, result.Err(), errProxy.Error())
+	require.Equal(t, bridge.ErrorStage_Broadcast, result.Results[0].Txs[0].Stage)
+	require.Equal(t, args.RetryPolicy.MaxAttempts, sendAttempts)
+}
+
+func TestTxSender_ReplaceStuckTxs(t *testing.T) {
+	t.Parallel()
+
+	fileJournal, err := journal.NewFileJournal(t.TempDir())
 	require.Nil(t, err)
-	require.Equal(t, expectedTxHashes, txHashes)
-	require.Equal(t, 3, expectedNonce)
-	require.Equal(t, 3, expectedDataIdx)
+
+	bridgeData := &sovereign.BridgeOutGoingData{Hash: []byte("bridgeDataHash")}
+	txData := []byte(executeDepositBridgeOpsPrefix + "@txData1")
+
+	wallet := createWallet("erd1sender")
+
+	args := createArgs()
+	args.Journal = fileJournal
+	args.WalletPool = createWalletPool(wallet)
+	args.RetryPolicy.GasPriceBumpPercentage = 50
+	args.RetryPolicy.MaxGasPrice = 2_000
+	args.Proxy = &testscommon.ProxyMock{
+		GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{MinGasPrice: 1_000}, nil
+		},
+	}
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			return [][]byte{txData}, nil
+		},
+	}
+	sentTxs := make([]*transaction.FrontendTransaction, 0)
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+			txs[0].Nonce = 7
+			return nil
+		},
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			sentTxs = append(sentTxs, txs[0])
+			return []string{fmt.Sprintf("txHash%d", len(sentTxs))}, nil
+		},
+	}
+
+	replacedTxs := make(map[string]string)
+	args.TxTracker = &testscommon.TxTrackerMock{
+		GetStuckTxsCalled: func(pendingFor time.Duration) []*results.PendingTx {
+			return []*results.PendingTx{{BridgeDataHash: bridgeData.Hash, TxHash: "txHash1"}}
+		},
+		ReplaceCalled: func(bridgeDataHash []byte, oldTxHash string, newTxHash string) {
+			require.Equal(t, bridgeData.Hash, bridgeDataHash)
+			replacedTxs[oldTxHash] = newTxHash
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+
+	result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{Data: []*sovereign.BridgeOutGoingData{bridgeData}})
+	require.Equal(t, []string{"txHash1"}, result.TxHashes())
+
+	ts.replaceStuckTxs(context.Background())
+	require.Equal(t, map[string]string{"txHash1": "txHash2"}, replacedTxs)
+	require.Len(t, sentTxs, 2)
+	require.Equal(t, sentTxs[0].Nonce, sentTxs[1].Nonce)
+	require.Equal(t, wallet.GetBech32(), sentTxs[1].Sender)
+	require.Equal(t, sentTxs[0].GasLimit, sentTxs[1].GasLimit)
+	require.Equal(t, txData, sentTxs[1].Data)
+	require.Equal(t, uint64(1_500), sentTxs[1].GasPrice)
+
+	entry, _ := fileJournal.Get(bridgeData.Hash)
+	require.Equal(t, "txHash2", entry.Txs[0].Hash)
+	require.Equal(t, uint64(1_500), entry.Txs[0].GasPrice)
+	require.Equal(t, journal.TxBroadcast, entry.Txs[0].State)
+	require.Equal(t, []string{"txHash1"}, entry.Txs[0].ReplacedHashes)
+
+	// gas price is capped to the max gas price
+	ts.txTracker = &testscommon.TxTrackerMock{
+		GetStuckTxsCalled: func(pendingFor time.Duration) []*results.PendingTx {
+			return []*results.PendingTx{{BridgeDataHash: bridgeData.Hash, TxHash: "txHash2"}}
+		},
+	}
+	ts.replaceStuckTxs(context.Background())
+	require.Len(t, sentTxs, 3)
+	require.Equal(t, uint64(2_000), sentTxs[2].GasPrice)
+
+	entry, _ = fileJournal.Get(bridgeData.Hash)
+	require.Equal(t, []string{"txHash1", "txHash2"}, entry.Txs[0].ReplacedHashes)
+
+	// max gas price reached, tx is not replaced anymore
+	ts.txTracker = &testscommon.TxTrackerMock{
+		GetStuckTxsCalled: func(pendingFor time.Duration) []*results.PendingTx {
+			return []*results.PendingTx{{BridgeDataHash: bridgeData.Hash, TxHash: "txHash3"}}
+		},
+	}
+	ts.replaceStuckTxs(context.Background())
+	require.Len(t, sentTxs, 3)
+}
+
+func TestTxSender_SendTxsJournalError(t *testing.T) {
+	t.Parallel()
+
+	errJournal := fmt.Errorf("journal error")
+	args := createArgs()
+	args.Journal = &testscommon.JournalMock{
+		AddCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+			return errJournal
+		},
+	}
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			require.Fail(t, "should not build txs if bridge data was not journaled")
+			return nil, nil
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("hash")}},
+	})
+	require.NotNil(t, result.Err())
+	require.Empty(t, result.TxHashes())
+	require.Len(t, result.Results, 1)
+	require.Equal(t, bridge.ErrorStage_Journal, result.Results[0].Stage)
+	require.Equal(t, errJournal.Error(), result.Results[0].Error)
+}
+
+func TestTxSender_Accept(t *testing.T) {
+	t.Parallel()
+
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{Hash: []byte("hash1")},
+			{Hash: []byte("hash2")},
+		},
+	}
+	noSendArgs := func() TxSenderArgs {
+		args := createArgs()
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				require.Fail(t, "should not send txs")
+				return nil, nil
+			},
+		}
+		return args
+	}
+
+	t.Run("should journal bridge data without sending txs", func(t *testing.T) {
+		fileJournal, err := journal.NewFileJournal(t.TempDir())
+		require.Nil(t, err)
+
+		args := noSendArgs()
+		args.Journal = fileJournal
+
+		ts, _ := NewTxSender(args)
+		result := ts.Accept(bridgeOps)
+		require.Nil(t, result.Err())
+		require.False(t, result.DryRun)
+		require.Len(t, result.Results, 2)
+		require.Empty(t, result.TxHashes())
+
+		entry, found := fileJournal.Get([]byte("hash2"))
+		require.True(t, found)
+		require.False(t, entry.TxsBuilt)
+		require.Len(t, fileJournal.Unfinished(), 2)
+
+		// accepting again the same bridge data should not journal it twice
+		result = ts.Accept(bridgeOps)
+		require.Nil(t, result.Err())
+		require.Len(t, fileJournal.Unfinished(), 2)
+	})
+	t.Run("journal error should be reported", func(t *testing.T) {
+		errJournal := errors.New("journal error")
+		args := noSendArgs()
+		args.Journal = &testscommon.JournalMock{
+			AddCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+				if string(bridgeData.Hash) == "hash2" {
+					return errJournal
+				}
+				return nil
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		result := ts.Accept(bridgeOps)
+		require.NotNil(t, result.Err())
+		require.False(t, result.Results[0].Failed())
+		require.Equal(t, bridge.ErrorStage_Journal, result.Results[1].Stage)
+		require.Equal(t, errJournal.Error(), result.Results[1].Error)
+	})
+	t.Run("dry-run mode should not journal anything", func(t *testing.T) {
+		args := noSendArgs()
+		args.DryRun = true
+		args.Journal = &testscommon.JournalMock{
+			AddCalled: func(bridgeData *sovereign.BridgeOutGoingData) error {
+				require.Fail(t, "should not journal in dry-run mode")
+				return nil
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		result := ts.Accept(bridgeOps)
+		require.True(t, result.DryRun)
+		require.Empty(t, result.Results)
+	})
+}
+
+func TestTxSender_ResumeUnfinished(t *testing.T) {
+	t.Parallel()
+
+	notBuiltBridgeData := &sovereign.BridgeOutGoingData{Hash: []byte("notBuilt")}
+	notBuiltBridgeDataBytes, err := proto.Marshal(notBuiltBridgeData)
+	require.Nil(t, err)
+
+	partiallySentHash := []byte("partiallySent")
+	entries := []*journal.Entry{
+		{
+			Hash:       notBuiltBridgeData.Hash,
+			BridgeData: notBuiltBridgeDataBytes,
+		},
+		{
+			Hash:     partiallySentHash,
+			TxsBuilt: true,
+			Txs: []*journal.TxRecord{
+				{Data: []byte(registerBridgeOpsPrefix + "@txData1"), Nonce: 1, Hash: "txHash1", State: journal.TxConfirmed},
+				{Data: []byte(executeDepositBridgeOpsPrefix + "@txData2"), Nonce: 2, State: journal.TxSigned},
+			},
+		},
+	}
+
+	args := createArgs()
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			require.Equal(t, notBuiltBridgeData.Hash, bridgeData.Hash)
+			return [][]byte{[]byte(executeDepositBridgeOpsPrefix + "@txData3")}, nil
+		},
+	}
+	args.Journal = &testscommon.JournalMock{
+		UnfinishedCalled: func() []*journal.Entry {
+			return entries
+		},
+		UpdateTxCalled: func(hash []byte, txIndex int, tx *journal.TxRecord) error {
+			if string(hash) == string(partiallySentHash) {
+				require.Equal(t, 1, txIndex)
+			}
+			return nil
+		},
+	}
+
+	sentTxsData := make([]string, 0)
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			sentTxsData = append(sentTxsData, string(txs[0].Data))
+			return []string{"hash" + string(txs[0].Data)}, nil
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	result := ts.ResumeUnfinished(context.Background())
+	require.Nil(t, result.Err())
+	require.Equal(t, []string{
+		executeDepositBridgeOpsPrefix + "@txData3",
+		executeDepositBridgeOpsPrefix + "@txData2",
+	}, sentTxsData)
+	require.Len(t, result.TxHashes(), 2)
+}
+
+func TestTxSender_ResumeSignedTxs(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("hash")
+	signedTxData := []byte(executeDepositBridgeOpsPrefix + "@txData1")
+	builtTxData := []byte(executeDepositBridgeOpsPrefix + "@txData2")
+	entries := []*journal.Entry{
+		{
+			Hash:     hash,
+			TxsBuilt: true,
+			Txs: []*journal.TxRecord{
+				{Data: signedTxData, Sender: "erd1sender", Nonce: 5, GasLimit: 100, GasPrice: 10, State: journal.TxSigned},
+				{Data: builtTxData, State: journal.TxBuilt},
+			},
+		},
+	}
+
+	type resumeOutcome struct {
+		mut             sync.Mutex
+		newNonceTxsData []string
+		reservedNonces  []uint64
+		sentTxs         []*transaction.FrontendTransaction
+		updatedTxs      map[int]*journal.TxRecord
+		trackedTxHashes []string
+	}
+	resumeSignedTxs := func(accountNonce uint64) (*bridge.OperationsResult, *resumeOutcome) {
+		outcome := &resumeOutcome{
+			updatedTxs: make(map[int]*journal.TxRecord),
+		}
+
+		wallet := createWallet("erd1sender")
+		wallet.SignTxCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) error {
+			tx.Signature = fmt.Sprintf("signature%d", tx.Nonce)
+			return nil
+		}
+
+		args := createArgs()
+		args.WalletPool = createWalletPool(wallet)
+		args.Proxy = &testscommon.ProxyMock{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Nonce: accountNonce}, nil
+			},
+		}
+		args.Journal = &testscommon.JournalMock{
+			UnfinishedCalled: func() []*journal.Entry {
+				return entries
+			},
+			UpdateTxCalled: func(hash []byte, txIndex int, tx *journal.TxRecord) error {
+				outcome.mut.Lock()
+				outcome.updatedTxs[txIndex] = tx
+				outcome.mut.Unlock()
+				return nil
+			},
+		}
+		args.TxTracker = &testscommon.TxTrackerMock{
+			TrackCalled: func(bridgeDataHash []byte, txHash string) {
+				outcome.mut.Lock()
+				outcome.trackedTxHashes = append(outcome.trackedTxHashes, txHash)
+				outcome.mut.Unlock()
+			},
+		}
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+				for _, tx := range txs {
+					outcome.newNonceTxsData = append(outcome.newNonceTxsData, string(tx.Data))
+					tx.Nonce = accountNonce + 1
+				}
+				return nil
+			},
+			ReserveNoncesCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+				for _, tx := range txs {
+					outcome.reservedNonces = append(outcome.reservedNonces, tx.Nonce)
+				}
+				return nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				hashes := make([]string, 0, len(txs))
+				for _, tx := range txs {
+					outcome.sentTxs = append(outcome.sentTxs, tx)
+					hashes = append(hashes, "hash"+tx.Signature)
+				}
+				return hashes, nil
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		return ts.ResumeUnfinished(context.Background()), outcome
+	}
+
+	t.Run("nonce already used on-chain, should journal the signed tx as broadcast without sending it again", func(t *testing.T) {
+		result, outcome := resumeSignedTxs(6)
+		require.Nil(t, result.Err())
+
+		signedTxHash := hex.EncodeToString([]byte("signature5"))
+		require.Equal(t, []string{signedTxHash, "hashsignature7"}, result.TxHashes())
+		require.Equal(t, []string{string(builtTxData)}, outcome.newNonceTxsData)
+		require.Empty(t, outcome.reservedNonces)
+		require.Len(t, outcome.sentTxs, 1)
+		require.Equal(t, builtTxData, outcome.sentTxs[0].Data)
+
+		require.Equal(t, journal.TxBroadcast, outcome.updatedTxs[0].State)
+		require.Equal(t, signedTxHash, outcome.updatedTxs[0].Hash)
+		require.Equal(t, uint64(5), outcome.updatedTxs[0].Nonce)
+		require.Contains(t, outcome.trackedTxHashes, signedTxHash)
+	})
+	t.Run("nonce not used on-chain, should send the same signed tx again with its journaled nonce", func(t *testing.T) {
+		result, outcome := resumeSignedTxs(5)
+		require.Nil(t, result.Err())
+
+		require.Equal(t, []string{"hashsignature5", "hashsignature6"}, result.TxHashes())
+		require.Equal(t, []string{string(builtTxData)}, outcome.newNonceTxsData)
+		require.Equal(t, []uint64{5}, outcome.reservedNonces)
+		require.Len(t, outcome.sentTxs, 2)
+		require.Equal(t, signedTxData, outcome.sentTxs[0].Data)
+		require.Equal(t, uint64(5), outcome.sentTxs[0].Nonce)
+		require.Equal(t, uint64(100), outcome.sentTxs[0].GasLimit)
+		require.Equal(t, uint64(10), outcome.sentTxs[0].GasPrice)
+		require.Equal(t, builtTxData, outcome.sentTxs[1].Data)
+	})
 }
 
 func TestTxSender_SendTxsConcurrently(t *testing.T) {
@@ -245,8 +1457,8 @@ func TestTxSender_SendTxsConcurrently(t *testing.T) {
 	wg.Add(numTxsToSend)
 
 	args.DataFormatter = &testscommon.DataFormatterMock{
-		CreateTxsDataCalled: func(data *sovereign.BridgeOperations) [][]byte {
-			return [][]byte{[]byte(executeDepositBridgeOpsPrefix + "@" + "txData")}
+		CreateBridgeDataTxsDataCalled: func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+			return [][]byte{[]byte(executeDepositBridgeOpsPrefix + "@" + "txData")}, nil
 		},
 	}
 	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
@@ -266,18 +1478,55 @@ func TestTxSender_SendTxsConcurrently(t *testing.T) {
 
 	for i := 0; i < numTxsToSend; i++ {
 		go func(idx int) {
-			txHashes, err := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+			result := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
 				Data: []*sovereign.BridgeOutGoingData{
 					{
 						Hash: []byte(fmt.Sprintf("hash%d", idx)),
 					},
 				},
 			})
-			require.Nil(t, err)
-			require.Equal(t, expectedTxHashes, txHashes)
+			require.Nil(t, result.Err())
+			require.Equal(t, expectedTxHashes, result.TxHashes())
 		}(i)
 	}
 
 	wg.Wait()
 	require.Equal(t, numTxsToSend, numSentTxs)
 }
+
+func TestTxSender_CheckNetwork(t *testing.T) {
+	t.Parallel()
+
+	t.Run("network config not loaded", func(t *testing.T) {
+		ts, _ := NewTxSender(createArgs())
+
+		err := ts.CheckNetwork(context.Background())
+		require.Equal(t, errNetworkConfigNotLoaded, err)
+	})
+	t.Run("proxy unreachable", func(t *testing.T) {
+		args := createArgs()
+		args.Proxy = &testscommon.ProxyMock{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{ChainID: "T"}, nil
+			},
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 0, errors.New("connection refused")
+			},
+		}
+		ts, _ := NewTxSender(args)
+
+		err := ts.CheckNetwork(context.Background())
+		require.ErrorIs(t, err, errProxyUnreachable)
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createArgs()
+		args.Proxy = &testscommon.ProxyMock{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{ChainID: "T"}, nil
+			},
+		}
+		ts, _ := NewTxSender(args)
+
+		require.Nil(t, ts.CheckNetwork(context.Background()))
+	})
+}
@@ -51,6 +51,25 @@ func LoadWallet(cfg WalletConfig) (core.CryptoComponentsHolder, error) {
 	return cryptoProvider.NewCryptoComponentsHolder(keyGen, privateKey)
 }
 
+// LoadWallets loads all wallets using provided configs
+func LoadWallets(cfgs []WalletConfig) ([]core.CryptoComponentsHolder, error) {
+	if len(cfgs) == 0 {
+		return nil, errNoWallets
+	}
+
+	wallets := make([]core.CryptoComponentsHolder, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		wallet, err := LoadWallet(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%w, wallet = %s", err, cfg.Path)
+		}
+
+		wallets = append(wallets, wallet)
+	}
+
+	return wallets, nil
+}
+
 func getWalletType(walletPath string) string {
 	tokens := strings.Split(walletPath, ".")
 	if len(tokens) < 2 {
@@ -0,0 +1,245 @@
+package txSender
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+)
+
+const minWalletsRefreshInterval = time.Millisecond * 100
+
+// ArgsWalletPool holds args to create a new wallet pool
+type ArgsWalletPool struct {
+	Wallets         []TxSigner
+	Proxy           Proxy
+	RefreshInterval time.Duration
+}
+
+type pooledWallet struct {
+	wallet       TxSigner
+	address      string
+	accountNonce uint64
+	balance      string
+	nextNonce    uint64
+	assigned     uint64
+}
+
+// inFlight returns the number of txs sent from the wallet which were not yet executed on the network
+func (pw *pooledWallet) inFlight() uint64 {
+	if pw.nextNonce <= pw.accountNonce {
+		return 0
+	}
+
+	return pw.nextNonce - pw.accountNonce
+}
+
+type walletPool struct {
+	proxy           Proxy
+	refreshInterval time.Duration
+
+	mut       sync.RWMutex
+	wallets   []*pooledWallet
+	byAddress map[string]*pooledWallet
+	cancel    context.CancelFunc
+}
+
+// NewWalletPool creates a pool of wallets across which bridge txs are distributed. Each wallet's nonce and balance
+// are periodically refreshed from the network and logged, together with its number of in-flight txs.
+func NewWalletPool(args ArgsWalletPool) (*walletPool, error) {
+	err := checkWalletPoolArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	wp := &walletPool{
+		proxy:           args.Proxy,
+		refreshInterval: args.RefreshInterval,
+		wallets:         make([]*pooledWallet, 0, len(args.Wallets)),
+		byAddress:       make(map[string]*pooledWallet),
+	}
+	for _, wallet := range args.Wallets {
+		pw := &pooledWallet{
+			wallet:  wallet,
+			address: wallet.GetBech32(),
+		}
+		wp.wallets = append(wp.wallets, pw)
+		wp.byAddress[pw.address] = pw
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wp.cancel = cancel
+
+	wp.refresh(ctx)
+	go wp.refreshLoop(ctx)
+
+	return wp, nil
+}
+
+func checkWalletPoolArgs(args ArgsWalletPool) error {
+	if len(args.Wallets) == 0 {
+		return errNoWallets
+	}
+	if check.IfNil(args.Proxy) {
+		return errNilProxy
+	}
+	if args.RefreshInterval < minWalletsRefreshInterval {
+		return errInvalidWalletsRefreshInterval
+	}
+
+	addresses := make(map[string]struct{})
+	for _, wallet := range args.Wallets {
+		if check.IfNil(wallet) {
+			return errNilWallet
+		}
+
+		address := wallet.GetBech32()
+		if _, exists := addresses[address]; exists {
+			return fmt.Errorf("%w, address = %s", errDuplicatedWallet, address)
+		}
+		addresses[address] = struct{}{}
+	}
+
+	return nil
+}
+
+// Select returns the wallet with the lowest load, counting both its in-flight txs and the bridge data currently
+// assigned to it. The selected wallet should be released once all txs of the bridge data are sent.
+func (wp *walletPool) Select() TxSigner {
+	wp.mut.Lock()
+	defer wp.mut.Unlock()
+
+	selected := wp.wallets[0]
+	for _, pw := range wp.wallets[1:] {
+		if pw.inFlight()+pw.assigned < selected.inFlight()+selected.assigned {
+			selected = pw
+		}
+	}
+
+	selected.assigned++
+	return selected.wallet
+}
+
+// Acquire returns the wallet with the provided address, if it is part of the pool. The acquired wallet should be
+// released once all txs of the bridge data are sent.
+func (wp *walletPool) Acquire(address string) (TxSigner, bool) {
+	wp.mut.Lock()
+	defer wp.mut.Unlock()
+
+	pw, found := wp.byAddress[address]
+	if !found {
+		return nil, false
+	}
+
+	pw.assigned++
+	return pw.wallet, true
+}
+
+// Release marks that the wallet with the provided address finished sending the txs of a bridge data
+func (wp *walletPool) Release(address string) {
+	wp.mut.Lock()
+	defer wp.mut.Unlock()
+
+	pw, found := wp.byAddress[address]
+	if found && pw.assigned > 0 {
+		pw.assigned--
+	}
+}
+
+// Get returns the wallet with the provided address, if it is part of the pool
+func (wp *walletPool) Get(address string) (TxSigner, bool) {
+	wp.mut.RLock()
+	defer wp.mut.RUnlock()
+
+	pw, found := wp.byAddress[address]
+	if !found {
+		return nil, false
+	}
+
+	return pw.wallet, true
+}
+
+// TxSent records that a tx with the provided nonce was sent from the wallet with the provided address
+func (wp *walletPool) TxSent(address string, nonce uint64) {
+	wp.mut.Lock()
+	defer wp.mut.Unlock()
+
+	pw, found := wp.byAddress[address]
+	if found && nonce+1 > pw.nextNonce {
+		pw.nextNonce = nonce + 1
+	}
+}
+
+// GetStatus returns the nonce, balance and number of in-flight txs of each wallet in the pool
+func (wp *walletPool) GetStatus() []*results.WalletStatus {
+	wp.mut.RLock()
+	defer wp.mut.RUnlock()
+
+	status := make([]*results.WalletStatus, 0, len(wp.wallets))
+	for _, pw := range wp.wallets {
+		status = append(status, &results.WalletStatus{
+			Address:  pw.address,
+			Nonce:    pw.accountNonce,
+			Balance:  pw.balance,
+			InFlight: pw.inFlight(),
+		})
+	}
+
+	return status
+}
+
+func (wp *walletPool) refreshLoop(ctx context.Context) {
+	ticker := time.NewTicker(wp.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing wallet pool refresh loop")
+			return
+		case <-ticker.C:
+			wp.refresh(ctx)
+		}
+	}
+}
+
+func (wp *walletPool) refresh(ctx context.Context) {
+	for _, pw := range wp.wallets {
+		account, err := wp.proxy.GetAccount(ctx, pw.wallet.GetAddressHandler())
+		if err != nil {
+			log.Warn("could not refresh wallet account", "address", pw.address, "error", err)
+			continue
+		}
+
+		wp.mut.Lock()
+		pw.accountNonce = account.Nonce
+		pw.balance = account.Balance
+		if pw.nextNonce < pw.accountNonce {
+			pw.nextNonce = pw.accountNonce
+		}
+		inFlight := pw.inFlight()
+		wp.mut.Unlock()
+
+		log.Info("wallet status",
+			"address", pw.address,
+			"nonce", account.Nonce,
+			"balance", account.Balance,
+			"in-flight txs", inFlight,
+		)
+	}
+}
+
+// Close stops refreshing the wallets
+func (wp *walletPool) Close() error {
+	wp.cancel()
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (wp *walletPool) IsInterfaceNil() bool {
+	return wp == nil
+}
@@ -0,0 +1,148 @@
+package txSender
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+type addressHandlerMock struct {
+	core.AddressHandler
+	address string
+}
+
+func createWallet(address string) *testscommon.TxSignerMock {
+	return &testscommon.TxSignerMock{
+		GetBech32Called: func() string {
+			return address
+		},
+		GetAddressHandlerCalled: func() core.AddressHandler {
+			return &addressHandlerMock{address: address}
+		},
+	}
+}
+
+func createWalletPool(wallets ...TxSigner) *walletPool {
+	wp, _ := NewWalletPool(ArgsWalletPool{
+		Wallets:         wallets,
+		Proxy:           &testscommon.ProxyMock{},
+		RefreshInterval: time.Hour,
+	})
+
+	return wp
+}
+
+func createWalletPoolArgs() ArgsWalletPool {
+	return ArgsWalletPool{
+		Wallets:         []TxSigner{createWallet("erd1a"), createWallet("erd1b")},
+		Proxy:           &testscommon.ProxyMock{},
+		RefreshInterval: time.Hour,
+	}
+}
+
+func TestNewWalletPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no wallets", func(t *testing.T) {
+		args := createWalletPoolArgs()
+		args.Wallets = nil
+
+		wp, err := NewWalletPool(args)
+		require.Equal(t, errNoWallets, err)
+		require.Nil(t, wp)
+	})
+	t.Run("nil wallet", func(t *testing.T) {
+		args := createWalletPoolArgs()
+		args.Wallets = append(args.Wallets, nil)
+
+		wp, err := NewWalletPool(args)
+		require.Equal(t, errNilWallet, err)
+		require.Nil(t, wp)
+	})
+	t.Run("duplicated wallet", func(t *testing.T) {
+		args := createWalletPoolArgs()
+		args.Wallets = append(args.Wallets, createWallet("erd1a"))
+
+		wp, err := NewWalletPool(args)
+		require.ErrorIs(t, err, errDuplicatedWallet)
+		require.Nil(t, wp)
+	})
+	t.Run("nil proxy", func(t *testing.T) {
+		args := createWalletPoolArgs()
+		args.Proxy = nil
+
+		wp, err := NewWalletPool(args)
+		require.Equal(t, errNilProxy, err)
+		require.Nil(t, wp)
+	})
+	t.Run("invalid refresh interval", func(t *testing.T) {
+		args := createWalletPoolArgs()
+		args.RefreshInterval = time.Millisecond
+
+		wp, err := NewWalletPool(args)
+		require.Equal(t, errInvalidWalletsRefreshInterval, err)
+		require.Nil(t, wp)
+	})
+	t.Run("should work", func(t *testing.T) {
+		wp, err := NewWalletPool(createWalletPoolArgs())
+		require.Nil(t, err)
+		require.False(t, wp.IsInterfaceNil())
+		require.Nil(t, wp.Close())
+	})
+}
+
+func TestWalletPool_Select(t *testing.T) {
+	t.Parallel()
+
+	args := createWalletPoolArgs()
+	args.Proxy = &testscommon.ProxyMock{
+		GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			if address.(*addressHandlerMock).address == "erd1a" {
+				return &data.Account{Nonce: 10, Balance: "100"}, nil
+			}
+
+			return nil, errors.New("proxy error")
+		},
+	}
+
+	wp, _ := NewWalletPool(args)
+	defer func() {
+		_ = wp.Close()
+	}()
+
+	// both wallets are idle, the first one is selected
+	wallet := wp.Select()
+	require.Equal(t, "erd1a", wallet.GetBech32())
+	wp.TxSent("erd1a", 10)
+	wp.TxSent("erd1a", 11)
+
+	// first wallet has one bridge data assigned and two in-flight txs
+	wallet = wp.Select()
+	require.Equal(t, "erd1b", wallet.GetBech32())
+
+	wp.Release("erd1a")
+	wp.Release("erd1b")
+	wp.TxSent("erd1b", 0)
+	require.Equal(t, "erd1b", wp.Select().GetBech32())
+
+	wallet, found := wp.Acquire("erd1a")
+	require.True(t, found)
+	require.Equal(t, "erd1a", wallet.GetBech32())
+	_, found = wp.Acquire("erd1c")
+	require.False(t, found)
+	_, found = wp.Get("erd1c")
+	require.False(t, found)
+
+	require.Equal(t, []*results.WalletStatus{
+		{Address: "erd1a", Nonce: 10, Balance: "100", InFlight: 2},
+		{Address: "erd1b", Nonce: 0, Balance: "", InFlight: 1},
+	}, wp.GetStatus())
+}
@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+)
+
+type signatureVerifier struct{}
+
+// NewDisabledSignatureVerifier creates a new instance of disabled signature verifier
+func NewDisabledSignatureVerifier() *signatureVerifier {
+	return &signatureVerifier{}
+}
+
+// Verify returns no error
+func (sv *signatureVerifier) Verify(_ *sovereign.BridgeOutGoingData) error {
+	return nil
+}
+
+// AddValidatorSetChange returns no error
+func (sv *signatureVerifier) AddValidatorSetChange(_ *sovereign.BridgeOutGoingData) error {
+	return nil
+}
+
+// ConfirmValidatorSetChange returns no error
+func (sv *signatureVerifier) ConfirmValidatorSetChange(_ *sovereign.BridgeOutGoingData) error {
+	return nil
+}
+
+// RemoveValidatorSetChange does nothing
+func (sv *signatureVerifier) RemoveValidatorSetChange(_ *sovereign.BridgeOutGoingData) {
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (sv *signatureVerifier) IsInterfaceNil() bool {
+	return sv == nil
+}
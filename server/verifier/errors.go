@@ -0,0 +1,19 @@
+package verifier
+
+import "errors"
+
+var errNilMultiSigVerifier = errors.New("nil multi signature verifier provided")
+
+var errNoStateFile = errors.New("no validators state file provided")
+
+var errNoValidators = errors.New("no validators bls public keys provided")
+
+var errUnknownEpoch = errors.New("no validator set known for epoch")
+
+var errInvalidPubKeysBitmap = errors.New("invalid public keys bitmap")
+
+var errNotEnoughSigners = errors.New("not enough signers in public keys bitmap")
+
+var errInvalidAggregatedSignature = errors.New("invalid aggregated signature")
+
+var errInvalidValidatorSetChange = errors.New("invalid validator set change")
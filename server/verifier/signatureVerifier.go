@@ -0,0 +1,328 @@
+package verifier
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"google.golang.org/protobuf/proto"
+)
+
+var log = logger.GetOrCreate("verifier")
+
+// ArgsSignatureVerifier holds args to create a new signature verifier. Pub keys are the bls public keys of the
+// validators at the provided epoch, in the order of the consensus group. The validator set of each epoch rotated by a
+// confirmed validator set change is saved in the state file, so that it is kept after a restart.
+type ArgsSignatureVerifier struct {
+	MultiSigVerifier crypto.MultiSigVerifier
+	Hasher           hashing.Hasher
+	Epoch            uint32
+	PubKeys          [][]byte
+	StateFile        string
+}
+
+// validatorSet holds the bls public keys of the validators, starting with the provided epoch
+type validatorSet struct {
+	epoch   uint32
+	pubKeys [][]byte
+}
+
+type signatureVerifier struct {
+	multiSigVerifier crypto.MultiSigVerifier
+	hasher           hashing.Hasher
+	stateFile        string
+
+	mut           sync.RWMutex
+	validatorSets []*validatorSet
+	// validator sets of sent validator set changes not confirmed yet, by hex encoded hash
+	pending map[string]*validatorSet
+}
+
+// NewSignatureVerifier creates a component which verifies the aggregated bls signature of bridge outgoing data against
+// the validator set of its epoch, before any tx is built for it. The validator set is seeded with the provided keys
+// and updated from confirmed validator set changes. Validator sets of later epochs saved in the state file are used
+// from their epochs.
+func NewSignatureVerifier(args ArgsSignatureVerifier) (*signatureVerifier, error) {
+	if check.IfNil(args.MultiSigVerifier) {
+		return nil, errNilMultiSigVerifier
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, core.ErrNilHasher
+	}
+	if len(args.PubKeys) == 0 {
+		return nil, errNoValidators
+	}
+	if len(args.StateFile) == 0 {
+		return nil, errNoStateFile
+	}
+
+	sv := &signatureVerifier{
+		multiSigVerifier: args.MultiSigVerifier,
+		hasher:           args.Hasher,
+		stateFile:        args.StateFile,
+		validatorSets: []*validatorSet{
+			{
+				epoch:   args.Epoch,
+				pubKeys: args.PubKeys,
+			},
+		},
+		pending: make(map[string]*validatorSet),
+	}
+
+	err := sv.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	return sv, nil
+}
+
+func (sv *signatureVerifier) loadState() error {
+	_, err := os.Stat(sv.stateFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	state, err := LoadValidatorsState(sv.stateFile)
+	if err != nil {
+		return err
+	}
+
+	seedEpoch := sv.validatorSets[0].epoch
+	for _, cfg := range state.ValidatorSets {
+		pubKeys, errDecode := cfg.DecodePubKeys()
+		if errDecode != nil {
+			return errDecode
+		}
+		if len(pubKeys) == 0 || cfg.Epoch <= seedEpoch {
+			continue
+		}
+
+		sv.insertValidatorSet(&validatorSet{
+			epoch:   cfg.Epoch,
+			pubKeys: pubKeys,
+		})
+		log.Info("loaded validator set", "epoch", cfg.Epoch, "validators", len(pubKeys), "file", sv.stateFile)
+	}
+
+	return nil
+}
+
+// Verify checks that the bridge outgoing data hash is signed by enough validators of its epoch, as selected by the
+// public keys bitmap. Bridge data which is only resent, without registering it again, is not verified, since its
+// signature is not sent to the contracts.
+func (sv *signatureVerifier) Verify(bridgeData *sovereign.BridgeOutGoingData) error {
+	if !sv.isSignatureSent(bridgeData) {
+		return nil
+	}
+
+	pubKeys, found := sv.getValidators(bridgeData.Epoch)
+	if !found {
+		return fmt.Errorf("%w, epoch = %d", errUnknownEpoch, bridgeData.Epoch)
+	}
+
+	signers, err := getSigners(pubKeys, bridgeData.PubKeysBitmap)
+	if err != nil {
+		return err
+	}
+
+	err = sv.multiSigVerifier.VerifyAggregatedSig(signers, bridgeData.Hash, bridgeData.AggregatedSignature)
+	if err != nil {
+		return fmt.Errorf("%w, epoch = %d, signers = %d: %v", errInvalidAggregatedSignature, bridgeData.Epoch, len(signers), err)
+	}
+
+	return nil
+}
+
+// isSignatureSent returns true if a registerBridgeOps or changeValidatorSet tx is built for the bridge data. Same as the
+// data formatter, operations whose hash is not the hash of their operations' hashes are only executed.
+func (sv *signatureVerifier) isSignatureSent(bridgeData *sovereign.BridgeOutGoingData) bool {
+	if bridgeData.Type == int32(block.OutGoingMbChangeValidatorSet) {
+		return true
+	}
+
+	hashes := make([]byte, 0)
+	for _, operation := range bridgeData.OutGoingOperations {
+		hashes = append(hashes, operation.Hash...)
+	}
+
+	return bytes.Equal(bridgeData.Hash, sv.hasher.Compute(string(hashes)))
+}
+
+// getValidators returns the validator set of the latest change up to the provided epoch, either confirmed or pending
+func (sv *signatureVerifier) getValidators(epoch uint32) ([][]byte, bool) {
+	sv.mut.RLock()
+	defer sv.mut.RUnlock()
+
+	var latest *validatorSet
+	for idx := len(sv.validatorSets) - 1; idx >= 0; idx-- {
+		if sv.validatorSets[idx].epoch <= epoch {
+			latest = sv.validatorSets[idx]
+			break
+		}
+	}
+	for _, pendingSet := range sv.pending {
+		if pendingSet.epoch <= epoch && (latest == nil || pendingSet.epoch > latest.epoch) {
+			latest = pendingSet
+		}
+	}
+	if latest == nil {
+		return nil, false
+	}
+
+	return latest.pubKeys, true
+}
+
+// getSigners returns the public keys selected by the bitmap, which should hold at least the consensus threshold of
+// signers. The bit of the validator with index i is bit i%8 of byte i/8.
+func getSigners(pubKeys [][]byte, bitmap []byte) ([][]byte, error) {
+	if len(bitmap) != (len(pubKeys)+7)/8 {
+		return nil, fmt.Errorf("%w, bitmap size = %d, validators = %d", errInvalidPubKeysBitmap, len(bitmap), len(pubKeys))
+	}
+
+	signers := make([][]byte, 0, len(pubKeys))
+	for idx := 0; idx < len(bitmap)*8; idx++ {
+		if bitmap[idx/8]&(1<<uint(idx%8)) == 0 {
+			continue
+		}
+		if idx >= len(pubKeys) {
+			return nil, fmt.Errorf("%w, signer index = %d, validators = %d", errInvalidPubKeysBitmap, idx, len(pubKeys))
+		}
+
+		signers = append(signers, pubKeys[idx])
+	}
+
+	threshold := core.GetPBFTThreshold(len(pubKeys))
+	if len(signers) < threshold {
+		return nil, fmt.Errorf("%w, signers = %d, threshold = %d", errNotEnoughSigners, len(signers), threshold)
+	}
+
+	return signers, nil
+}
+
+// AddValidatorSetChange stores the new validators of a received validator set change as pending, until its tx is confirmed.
+// Pending validator sets are only used to verify bridge data of the following epochs, so that bridge data signed by
+// them is accepted as soon as the sovereign chain sends it, but they are neither rotated nor saved. The key ids of the
+// validator set change are the validators' bls public keys, in the order of the consensus group.
+func (sv *signatureVerifier) AddValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) error {
+	newSet, isValidatorSetChange, err := parseValidatorSetChange(bridgeData)
+	if err != nil || !isValidatorSetChange {
+		return err
+	}
+
+	sv.mut.Lock()
+	sv.pending[hex.EncodeToString(bridgeData.Hash)] = newSet
+	sv.mut.Unlock()
+
+	log.Debug("added pending validator set", "epoch", newSet.epoch, "validators", len(newSet.pubKeys))
+	return nil
+}
+
+// ConfirmValidatorSetChange rotates the validator set once the tx of the validator set change is confirmed on-chain.
+// The new validators are used starting with the next epoch, and the key set of each epoch is saved in the state file.
+func (sv *signatureVerifier) ConfirmValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) error {
+	newSet, isValidatorSetChange, err := parseValidatorSetChange(bridgeData)
+	if err != nil || !isValidatorSetChange {
+		return err
+	}
+
+	validatorSets := sv.setValidators(hex.EncodeToString(bridgeData.Hash), newSet)
+	return sv.saveState(validatorSets)
+}
+
+// RemoveValidatorSetChange drops the pending validators of a validator set change whose tx failed on-chain, or which
+// was rejected before its tx was sent
+func (sv *signatureVerifier) RemoveValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) {
+	sv.mut.Lock()
+	defer sv.mut.Unlock()
+
+	key := hex.EncodeToString(bridgeData.Hash)
+	if _, found := sv.pending[key]; found {
+		delete(sv.pending, key)
+		log.Warn("dropped pending validator set of failed or rejected validator set change", "hash", key)
+	}
+}
+
+// parseValidatorSetChange returns the validator set starting with the epoch after the validator set change
+func parseValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) (*validatorSet, bool, error) {
+	if bridgeData.Type != int32(block.OutGoingMbChangeValidatorSet) {
+		return nil, false, nil
+	}
+	if len(bridgeData.OutGoingOperations) != 1 {
+		return nil, true, fmt.Errorf("%w, expected 1 operation, got %d", errInvalidValidatorSetChange, len(bridgeData.OutGoingOperations))
+	}
+
+	validatorSetChange := &sovereign.BridgeOutGoingDataValidatorSetChange{}
+	err := proto.Unmarshal(bridgeData.OutGoingOperations[0].Data, validatorSetChange)
+	if err != nil {
+		return nil, true, fmt.Errorf("%w: %v", errInvalidValidatorSetChange, err)
+	}
+	if len(validatorSetChange.PubKeyIDs) == 0 {
+		return nil, true, fmt.Errorf("%w: %v", errInvalidValidatorSetChange, errNoValidators)
+	}
+
+	return &validatorSet{
+		epoch:   bridgeData.Epoch + 1,
+		pubKeys: validatorSetChange.PubKeyIDs,
+	}, true, nil
+}
+
+// setValidators stores the confirmed validator set, replacing its pending one, if any, and returns all validator sets
+func (sv *signatureVerifier) setValidators(key string, newSet *validatorSet) []*validatorSet {
+	sv.mut.Lock()
+	defer sv.mut.Unlock()
+
+	delete(sv.pending, key)
+	sv.insertValidatorSet(newSet)
+	log.Info("changed validator set", "epoch", newSet.epoch, "validators", len(newSet.pubKeys))
+
+	return slices.Clone(sv.validatorSets)
+}
+
+// insertValidatorSet stores the validator set in epoch order, replacing the one of the same epoch
+func (sv *signatureVerifier) insertValidatorSet(newSet *validatorSet) {
+	idx := sort.Search(len(sv.validatorSets), func(i int) bool {
+		return sv.validatorSets[i].epoch >= newSet.epoch
+	})
+	if idx < len(sv.validatorSets) && sv.validatorSets[idx].epoch == newSet.epoch {
+		sv.validatorSets[idx] = newSet
+		return
+	}
+
+	sv.validatorSets = slices.Insert(sv.validatorSets, idx, newSet)
+}
+
+func (sv *signatureVerifier) saveState(validatorSets []*validatorSet) error {
+	state := &ValidatorsState{
+		ValidatorSets: make([]*ValidatorsConfig, 0, len(validatorSets)),
+	}
+	for _, set := range validatorSets {
+		cfg := &ValidatorsConfig{
+			Epoch:   set.epoch,
+			PubKeys: make([]string, 0, len(set.pubKeys)),
+		}
+		for _, pubKey := range set.pubKeys {
+			cfg.PubKeys = append(cfg.PubKeys, hex.EncodeToString(pubKey))
+		}
+
+		state.ValidatorSets = append(state.ValidatorSets, cfg)
+	}
+
+	return core.SaveTomlFile(state, sv.stateFile)
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (sv *signatureVerifier) IsInterfaceNil() bool {
+	return sv == nil
+}
@@ -0,0 +1,291 @@
+package verifier
+
+import (
+	"encoding/hex"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+var pubKeys = [][]byte{[]byte("pk0"), []byte("pk1"), []byte("pk2"), []byte("pk3")}
+
+func createArgs(t *testing.T) ArgsSignatureVerifier {
+	return ArgsSignatureVerifier{
+		MultiSigVerifier: &testscommon.MultiSigVerifierMock{},
+		Hasher: &testscommon.HasherMock{
+			ComputeCalled: func(s string) []byte {
+				return []byte(s)
+			},
+		},
+		Epoch:     2,
+		PubKeys:   pubKeys,
+		StateFile: filepath.Join(t.TempDir(), "validators.toml"),
+	}
+}
+
+func createBridgeData(epoch uint32, bitmap []byte) *sovereign.BridgeOutGoingData {
+	return &sovereign.BridgeOutGoingData{
+		Type:                int32(block.OutGoingMbDeposit),
+		Hash:                []byte("opHash1opHash2"),
+		OutGoingOperations:  []*sovereign.OutGoingOperation{{Hash: []byte("opHash1")}, {Hash: []byte("opHash2")}},
+		AggregatedSignature: []byte("aggregatedSig"),
+		PubKeysBitmap:       bitmap,
+		Epoch:               epoch,
+	}
+}
+
+func createValidatorSetChange(t *testing.T, epoch uint32, newPubKeys [][]byte) *sovereign.BridgeOutGoingData {
+	data, err := proto.Marshal(&sovereign.BridgeOutGoingDataValidatorSetChange{PubKeyIDs: newPubKeys})
+	require.Nil(t, err)
+
+	return &sovereign.BridgeOutGoingData{
+		Type:                int32(block.OutGoingMbChangeValidatorSet),
+		Hash:                []byte("changeHash"),
+		OutGoingOperations:  []*sovereign.OutGoingOperation{{Hash: []byte("opHash"), Data: data}},
+		AggregatedSignature: []byte("aggregatedSig"),
+		PubKeysBitmap:       []byte{0b0111},
+		Epoch:               epoch,
+	}
+}
+
+func TestNewSignatureVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil multi sig verifier", func(t *testing.T) {
+		args := createArgs(t)
+		args.MultiSigVerifier = nil
+		sv, err := NewSignatureVerifier(args)
+		require.Equal(t, errNilMultiSigVerifier, err)
+		require.Nil(t, sv)
+	})
+	t.Run("nil hasher", func(t *testing.T) {
+		args := createArgs(t)
+		args.Hasher = nil
+		sv, err := NewSignatureVerifier(args)
+		require.Equal(t, core.ErrNilHasher, err)
+		require.Nil(t, sv)
+	})
+	t.Run("no validators", func(t *testing.T) {
+		args := createArgs(t)
+		args.PubKeys = nil
+		sv, err := NewSignatureVerifier(args)
+		require.Equal(t, errNoValidators, err)
+		require.Nil(t, sv)
+	})
+	t.Run("no state file", func(t *testing.T) {
+		args := createArgs(t)
+		args.StateFile = ""
+		sv, err := NewSignatureVerifier(args)
+		require.Equal(t, errNoStateFile, err)
+		require.Nil(t, sv)
+	})
+	t.Run("should work", func(t *testing.T) {
+		sv, err := NewSignatureVerifier(createArgs(t))
+		require.Nil(t, err)
+		require.False(t, sv.IsInterfaceNil())
+	})
+}
+
+func TestSignatureVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should verify signers selected by bitmap", func(t *testing.T) {
+		args := createArgs(t)
+		args.MultiSigVerifier = &testscommon.MultiSigVerifierMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				require.Equal(t, [][]byte{pubKeys[0], pubKeys[2], pubKeys[3]}, pubKeysSigners)
+				require.Equal(t, []byte("opHash1opHash2"), message)
+				require.Equal(t, []byte("aggregatedSig"), aggSig)
+				return nil
+			},
+		}
+		sv, _ := NewSignatureVerifier(args)
+
+		require.Nil(t, sv.Verify(createBridgeData(2, []byte{0b1101})))
+		require.Nil(t, sv.Verify(createBridgeData(5, []byte{0b1101})))
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		args := createArgs(t)
+		args.MultiSigVerifier = &testscommon.MultiSigVerifierMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				return errors.New("signature mismatch")
+			},
+		}
+		sv, _ := NewSignatureVerifier(args)
+
+		err := sv.Verify(createBridgeData(2, []byte{0b1111}))
+		require.ErrorIs(t, err, errInvalidAggregatedSignature)
+		require.ErrorContains(t, err, "signature mismatch")
+	})
+	t.Run("invalid bitmap", func(t *testing.T) {
+		sv, _ := NewSignatureVerifier(createArgs(t))
+
+		require.ErrorIs(t, sv.Verify(createBridgeData(2, nil)), errInvalidPubKeysBitmap)
+		require.ErrorIs(t, sv.Verify(createBridgeData(2, []byte{0b1111, 0})), errInvalidPubKeysBitmap)
+		require.ErrorIs(t, sv.Verify(createBridgeData(2, []byte{0b11111})), errInvalidPubKeysBitmap)
+		require.ErrorIs(t, sv.Verify(createBridgeData(2, []byte{0b0101})), errNotEnoughSigners)
+	})
+	t.Run("unknown epoch", func(t *testing.T) {
+		sv, _ := NewSignatureVerifier(createArgs(t))
+
+		require.ErrorIs(t, sv.Verify(createBridgeData(1, []byte{0b1111})), errUnknownEpoch)
+	})
+	t.Run("resent operations should not be verified", func(t *testing.T) {
+		args := createArgs(t)
+		args.MultiSigVerifier = &testscommon.MultiSigVerifierMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				require.Fail(t, "should not verify")
+				return nil
+			},
+		}
+		sv, _ := NewSignatureVerifier(args)
+
+		bridgeData := createBridgeData(2, nil)
+		bridgeData.Hash = []byte("hash")
+		require.Nil(t, sv.Verify(bridgeData))
+	})
+}
+
+func TestSignatureVerifier_ValidatorSetChange(t *testing.T) {
+	t.Parallel()
+
+	newPubKeys := [][]byte{[]byte("pk4"), []byte("pk5")}
+
+	t.Run("pending validators should be used starting with next epoch, but only saved once confirmed", func(t *testing.T) {
+		var signers [][]byte
+		args := createArgs(t)
+		args.MultiSigVerifier = &testscommon.MultiSigVerifierMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				signers = pubKeysSigners
+				return nil
+			},
+		}
+		sv, _ := NewSignatureVerifier(args)
+
+		validatorSetChange := createValidatorSetChange(t, 3, newPubKeys)
+		require.Nil(t, sv.Verify(validatorSetChange))
+		require.Equal(t, pubKeys[:3], signers)
+		require.Nil(t, sv.AddValidatorSetChange(validatorSetChange))
+
+		require.Nil(t, sv.Verify(createBridgeData(3, []byte{0b1111})))
+		require.Equal(t, pubKeys, signers)
+		require.Nil(t, sv.Verify(createBridgeData(4, []byte{0b11})))
+		require.Equal(t, newPubKeys, signers)
+		require.NoFileExists(t, args.StateFile)
+
+		// pending validator set is not kept after restart
+		sv, _ = NewSignatureVerifier(args)
+		require.ErrorIs(t, sv.Verify(createBridgeData(4, []byte{0b11})), errNotEnoughSigners)
+
+		require.Nil(t, sv.ConfirmValidatorSetChange(validatorSetChange))
+		require.Nil(t, sv.Verify(createBridgeData(4, []byte{0b11})))
+		require.Equal(t, newPubKeys, signers)
+
+		// confirmed validator set is loaded from the state file after restart
+		sv, _ = NewSignatureVerifier(args)
+		require.Nil(t, sv.Verify(createBridgeData(3, []byte{0b1111})))
+		require.Equal(t, pubKeys, signers)
+		require.Nil(t, sv.Verify(createBridgeData(7, []byte{0b11})))
+		require.Equal(t, newPubKeys, signers)
+	})
+	t.Run("validator set of each confirmed epoch should be saved", func(t *testing.T) {
+		var signers [][]byte
+		args := createArgs(t)
+		args.MultiSigVerifier = &testscommon.MultiSigVerifierMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				signers = pubKeysSigners
+				return nil
+			},
+		}
+		sv, _ := NewSignatureVerifier(args)
+
+		latestPubKeys := [][]byte{[]byte("pk6"), []byte("pk7"), []byte("pk8")}
+		require.Nil(t, sv.ConfirmValidatorSetChange(createValidatorSetChange(t, 3, newPubKeys)))
+		require.Nil(t, sv.ConfirmValidatorSetChange(createValidatorSetChange(t, 5, latestPubKeys)))
+
+		state, err := LoadValidatorsState(args.StateFile)
+		require.Nil(t, err)
+		require.Len(t, state.ValidatorSets, 3)
+
+		sv, _ = NewSignatureVerifier(args)
+		require.Nil(t, sv.Verify(createBridgeData(5, []byte{0b11})))
+		require.Equal(t, newPubKeys, signers)
+		require.Nil(t, sv.Verify(createBridgeData(6, []byte{0b111})))
+		require.Equal(t, latestPubKeys, signers)
+	})
+	t.Run("failed validator set change should be removed", func(t *testing.T) {
+		sv, _ := NewSignatureVerifier(createArgs(t))
+
+		validatorSetChange := createValidatorSetChange(t, 3, newPubKeys)
+		require.Nil(t, sv.AddValidatorSetChange(validatorSetChange))
+		require.Nil(t, sv.Verify(createBridgeData(4, []byte{0b11})))
+
+		sv.RemoveValidatorSetChange(validatorSetChange)
+		require.ErrorIs(t, sv.Verify(createBridgeData(4, []byte{0b11})), errNotEnoughSigners)
+	})
+	t.Run("legacy state file with a single validator set should be loaded", func(t *testing.T) {
+		var signers [][]byte
+		args := createArgs(t)
+		args.MultiSigVerifier = &testscommon.MultiSigVerifierMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				signers = pubKeysSigners
+				return nil
+			},
+		}
+		legacyState := &ValidatorsConfig{
+			Epoch:   4,
+			PubKeys: []string{hex.EncodeToString(newPubKeys[0]), hex.EncodeToString(newPubKeys[1])},
+		}
+		require.Nil(t, core.SaveTomlFile(legacyState, args.StateFile))
+
+		sv, err := NewSignatureVerifier(args)
+		require.Nil(t, err)
+		require.Nil(t, sv.Verify(createBridgeData(4, []byte{0b11})))
+		require.Equal(t, newPubKeys, signers)
+	})
+	t.Run("other bridge data should be ignored", func(t *testing.T) {
+		sv, _ := NewSignatureVerifier(createArgs(t))
+
+		require.Nil(t, sv.AddValidatorSetChange(createBridgeData(2, []byte{0b1111})))
+		require.Nil(t, sv.ConfirmValidatorSetChange(createBridgeData(2, []byte{0b1111})))
+		require.ErrorIs(t, sv.Verify(createBridgeData(3, []byte{0b11})), errNotEnoughSigners)
+	})
+	t.Run("invalid validator set change", func(t *testing.T) {
+		sv, _ := NewSignatureVerifier(createArgs(t))
+
+		validatorSetChange := createValidatorSetChange(t, 2, nil)
+		require.ErrorIs(t, sv.AddValidatorSetChange(validatorSetChange), errInvalidValidatorSetChange)
+		require.ErrorIs(t, sv.ConfirmValidatorSetChange(validatorSetChange), errInvalidValidatorSetChange)
+
+		validatorSetChange.OutGoingOperations[0].Data = []byte("invalid")
+		require.ErrorIs(t, sv.AddValidatorSetChange(validatorSetChange), errInvalidValidatorSetChange)
+
+		validatorSetChange.OutGoingOperations = nil
+		require.ErrorIs(t, sv.AddValidatorSetChange(validatorSetChange), errInvalidValidatorSetChange)
+	})
+}
+
+func TestLoadValidatorsConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := LoadValidatorsConfig("../cmd/server/validators.toml")
+	require.Nil(t, err)
+	require.Equal(t, uint32(0), cfg.Epoch)
+
+	decodedPubKeys, err := cfg.DecodePubKeys()
+	require.Nil(t, err)
+	require.Len(t, decodedPubKeys, 1)
+	require.Len(t, decodedPubKeys[0], 96)
+
+	cfg.PubKeys = append(cfg.PubKeys, "invalid")
+	_, err = cfg.DecodePubKeys()
+	require.NotNil(t, err)
+}
@@ -0,0 +1,67 @@
+package verifier
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// ValidatorsConfig holds the hex encoded bls public keys of the sovereign validators at the provided epoch, in the
+// order of the consensus group, as loaded from a config file
+type ValidatorsConfig struct {
+	Epoch   uint32
+	PubKeys []string
+}
+
+// LoadValidatorsConfig loads the validators config from the provided toml file
+func LoadValidatorsConfig(filePath string) (*ValidatorsConfig, error) {
+	cfg := &ValidatorsConfig{}
+	err := core.LoadTomlFile(cfg, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// ValidatorsState holds the validator set of each epoch rotated on-chain, as saved in the state file
+type ValidatorsState struct {
+	ValidatorSets []*ValidatorsConfig
+}
+
+// LoadValidatorsState loads the validators state from the provided toml file. A state file holding a single validator
+// set, as saved by previous versions, is loaded as the only validator set.
+func LoadValidatorsState(filePath string) (*ValidatorsState, error) {
+	state := &ValidatorsState{}
+	err := core.LoadTomlFile(state, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(state.ValidatorSets) != 0 {
+		return state, nil
+	}
+
+	cfg, err := LoadValidatorsConfig(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	state.ValidatorSets = append(state.ValidatorSets, cfg)
+	return state, nil
+}
+
+// DecodePubKeys returns the decoded bls public keys of the validators
+func (cfg *ValidatorsConfig) DecodePubKeys() ([][]byte, error) {
+	pubKeys := make([][]byte, 0, len(cfg.PubKeys))
+	for idx, pubKeyHex := range cfg.PubKeys {
+		pubKey, err := hex.DecodeString(pubKeyHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator public key at index %d: %w", idx, err)
+		}
+
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	return pubKeys, nil
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+var (
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,fork:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the fork package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogDebug.String(),
+	}
+)
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/cert"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/txSender"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/signer"
+)
+
+var log = logger.GetOrCreate("bridge-signer")
+
+const (
+	envSignerAddress    = "SIGNER_ADDRESS"
+	envWallet           = "WALLET_PATH"
+	envPassword         = "WALLET_PASSWORD"
+	envAllowedReceivers = "ALLOWED_RECEIVERS"
+	envAllowedEndpoints = "ALLOWED_ENDPOINTS"
+	envCertFile         = "CERT_FILE"
+	envCertPkFile       = "CERT_PK_FILE"
+	envCertCAFile       = "CERT_CA_FILE"
+	envCertCRLFile      = "CERT_CRL_FILE"
+	envCertAllowlist    = "CERT_ALLOWLIST_FILE"
+)
+
+type signerConfig struct {
+	address           string
+	walletConfig      txSender.WalletConfig
+	allowedReceivers  []string
+	allowedEndpoints  []string
+	certificateConfig cert.FileCfg
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "Sovereign bridge tx signer"
+	app.Action = startSigner
+	app.Flags = []cli.Flag{
+		logLevel,
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startSigner(ctx *cli.Context) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	err = initializeLogger(ctx)
+	if err != nil {
+		return err
+	}
+
+	wallet, err := txSender.LoadWallet(cfg.walletConfig)
+	if err != nil {
+		return err
+	}
+
+	txBuilder, err := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	if err != nil {
+		return err
+	}
+
+	signerServer, err := signer.NewSignerServer(signer.ArgsSignerServer{
+		Wallet:           wallet,
+		TxInteractor:     txBuilder,
+		AllowedReceivers: cfg.allowedReceivers,
+		AllowedEndpoints: cfg.allowedEndpoints,
+	})
+	if err != nil {
+		return err
+	}
+
+	listener, grpcServer, err := createGRPCServer(cfg)
+	if err != nil {
+		return err
+	}
+
+	signer.RegisterSignerServer(grpcServer, signerServer)
+	log.Info("starting signer...", "address", cfg.address, "wallet", wallet.GetBech32())
+
+	go func() {
+		err = grpcServer.Serve(listener)
+		if err != nil {
+			log.Error("sovereign bridge signer: Serve", "error", err)
+		}
+	}()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
+
+	<-interrupt
+	log.Info("closing signer at user's signal")
+
+	grpcServer.GracefulStop()
+
+	return nil
+}
+
+// createGRPCServer listens on the unix socket, if the address is prefixed by unix://, or on the tcp address secured
+// with tls otherwise. Certificate files are reloaded when changed.
+func createGRPCServer(cfg *signerConfig) (net.Listener, *grpc.Server, error) {
+	if strings.HasPrefix(cfg.address, signer.UnixSocketPrefix) {
+		socketPath := strings.TrimPrefix(cfg.address, signer.UnixSocketPrefix)
+		_ = os.Remove(socketPath)
+
+		listener, err := net.Listen("unix", socketPath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return listener, grpc.NewServer(), nil
+	}
+
+	certReloader, err := cert.NewCertificateReloader(cfg.certificateConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// certificate files are also reloaded on SIGHUP, for the whole lifetime of the signer
+	_ = certReloader.ReloadOnSignal(syscall.SIGHUP)
+
+	listener, err := net.Listen("tcp", cfg.address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return listener, grpc.NewServer(grpc.Creds(credentials.NewTLS(certReloader.TLSServerConfig()))), nil
+}
+
+func loadConfig() (*signerConfig, error) {
+	err := godotenv.Load(".env")
+	if err != nil {
+		return nil, err
+	}
+
+	address := os.Getenv(envSignerAddress)
+	walletPath := os.Getenv(envWallet)
+	walletPassword := os.Getenv(envPassword)
+	allowedReceivers := splitList(os.Getenv(envAllowedReceivers))
+	allowedEndpoints := splitList(os.Getenv(envAllowedEndpoints))
+	certFile := os.Getenv(envCertFile)
+	certPkFile := os.Getenv(envCertPkFile)
+	certCAFile := os.Getenv(envCertCAFile)
+	certCRLFile := os.Getenv(envCertCRLFile)
+	certAllowlistFile := os.Getenv(envCertAllowlist)
+
+	log.Info("loaded config", "address", address)
+	log.Info("loaded config", "allowedReceivers", allowedReceivers)
+	log.Info("loaded config", "allowedEndpoints", allowedEndpoints)
+
+	log.Info("loaded config", "certificate file", certFile)
+	log.Info("loaded config", "certificate pk", certPkFile)
+	log.Info("loaded config", "certificate ca", certCAFile)
+	log.Info("loaded config", "certificate crl", certCRLFile)
+	log.Info("loaded config", "certificate allowlist", certAllowlistFile)
+
+	return &signerConfig{
+		address: address,
+		walletConfig: txSender.WalletConfig{
+			Path:     walletPath,
+			Password: walletPassword,
+		},
+		allowedReceivers: allowedReceivers,
+		allowedEndpoints: allowedEndpoints,
+		certificateConfig: cert.FileCfg{
+			CertFile:      certFile,
+			PkFile:        certPkFile,
+			CAFile:        certCAFile,
+			CRLFile:       certCRLFile,
+			AllowlistFile: certAllowlistFile,
+		},
+	}, nil
+}
+
+func splitList(values string) []string {
+	list := make([]string, 0)
+	for _, value := range strings.Split(values, ",") {
+		value = strings.TrimSpace(value)
+		if len(value) != 0 {
+			list = append(list, value)
+		}
+	}
+
+	return list
+}
+
+func initializeLogger(ctx *cli.Context) error {
+	logLevelFlagValue := ctx.GlobalString(logLevel.Name)
+	return logger.SetLogLevel(logLevelFlagValue)
+}
@@ -0,0 +1,10 @@
+package signer
+
+import "github.com/multiversx/mx-chain-sovereign-bridge-go/cert"
+
+// RemoteSignerConfig holds the config to connect to a remote signer. Addresses prefixed by unix:// are unix sockets,
+// which are not secured by tls, while any other address is a tcp host:port secured with the provided certificate.
+type RemoteSignerConfig struct {
+	Address        string
+	CertificateCfg cert.FileCfg
+}
@@ -0,0 +1,21 @@
+package signer
+
+import "errors"
+
+var errNilWallet = errors.New("nil wallet provided")
+
+var errNilTxInteractor = errors.New("nil tx interactor provided")
+
+var errNilSignerClient = errors.New("nil signer client provided")
+
+var errNilTx = errors.New("nil tx provided")
+
+var errNoAllowedReceivers = errors.New("no allowed receivers provided")
+
+var errNoAllowedEndpoints = errors.New("no allowed endpoints provided")
+
+var errInvalidSender = errors.New("tx sender is not the signer's wallet")
+
+var errReceiverNotAllowed = errors.New("tx receiver is not allowed")
+
+var errEndpointNotAllowed = errors.New("tx endpoint is not allowed")
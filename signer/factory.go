@@ -0,0 +1,42 @@
+package signer
+
+import (
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/cert"
+)
+
+// UnixSocketPrefix is the prefix of signer addresses which are unix sockets
+const UnixSocketPrefix = "unix://"
+
+// CreateRemoteSigner connects to the signer process found at the configured address
+func CreateRemoteSigner(cfg RemoteSignerConfig) (*remoteSigner, error) {
+	transportCredentials, err := createTransportCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(cfg.Address, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemoteSigner(NewSignerClient(conn))
+}
+
+func createTransportCredentials(cfg RemoteSignerConfig) (credentials.TransportCredentials, error) {
+	if strings.HasPrefix(cfg.Address, UnixSocketPrefix) {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig, err := cert.LoadTLSClientConfig(cfg.CertificateCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
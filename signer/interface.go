@@ -0,0 +1,12 @@
+package signer
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// TxInteractor defines a component which signs txs with a wallet's private key
+type TxInteractor interface {
+	ApplyUserSignature(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,55 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// ArgsLocalSigner holds args to create a new local signer
+type ArgsLocalSigner struct {
+	Wallet       core.CryptoComponentsHolder
+	TxInteractor TxInteractor
+}
+
+type localSigner struct {
+	wallet       core.CryptoComponentsHolder
+	txInteractor TxInteractor
+}
+
+// NewLocalSigner creates a signer which signs txs in-process, with a wallet loaded in memory
+func NewLocalSigner(args ArgsLocalSigner) (*localSigner, error) {
+	if check.IfNil(args.Wallet) {
+		return nil, errNilWallet
+	}
+	if check.IfNil(args.TxInteractor) {
+		return nil, errNilTxInteractor
+	}
+
+	return &localSigner{
+		wallet:       args.Wallet,
+		txInteractor: args.TxInteractor,
+	}, nil
+}
+
+// GetBech32 returns the bech32 address of the wallet
+func (ls *localSigner) GetBech32() string {
+	return ls.wallet.GetBech32()
+}
+
+// GetAddressHandler returns the address of the wallet
+func (ls *localSigner) GetAddressHandler() core.AddressHandler {
+	return ls.wallet.GetAddressHandler()
+}
+
+// SignTx signs the tx with the wallet's private key
+func (ls *localSigner) SignTx(_ context.Context, tx *transaction.FrontendTransaction) error {
+	return ls.txInteractor.ApplyUserSignature(ls.wallet, tx)
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (ls *localSigner) IsInterfaceNil() bool {
+	return ls == nil
+}
@@ -0,0 +1,75 @@
+package signer
+
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const getAddressTimeout = time.Second * 10
+
+type remoteSigner struct {
+	client  SignerClient
+	bech32  string
+	address core.AddressHandler
+}
+
+// NewRemoteSigner creates a signer which delegates signing to a separate signer process. The address of the signer's
+// wallet is requested once, at creation.
+func NewRemoteSigner(client SignerClient) (*remoteSigner, error) {
+	if client == nil {
+		return nil, errNilSignerClient
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), getAddressTimeout)
+	defer cancel()
+
+	resp, err := client.GetAddress(ctx, &AddressRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := data.NewAddressFromBech32String(resp.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("connected to remote signer", "address", resp.GetAddress())
+
+	return &remoteSigner{
+		client:  client,
+		bech32:  resp.GetAddress(),
+		address: address,
+	}, nil
+}
+
+// GetBech32 returns the bech32 address of the signer's wallet
+func (rs *remoteSigner) GetBech32() string {
+	return rs.bech32
+}
+
+// GetAddressHandler returns the address of the signer's wallet
+func (rs *remoteSigner) GetAddressHandler() core.AddressHandler {
+	return rs.address
+}
+
+// SignTx requests the signature of the tx from the signer process
+func (rs *remoteSigner) SignTx(ctx context.Context, tx *transaction.FrontendTransaction) error {
+	resp, err := rs.client.SignTx(ctx, &SignTxRequest{
+		Tx: newTransaction(tx),
+	})
+	if err != nil {
+		return err
+	}
+
+	tx.Signature = resp.GetSignature()
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (rs *remoteSigner) IsInterfaceNil() bool {
+	return rs == nil
+}
@@ -0,0 +1,114 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logger.GetOrCreate("signer")
+
+// ArgsSignerServer holds args to create a new signer server
+type ArgsSignerServer struct {
+	Wallet           core.CryptoComponentsHolder
+	TxInteractor     TxInteractor
+	AllowedReceivers []string
+	AllowedEndpoints []string
+}
+
+type signerServer struct {
+	UnimplementedSignerServer
+	wallet           core.CryptoComponentsHolder
+	txInteractor     TxInteractor
+	allowedReceivers map[string]struct{}
+	allowedEndpoints map[string]struct{}
+}
+
+// NewSignerServer creates a grpc signer server, which signs only txs sent from its wallet to the allowed receivers
+// and endpoints
+func NewSignerServer(args ArgsSignerServer) (*signerServer, error) {
+	if check.IfNil(args.Wallet) {
+		return nil, errNilWallet
+	}
+	if check.IfNil(args.TxInteractor) {
+		return nil, errNilTxInteractor
+	}
+	if len(args.AllowedReceivers) == 0 {
+		return nil, errNoAllowedReceivers
+	}
+	if len(args.AllowedEndpoints) == 0 {
+		return nil, errNoAllowedEndpoints
+	}
+
+	return &signerServer{
+		wallet:           args.Wallet,
+		txInteractor:     args.TxInteractor,
+		allowedReceivers: toSet(args.AllowedReceivers),
+		allowedEndpoints: toSet(args.AllowedEndpoints),
+	}, nil
+}
+
+// GetAddress returns the address of the signer's wallet
+func (s *signerServer) GetAddress(_ context.Context, _ *AddressRequest) (*AddressResponse, error) {
+	return &AddressResponse{
+		Address: s.wallet.GetBech32(),
+	}, nil
+}
+
+// SignTx signs the tx, if it is sent from the signer's wallet to an allowed receiver and endpoint
+func (s *signerServer) SignTx(_ context.Context, req *SignTxRequest) (*SignTxResponse, error) {
+	if req.GetTx() == nil {
+		return nil, status.Error(codes.InvalidArgument, errNilTx.Error())
+	}
+
+	tx := req.GetTx().toFrontendTransaction()
+	err := s.checkTx(tx.Sender, tx.Receiver, getEndpoint(tx.Data))
+	if err != nil {
+		log.Warn("refused to sign tx", "receiver", tx.Receiver, "nonce", tx.Nonce, "error", err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.txInteractor.ApplyUserSignature(s.wallet, tx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	log.Debug("signed tx", "receiver", tx.Receiver, "nonce", tx.Nonce)
+
+	return &SignTxResponse{
+		Signature: tx.Signature,
+	}, nil
+}
+
+func (s *signerServer) checkTx(sender string, receiver string, endpoint string) error {
+	if sender != s.wallet.GetBech32() {
+		return fmt.Errorf("%w, sender = %s", errInvalidSender, sender)
+	}
+	if _, allowed := s.allowedReceivers[receiver]; !allowed {
+		return fmt.Errorf("%w, receiver = %s", errReceiverNotAllowed, receiver)
+	}
+	if _, allowed := s.allowedEndpoints[endpoint]; !allowed {
+		return fmt.Errorf("%w, endpoint = %s", errEndpointNotAllowed, endpoint)
+	}
+
+	return nil
+}
+
+func getEndpoint(txData []byte) string {
+	return strings.Split(string(txData), "@")[0]
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
@@ -0,0 +1,191 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/testscommon"
+)
+
+const (
+	walletAddress   = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	allowedReceiver = "erd1qqqe"
+)
+
+func createSignerServerArgs() ArgsSignerServer {
+	return ArgsSignerServer{
+		Wallet: &testscommon.CryptoComponentsHolderMock{
+			GetBech32Called: func() string {
+				return walletAddress
+			},
+		},
+		TxInteractor: &testscommon.TxInteractorMock{
+			ApplyUserSignatureCalled: func(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error {
+				tx.Signature = "signature"
+				return nil
+			},
+		},
+		AllowedReceivers: []string{allowedReceiver},
+		AllowedEndpoints: []string{"executeBridgeOps"},
+	}
+}
+
+func createSignTxRequest(sender string, receiver string, data string) *SignTxRequest {
+	return &SignTxRequest{
+		Tx: &Transaction{
+			Nonce:    4,
+			Value:    "0",
+			Receiver: receiver,
+			Sender:   sender,
+			GasPrice: 1000000000,
+			GasLimit: 50000000,
+			Data:     []byte(data),
+			ChainID:  "T",
+			Version:  2,
+		},
+	}
+}
+
+func TestNewSignerServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil wallet", func(t *testing.T) {
+		args := createSignerServerArgs()
+		args.Wallet = nil
+
+		ss, err := NewSignerServer(args)
+		require.Nil(t, ss)
+		require.Equal(t, errNilWallet, err)
+	})
+	t.Run("nil tx interactor", func(t *testing.T) {
+		args := createSignerServerArgs()
+		args.TxInteractor = nil
+
+		ss, err := NewSignerServer(args)
+		require.Nil(t, ss)
+		require.Equal(t, errNilTxInteractor, err)
+	})
+	t.Run("no allowed receivers", func(t *testing.T) {
+		args := createSignerServerArgs()
+		args.AllowedReceivers = nil
+
+		ss, err := NewSignerServer(args)
+		require.Nil(t, ss)
+		require.Equal(t, errNoAllowedReceivers, err)
+	})
+	t.Run("no allowed endpoints", func(t *testing.T) {
+		args := createSignerServerArgs()
+		args.AllowedEndpoints = nil
+
+		ss, err := NewSignerServer(args)
+		require.Nil(t, ss)
+		require.Equal(t, errNoAllowedEndpoints, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ss, err := NewSignerServer(createSignerServerArgs())
+		require.Nil(t, err)
+		require.NotNil(t, ss)
+	})
+}
+
+func TestSignerServer_SignTx(t *testing.T) {
+	t.Parallel()
+
+	ss, _ := NewSignerServer(createSignerServerArgs())
+
+	t.Run("nil tx", func(t *testing.T) {
+		resp, err := ss.SignTx(context.Background(), &SignTxRequest{})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("invalid sender", func(t *testing.T) {
+		resp, err := ss.SignTx(context.Background(), createSignTxRequest("erd1other", allowedReceiver, "executeBridgeOps@01"))
+		require.Nil(t, resp)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.ErrorContains(t, err, errInvalidSender.Error())
+	})
+	t.Run("receiver not allowed", func(t *testing.T) {
+		resp, err := ss.SignTx(context.Background(), createSignTxRequest(walletAddress, "erd1other", "executeBridgeOps@01"))
+		require.Nil(t, resp)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.ErrorContains(t, err, errReceiverNotAllowed.Error())
+	})
+	t.Run("endpoint not allowed", func(t *testing.T) {
+		resp, err := ss.SignTx(context.Background(), createSignTxRequest(walletAddress, allowedReceiver, "transfer@01"))
+		require.Nil(t, resp)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.ErrorContains(t, err, errEndpointNotAllowed.Error())
+	})
+	t.Run("sign error", func(t *testing.T) {
+		args := createSignerServerArgs()
+		args.TxInteractor = &testscommon.TxInteractorMock{
+			ApplyUserSignatureCalled: func(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error {
+				return errors.New("local err")
+			},
+		}
+		failingServer, _ := NewSignerServer(args)
+
+		resp, err := failingServer.SignTx(context.Background(), createSignTxRequest(walletAddress, allowedReceiver, "executeBridgeOps@01"))
+		require.Nil(t, resp)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+	t.Run("should work", func(t *testing.T) {
+		resp, err := ss.SignTx(context.Background(), createSignTxRequest(walletAddress, allowedReceiver, "executeBridgeOps@01"))
+		require.Nil(t, err)
+		require.Equal(t, "signature", resp.GetSignature())
+	})
+}
+
+func TestRemoteSigner_SignTx(t *testing.T) {
+	t.Parallel()
+
+	socketPath := filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.Nil(t, err)
+
+	ss, _ := NewSignerServer(createSignerServerArgs())
+	grpcServer := grpc.NewServer()
+	RegisterSignerServer(grpcServer, ss)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	defer grpcServer.Stop()
+
+	rs, err := CreateRemoteSigner(RemoteSignerConfig{
+		Address: UnixSocketPrefix + socketPath,
+	})
+	require.Nil(t, err)
+	require.Equal(t, walletAddress, rs.GetBech32())
+	bech32, err := rs.GetAddressHandler().AddressAsBech32String()
+	require.Nil(t, err)
+	require.Equal(t, walletAddress, bech32)
+
+	tx := &transaction.FrontendTransaction{
+		Nonce:    4,
+		Value:    "0",
+		Receiver: allowedReceiver,
+		Sender:   walletAddress,
+		GasPrice: 1000000000,
+		GasLimit: 50000000,
+		Data:     []byte("executeBridgeOps@01"),
+		ChainID:  "T",
+		Version:  2,
+	}
+	err = rs.SignTx(context.Background(), tx)
+	require.Nil(t, err)
+	require.Equal(t, "signature", tx.Signature)
+
+	tx.Receiver = "erd1other"
+	err = rs.SignTx(context.Background(), tx)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
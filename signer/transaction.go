@@ -0,0 +1,35 @@
+package signer
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+func newTransaction(tx *transaction.FrontendTransaction) *Transaction {
+	return &Transaction{
+		Nonce:    tx.Nonce,
+		Value:    tx.Value,
+		Receiver: tx.Receiver,
+		Sender:   tx.Sender,
+		GasPrice: tx.GasPrice,
+		GasLimit: tx.GasLimit,
+		Data:     tx.Data,
+		ChainID:  tx.ChainID,
+		Version:  tx.Version,
+		Options:  tx.Options,
+	}
+}
+
+func (x *Transaction) toFrontendTransaction() *transaction.FrontendTransaction {
+	return &transaction.FrontendTransaction{
+		Nonce:    x.GetNonce(),
+		Value:    x.GetValue(),
+		Receiver: x.GetReceiver(),
+		Sender:   x.GetSender(),
+		GasPrice: x.GetGasPrice(),
+		GasLimit: x.GetGasLimit(),
+		Data:     x.GetData(),
+		ChainID:  x.GetChainID(),
+		Version:  x.GetVersion(),
+		Options:  x.GetOptions(),
+	}
+}
@@ -4,7 +4,8 @@ import "github.com/multiversx/mx-chain-core-go/data/sovereign"
 
 // DataFormatterMock mocks DataFormatter interface
 type DataFormatterMock struct {
-	CreateTxsDataCalled func(data *sovereign.BridgeOperations) [][]byte
+	CreateTxsDataCalled           func(data *sovereign.BridgeOperations) [][]byte
+	CreateBridgeDataTxsDataCalled func(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error)
 }
 
 // CreateTxsData mocks the CreateTxsData method
@@ -15,6 +16,14 @@ func (mock *DataFormatterMock) CreateTxsData(data *sovereign.BridgeOperations) [
 	return nil
 }
 
+// CreateBridgeDataTxsData mocks the CreateBridgeDataTxsData method
+func (mock *DataFormatterMock) CreateBridgeDataTxsData(bridgeData *sovereign.BridgeOutGoingData) ([][]byte, error) {
+	if mock.CreateBridgeDataTxsDataCalled != nil {
+		return mock.CreateBridgeDataTxsDataCalled(bridgeData)
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (mock *DataFormatterMock) IsInterfaceNil() bool {
 	return mock == nil
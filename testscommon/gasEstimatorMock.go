@@ -0,0 +1,25 @@
+package testscommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// GasEstimatorMock mocks GasEstimator interface
+type GasEstimatorMock struct {
+	EstimateGasCalled func(ctx context.Context, tx *transaction.FrontendTransaction) (uint64, error)
+}
+
+// EstimateGas mocks the EstimateGas method, by default it keeps the gas limit already set on the tx
+func (mock *GasEstimatorMock) EstimateGas(ctx context.Context, tx *transaction.FrontendTransaction) (uint64, error) {
+	if mock.EstimateGasCalled != nil {
+		return mock.EstimateGasCalled(ctx, tx)
+	}
+	return tx.GasLimit, nil
+}
+
+// IsInterfaceNil -
+func (mock *GasEstimatorMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,128 @@
+package testscommon
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+)
+
+// JournalMock mocks Journal interface
+type JournalMock struct {
+	AddCalled        func(bridgeData *sovereign.BridgeOutGoingData) error
+	SetTxsDataCalled func(hash []byte, txsData [][]byte) error
+	SetFailedCalled  func(hash []byte, reason string) error
+	UpdateTxCalled   func(hash []byte, txIndex int, tx *journal.TxRecord) error
+	UnfinishedCalled func() []*journal.Entry
+
+	ResetFailedTxsCalled func(hash []byte) error
+	GetCalled            func(hash []byte) (*journal.Entry, bool)
+	GetByOperationCalled func(operationHash []byte) (*journal.Entry, bool)
+
+	SetTxStateCalled  func(hash []byte, txHash string, state journal.TxState) error
+	UnconfirmedCalled func() []*journal.Entry
+	EntriesCalled     func() []*journal.Entry
+	PruneCalled       func(completedBefore time.Time, historySize int, keep func(entry *journal.Entry) bool) (int, error)
+}
+
+// Add mocks the Add method
+func (mock *JournalMock) Add(bridgeData *sovereign.BridgeOutGoingData) error {
+	if mock.AddCalled != nil {
+		return mock.AddCalled(bridgeData)
+	}
+	return nil
+}
+
+// SetTxsData mocks the SetTxsData method
+func (mock *JournalMock) SetTxsData(hash []byte, txsData [][]byte) error {
+	if mock.SetTxsDataCalled != nil {
+		return mock.SetTxsDataCalled(hash, txsData)
+	}
+	return nil
+}
+
+// SetFailed mocks the SetFailed method
+func (mock *JournalMock) SetFailed(hash []byte, reason string) error {
+	if mock.SetFailedCalled != nil {
+		return mock.SetFailedCalled(hash, reason)
+	}
+	return nil
+}
+
+// UpdateTx mocks the UpdateTx method
+func (mock *JournalMock) UpdateTx(hash []byte, txIndex int, tx *journal.TxRecord) error {
+	if mock.UpdateTxCalled != nil {
+		return mock.UpdateTxCalled(hash, txIndex, tx)
+	}
+	return nil
+}
+
+// Unfinished mocks the Unfinished method
+func (mock *JournalMock) Unfinished() []*journal.Entry {
+	if mock.UnfinishedCalled != nil {
+		return mock.UnfinishedCalled()
+	}
+	return make([]*journal.Entry, 0)
+}
+
+// SetTxState mocks the SetTxState method
+func (mock *JournalMock) SetTxState(hash []byte, txHash string, state journal.TxState) error {
+	if mock.SetTxStateCalled != nil {
+		return mock.SetTxStateCalled(hash, txHash, state)
+	}
+	return nil
+}
+
+// Unconfirmed mocks the Unconfirmed method
+func (mock *JournalMock) Unconfirmed() []*journal.Entry {
+	if mock.UnconfirmedCalled != nil {
+		return mock.UnconfirmedCalled()
+	}
+	return make([]*journal.Entry, 0)
+}
+
+// Entries mocks the Entries method
+func (mock *JournalMock) Entries() []*journal.Entry {
+	if mock.EntriesCalled != nil {
+		return mock.EntriesCalled()
+	}
+	return make([]*journal.Entry, 0)
+}
+
+// Prune mocks the Prune method
+func (mock *JournalMock) Prune(completedBefore time.Time, historySize int, keep func(entry *journal.Entry) bool) (int, error) {
+	if mock.PruneCalled != nil {
+		return mock.PruneCalled(completedBefore, historySize, keep)
+	}
+	return 0, nil
+}
+
+// ResetFailedTxs mocks the ResetFailedTxs method
+func (mock *JournalMock) ResetFailedTxs(hash []byte) error {
+	if mock.ResetFailedTxsCalled != nil {
+		return mock.ResetFailedTxsCalled(hash)
+	}
+	return nil
+}
+
+// Get mocks the Get method
+func (mock *JournalMock) Get(hash []byte) (*journal.Entry, bool) {
+	if mock.GetCalled != nil {
+		return mock.GetCalled(hash)
+	}
+	return nil, false
+}
+
+// GetByOperation mocks the GetByOperation method
+func (mock *JournalMock) GetByOperation(operationHash []byte) (*journal.Entry, bool) {
+	if mock.GetByOperationCalled != nil {
+		return mock.GetByOperationCalled(operationHash)
+	}
+	return nil, false
+}
+
+// IsInterfaceNil -
+func (mock *JournalMock) IsInterfaceNil() bool {
+	return mock == nil
+}
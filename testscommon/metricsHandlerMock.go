@@ -0,0 +1,26 @@
+package testscommon
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+)
+
+// MetricsHandlerMock mocks MetricsHandler interface
+type MetricsHandlerMock struct {
+	ObserveSendCalled func(data *sovereign.BridgeOperations, result *bridge.OperationsResult, duration time.Duration)
+}
+
+// ObserveSend mocks the ObserveSend method
+func (mock *MetricsHandlerMock) ObserveSend(data *sovereign.BridgeOperations, result *bridge.OperationsResult, duration time.Duration) {
+	if mock.ObserveSendCalled != nil {
+		mock.ObserveSendCalled(data, result, duration)
+	}
+}
+
+// IsInterfaceNil -
+func (mock *MetricsHandlerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,19 @@
+package testscommon
+
+// MultiSigVerifierMock mocks MultiSigVerifier interface
+type MultiSigVerifierMock struct {
+	VerifyAggregatedSigCalled func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error
+}
+
+// VerifyAggregatedSig mocks the VerifyAggregatedSig method
+func (mock *MultiSigVerifierMock) VerifyAggregatedSig(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+	if mock.VerifyAggregatedSigCalled != nil {
+		return mock.VerifyAggregatedSigCalled(pubKeysSigners, message, aggSig)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (mock *MultiSigVerifierMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -3,6 +3,7 @@ package testscommon
 import (
 	"context"
 
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 )
@@ -12,6 +13,12 @@ type ProxyMock struct {
 	GetAccountCalled       func(ctx context.Context, address core.AddressHandler) (*data.Account, error)
 	GetNetworkConfigCalled func(ctx context.Context) (*data.NetworkConfig, error)
 	IsInterfaceNilCalled   func() bool
+
+	ProcessTransactionStatusCalled      func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error)
+	GetTransactionInfoWithResultsCalled func(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	RequestTransactionCostCalled        func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error)
+	SendTransactionsCalled              func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error)
+	GetLatestHyperBlockNonceCalled      func(ctx context.Context) (uint64, error)
 }
 
 // GetAccount mocks the GetAccount method
@@ -30,6 +37,46 @@ func (mock *ProxyMock) GetNetworkConfig(ctx context.Context) (*data.NetworkConfi
 	return &data.NetworkConfig{}, nil
 }
 
+// ProcessTransactionStatus mocks the ProcessTransactionStatus method
+func (mock *ProxyMock) ProcessTransactionStatus(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+	if mock.ProcessTransactionStatusCalled != nil {
+		return mock.ProcessTransactionStatusCalled(ctx, hexTxHash)
+	}
+	return transaction.TxStatusPending, nil
+}
+
+// GetTransactionInfoWithResults mocks the GetTransactionInfoWithResults method
+func (mock *ProxyMock) GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+	if mock.GetTransactionInfoWithResultsCalled != nil {
+		return mock.GetTransactionInfoWithResultsCalled(ctx, hash)
+	}
+	return &data.TransactionInfo{}, nil
+}
+
+// SendTransactions mocks the SendTransactions method
+func (mock *ProxyMock) SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+	if mock.SendTransactionsCalled != nil {
+		return mock.SendTransactionsCalled(ctx, txs)
+	}
+	return make([]string, 0), nil
+}
+
+// RequestTransactionCost mocks the RequestTransactionCost method
+func (mock *ProxyMock) RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+	if mock.RequestTransactionCostCalled != nil {
+		return mock.RequestTransactionCostCalled(ctx, tx)
+	}
+	return &data.TxCostResponseData{}, nil
+}
+
+// GetLatestHyperBlockNonce mocks the GetLatestHyperBlockNonce method
+func (mock *ProxyMock) GetLatestHyperBlockNonce(ctx context.Context) (uint64, error) {
+	if mock.GetLatestHyperBlockNonceCalled != nil {
+		return mock.GetLatestHyperBlockNonceCalled(ctx)
+	}
+	return 0, nil
+}
+
 // IsInterfaceNil -
 func (mock *ProxyMock) IsInterfaceNil() bool {
 	return mock == nil
@@ -0,0 +1,21 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+
+// ReadinessStatusProviderMock mocks ReadinessStatusProvider interface
+type ReadinessStatusProviderMock struct {
+	GetReadinessStatusCalled func() *results.ReadinessStatus
+}
+
+// GetReadinessStatus mocks the GetReadinessStatus method
+func (mock *ReadinessStatusProviderMock) GetReadinessStatus() *results.ReadinessStatus {
+	if mock.GetReadinessStatusCalled != nil {
+		return mock.GetReadinessStatusCalled()
+	}
+	return &results.ReadinessStatus{Ready: true}
+}
+
+// IsInterfaceNil -
+func (mock *ReadinessStatusProviderMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,15 @@
+package testscommon
+
+import "google.golang.org/grpc/health/grpc_health_v1"
+
+// ServingStatusSetterMock mocks ServingStatusSetter interface
+type ServingStatusSetterMock struct {
+	SetServingStatusCalled func(service string, servingStatus grpc_health_v1.HealthCheckResponse_ServingStatus)
+}
+
+// SetServingStatus mocks the SetServingStatus method
+func (mock *ServingStatusSetterMock) SetServingStatus(service string, servingStatus grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	if mock.SetServingStatusCalled != nil {
+		mock.SetServingStatusCalled(service, servingStatus)
+	}
+}
@@ -0,0 +1,49 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+)
+
+// SignatureVerifierMock mocks SignatureVerifier interface
+type SignatureVerifierMock struct {
+	VerifyCalled                    func(bridgeData *sovereign.BridgeOutGoingData) error
+	AddValidatorSetChangeCalled     func(bridgeData *sovereign.BridgeOutGoingData) error
+	ConfirmValidatorSetChangeCalled func(bridgeData *sovereign.BridgeOutGoingData) error
+	RemoveValidatorSetChangeCalled  func(bridgeData *sovereign.BridgeOutGoingData)
+}
+
+// Verify mocks the Verify method
+func (mock *SignatureVerifierMock) Verify(bridgeData *sovereign.BridgeOutGoingData) error {
+	if mock.VerifyCalled != nil {
+		return mock.VerifyCalled(bridgeData)
+	}
+	return nil
+}
+
+// AddValidatorSetChange mocks the AddValidatorSetChange method
+func (mock *SignatureVerifierMock) AddValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) error {
+	if mock.AddValidatorSetChangeCalled != nil {
+		return mock.AddValidatorSetChangeCalled(bridgeData)
+	}
+	return nil
+}
+
+// ConfirmValidatorSetChange mocks the ConfirmValidatorSetChange method
+func (mock *SignatureVerifierMock) ConfirmValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) error {
+	if mock.ConfirmValidatorSetChangeCalled != nil {
+		return mock.ConfirmValidatorSetChangeCalled(bridgeData)
+	}
+	return nil
+}
+
+// RemoveValidatorSetChange mocks the RemoveValidatorSetChange method
+func (mock *SignatureVerifierMock) RemoveValidatorSetChange(bridgeData *sovereign.BridgeOutGoingData) {
+	if mock.RemoveValidatorSetChangeCalled != nil {
+		mock.RemoveValidatorSetChangeCalled(bridgeData)
+	}
+}
+
+// IsInterfaceNil -
+func (mock *SignatureVerifierMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,21 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// TxHashComputerMock mocks TxHashComputer interface
+type TxHashComputerMock struct {
+	ComputeTxHashCalled func(tx *transaction.FrontendTransaction) ([]byte, error)
+}
+
+// ComputeTxHash mocks the ComputeTxHash method
+func (mock *TxHashComputerMock) ComputeTxHash(tx *transaction.FrontendTransaction) ([]byte, error) {
+	if mock.ComputeTxHashCalled != nil {
+		return mock.ComputeTxHashCalled(tx)
+	}
+	return []byte(tx.Signature), nil
+}
+
+// IsInterfaceNil -
+func (mock *TxHashComputerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -9,6 +9,8 @@ import (
 // TxNonceSenderHandlerMock mocks TxNonceSenderHandler interface
 type TxNonceSenderHandlerMock struct {
 	ApplyNonceAndGasPriceCalled func(ctx context.Context, txs ...*transaction.FrontendTransaction) error
+	ReserveNoncesCalled         func(ctx context.Context, txs ...*transaction.FrontendTransaction) error
+	ReleaseNoncesCalled         func(txs ...*transaction.FrontendTransaction)
 	SendTransactionsCalled      func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error)
 }
 
@@ -20,6 +22,21 @@ func (mock *TxNonceSenderHandlerMock) ApplyNonceAndGasPrice(ctx context.Context,
 	return nil
 }
 
+// ReserveNonces mocks the ReserveNonces method
+func (mock *TxNonceSenderHandlerMock) ReserveNonces(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+	if mock.ReserveNoncesCalled != nil {
+		return mock.ReserveNoncesCalled(ctx, txs...)
+	}
+	return nil
+}
+
+// ReleaseNonces mocks the ReleaseNonces method
+func (mock *TxNonceSenderHandlerMock) ReleaseNonces(txs ...*transaction.FrontendTransaction) {
+	if mock.ReleaseNoncesCalled != nil {
+		mock.ReleaseNoncesCalled(txs...)
+	}
+}
+
 // SendTransactions mocks the SendTransaction method
 func (mock *TxNonceSenderHandlerMock) SendTransactions(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
 	if mock.SendTransactionsCalled != nil {
@@ -4,19 +4,92 @@ import (
 	"context"
 
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/bridge"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
 )
 
 // TxSenderMock mocks TxSender interface
 type TxSenderMock struct {
-	SendTxsCalled func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error)
+	SendTxsCalled                           func(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult
+	AcceptCalled                            func(data *sovereign.BridgeOperations) *bridge.OperationsResult
+	ResumeUnfinishedCalled                  func(ctx context.Context) *bridge.OperationsResult
+	RegisterValidatorSetChangeHandlerCalled func(handler func(bridgeData *sovereign.BridgeOutGoingData, confirmed bool))
+	RegisterHeldDataHandlerCalled           func(handler func(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult))
+	GetOperationResultCalled                func(bridgeDataHash []byte) (*results.OperationResult, bool)
+	GetWalletsStatusCalled                  func() []*results.WalletStatus
+	CheckNetworkCalled                      func(ctx context.Context) error
+	CloseCalled                             func() error
 }
 
 // SendTxs mocks the SendTxs method
-func (mock *TxSenderMock) SendTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+func (mock *TxSenderMock) SendTxs(ctx context.Context, data *sovereign.BridgeOperations) *bridge.OperationsResult {
 	if mock.SendTxsCalled != nil {
 		return mock.SendTxsCalled(ctx, data)
 	}
-	return nil, nil // Return appropriate default values if needed
+	return &bridge.OperationsResult{} // Return appropriate default values if needed
+}
+
+// Accept mocks the Accept method
+func (mock *TxSenderMock) Accept(data *sovereign.BridgeOperations) *bridge.OperationsResult {
+	if mock.AcceptCalled != nil {
+		return mock.AcceptCalled(data)
+	}
+	return &bridge.OperationsResult{}
+}
+
+// ResumeUnfinished mocks the ResumeUnfinished method
+func (mock *TxSenderMock) ResumeUnfinished(ctx context.Context) *bridge.OperationsResult {
+	if mock.ResumeUnfinishedCalled != nil {
+		return mock.ResumeUnfinishedCalled(ctx)
+	}
+	return &bridge.OperationsResult{}
+}
+
+// RegisterValidatorSetChangeHandler mocks the RegisterValidatorSetChangeHandler method
+func (mock *TxSenderMock) RegisterValidatorSetChangeHandler(handler func(bridgeData *sovereign.BridgeOutGoingData, confirmed bool)) {
+	if mock.RegisterValidatorSetChangeHandlerCalled != nil {
+		mock.RegisterValidatorSetChangeHandlerCalled(handler)
+	}
+}
+
+// RegisterHeldDataHandler mocks the RegisterHeldDataHandler method
+func (mock *TxSenderMock) RegisterHeldDataHandler(handler func(bridgeData *sovereign.BridgeOutGoingData, result *bridge.OutGoingDataResult)) {
+	if mock.RegisterHeldDataHandlerCalled != nil {
+		mock.RegisterHeldDataHandlerCalled(handler)
+	}
+}
+
+// GetOperationResult mocks the GetOperationResult method
+func (mock *TxSenderMock) GetOperationResult(bridgeDataHash []byte) (*results.OperationResult, bool) {
+	if mock.GetOperationResultCalled != nil {
+		return mock.GetOperationResultCalled(bridgeDataHash)
+	}
+	return nil, false
+}
+
+// GetWalletsStatus mocks the GetWalletsStatus method
+func (mock *TxSenderMock) GetWalletsStatus() []*results.WalletStatus {
+	if mock.GetWalletsStatusCalled != nil {
+		return mock.GetWalletsStatusCalled()
+	}
+	return make([]*results.WalletStatus, 0)
+}
+
+// CheckNetwork mocks the CheckNetwork method
+func (mock *TxSenderMock) CheckNetwork(ctx context.Context) error {
+	if mock.CheckNetworkCalled != nil {
+		return mock.CheckNetworkCalled(ctx)
+	}
+	return nil
+}
+
+// Close mocks the Close method
+func (mock *TxSenderMock) Close() error {
+	if mock.CloseCalled != nil {
+		return mock.CloseCalled()
+	}
+	return nil
 }
 
 // IsInterfaceNil mocks the IsInterfaceNil method
@@ -0,0 +1,44 @@
+package testscommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// TxSignerMock mocks TxSigner interface
+type TxSignerMock struct {
+	GetBech32Called         func() string
+	GetAddressHandlerCalled func() core.AddressHandler
+	SignTxCalled            func(ctx context.Context, tx *transaction.FrontendTransaction) error
+}
+
+// GetBech32 mocks the GetBech32 method
+func (mock *TxSignerMock) GetBech32() string {
+	if mock.GetBech32Called != nil {
+		return mock.GetBech32Called()
+	}
+	return ""
+}
+
+// GetAddressHandler mocks the GetAddressHandler method
+func (mock *TxSignerMock) GetAddressHandler() core.AddressHandler {
+	if mock.GetAddressHandlerCalled != nil {
+		return mock.GetAddressHandlerCalled()
+	}
+	return nil
+}
+
+// SignTx mocks the SignTx method
+func (mock *TxSignerMock) SignTx(ctx context.Context, tx *transaction.FrontendTransaction) error {
+	if mock.SignTxCalled != nil {
+		return mock.SignTxCalled(ctx, tx)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (mock *TxSignerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,76 @@
+package testscommon
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/journal"
+	"github.com/multiversx/mx-chain-sovereign-bridge-go/server/results"
+)
+
+// TxTrackerMock mocks TxTracker interface
+type TxTrackerMock struct {
+	TrackCalled              func(bridgeDataHash []byte, txHash string)
+	GetOperationResultCalled func(bridgeDataHash []byte) (*results.OperationResult, bool)
+	CloseCalled              func() error
+	ReplaceCalled            func(bridgeDataHash []byte, oldTxHash string, newTxHash string)
+	SetCancelledCalled       func(bridgeDataHash []byte, reason string)
+	GetStuckTxsCalled        func(pendingFor time.Duration) []*results.PendingTx
+	RegisterHandlerCalled    func(handler func(bridgeDataHash []byte, txHash string, state journal.TxState))
+}
+
+// Track mocks the Track method
+func (mock *TxTrackerMock) Track(bridgeDataHash []byte, txHash string) {
+	if mock.TrackCalled != nil {
+		mock.TrackCalled(bridgeDataHash, txHash)
+	}
+}
+
+// GetOperationResult mocks the GetOperationResult method
+func (mock *TxTrackerMock) GetOperationResult(bridgeDataHash []byte) (*results.OperationResult, bool) {
+	if mock.GetOperationResultCalled != nil {
+		return mock.GetOperationResultCalled(bridgeDataHash)
+	}
+	return nil, false
+}
+
+// Replace mocks the Replace method
+func (mock *TxTrackerMock) Replace(bridgeDataHash []byte, oldTxHash string, newTxHash string) {
+	if mock.ReplaceCalled != nil {
+		mock.ReplaceCalled(bridgeDataHash, oldTxHash, newTxHash)
+	}
+}
+
+// SetCancelled mocks the SetCancelled method
+func (mock *TxTrackerMock) SetCancelled(bridgeDataHash []byte, reason string) {
+	if mock.SetCancelledCalled != nil {
+		mock.SetCancelledCalled(bridgeDataHash, reason)
+	}
+}
+
+// RegisterHandler mocks the RegisterHandler method
+func (mock *TxTrackerMock) RegisterHandler(handler func(bridgeDataHash []byte, txHash string, state journal.TxState)) {
+	if mock.RegisterHandlerCalled != nil {
+		mock.RegisterHandlerCalled(handler)
+	}
+}
+
+// GetStuckTxs mocks the GetStuckTxs method
+func (mock *TxTrackerMock) GetStuckTxs(pendingFor time.Duration) []*results.PendingTx {
+	if mock.GetStuckTxsCalled != nil {
+		return mock.GetStuckTxsCalled(pendingFor)
+	}
+	return make([]*results.PendingTx, 0)
+}
+
+// Close mocks the Close method
+func (mock *TxTrackerMock) Close() error {
+	if mock.CloseCalled != nil {
+		return mock.CloseCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (mock *TxTrackerMock) IsInterfaceNil() bool {
+	return mock == nil
+}